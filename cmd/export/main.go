@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	userdata_service "pinstack-notification-service/internal/application/userdata"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	prometheus_metrics "pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// export writes a user's data export (the same document ExportUserData
+// streams) to a file, for operators answering data requests by hand.
+func main() {
+	cfg := config.MustLoad()
+
+	log := logger.New(cfg.Env, logger.WithLevel(cfg.Logging.Level), logger.WithRedaction(cfg.Logging.RedactKeys, cfg.Logging.MaxValueLength))
+
+	userID := flag.Int64("user-id", 0, "ID of the user to export")
+	format := flag.String("format", string(model.ExportFormatNDJSON), "Export format (ndjson/json)")
+	// The logger writes to stdout, so the export always goes to a file.
+	out := flag.String("out", "", "Output file")
+	pageSize := flag.Int("page-size", 0, "Records per page, 0 to export everything")
+	cursor := flag.String("cursor", "", "Cursor printed by the previous page")
+	flag.Parse()
+
+	if *userID <= 0 || *out == "" {
+		log.Error("Both -user-id and -out are required")
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	dsn := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
+		cfg.Database.Username,
+		cfg.Database.Password,
+		cfg.Database.Host,
+		cfg.Database.Port,
+		cfg.Database.DbName)
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		log.Error("Failed to create postgres pool", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer pool.Close()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Error("Failed to create output file", slog.String("path", *out), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error("Failed to close output file", slog.String("error", err.Error()))
+		}
+	}()
+
+	metrics := prometheus_metrics.NewPrometheusMetricsProvider()
+	userDataService := userdata_service.NewUserDataService(log,
+		repository_postgres.NewNotificationRepository(pool, log, metrics),
+		repository_postgres.NewPreferenceRepository(pool, log, metrics),
+		repository_postgres.NewDeviceTokenRepository(pool, log, metrics),
+		repository_postgres.NewWebhookRepository(pool, log, metrics),
+		repository_postgres.NewDigestRepository(pool, log, metrics),
+		repository_postgres.NewUserDataExportRepository(pool, log, metrics),
+		metrics,
+		userdata_service.Config{PurgeBatchSize: cfg.UserData.PurgeBatchSize},
+	)
+
+	next, err := userDataService.ExportUserData(ctx, f, *userID, model.ExportFormat(*format), *cursor, *pageSize)
+	if err != nil {
+		log.Error("Failed to export user data", slog.Int64("user_id", *userID), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if next != "" {
+		log.Info("More records follow, rerun with -cursor", slog.String("cursor", next))
+		return
+	}
+	log.Info("User data exported", slog.Int64("user_id", *userID))
+}
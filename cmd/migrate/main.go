@@ -12,7 +12,7 @@ import (
 func main() {
 	cfg := config.MustLoad()
 
-	log := logger.New(cfg.Env)
+	log := logger.New(cfg.Env, logger.WithLevel(cfg.Logging.Level), logger.WithRedaction(cfg.Logging.RedactKeys, cfg.Logging.MaxValueLength))
 
 	command := flag.String("command", "up", "Migration command (up/down)")
 	flag.Parse()
@@ -4,21 +4,50 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"os/signal"
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+	broadcast_service "pinstack-notification-service/internal/application/broadcast"
+	delivery_service "pinstack-notification-service/internal/application/delivery"
+	device_service "pinstack-notification-service/internal/application/device"
+	digest_service "pinstack-notification-service/internal/application/digest"
 	notification_service "pinstack-notification-service/internal/application/service"
+	userdata_service "pinstack-notification-service/internal/application/userdata"
+	webhook_service "pinstack-notification-service/internal/application/webhook"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
 	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/inbound/admin"
 	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/inbound/health"
+	"pinstack-notification-service/internal/infrastructure/inbound/jobs"
 	"pinstack-notification-service/internal/infrastructure/inbound/kafka/consumer"
 	metrics_server "pinstack-notification-service/internal/infrastructure/inbound/metrics"
+	"pinstack-notification-service/internal/infrastructure/inbound/middleware"
 	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/email"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/fake"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/push"
+	relation_client "pinstack-notification-service/internal/infrastructure/outbound/client/relation"
 	user_client "pinstack-notification-service/internal/infrastructure/outbound/client/user"
+	"pinstack-notification-service/internal/infrastructure/outbound/kafka/producer"
+	"pinstack-notification-service/internal/infrastructure/outbound/localization"
 	prometheus_metrics "pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
 	repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-notification-service/internal/infrastructure/outbound/webhook"
+	"pinstack-notification-service/internal/infrastructure/ratelimit"
+	"pinstack-notification-service/internal/infrastructure/tracing"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/notification/v1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -32,13 +61,29 @@ func main() {
 		cfg.Database.Port,
 		cfg.Database.DbName)
 	ctx := context.Background()
-	log := logger.New(cfg.Env)
+	log := logger.New(cfg.Env,
+		logger.WithLevel(cfg.Logging.Level),
+		logger.WithRedaction(cfg.Logging.RedactKeys, cfg.Logging.MaxValueLength))
+	// Component loggers can be switched to another level at runtime
+	// through /admin/log-level.
+	grpcLog := log.With(slog.String(logger.ComponentKey, "grpc"))
+	consumerLog := log.With(slog.String(logger.ComponentKey, "kafka_consumer"))
+	postgresLog := log.With(slog.String(logger.ComponentKey, "postgres"))
+	userServiceLog := log.With(slog.String(logger.ComponentKey, "user_service"))
+	relationServiceLog := log.With(slog.String(logger.ComponentKey, "relation_service"))
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing, log)
+	if err != nil {
+		log.Error("Failed to set up tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
 	poolConfig, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		log.Error("Failed to parse postgres poolConfig", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
+	poolConfig.ConnConfig.Tracer = tracing.PgxTracer{}
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
@@ -50,6 +95,8 @@ func main() {
 	userServiceConn, err := grpc.NewClient(
 		fmt.Sprintf("%s:%d", cfg.UserService.Address, cfg.UserService.Port),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(middleware.UnaryClientCorrelationInterceptor()),
 	)
 	if err != nil {
 		log.Error("Failed to connect to user service", slog.String("error", err.Error()))
@@ -62,26 +109,225 @@ func main() {
 		}
 	}(userServiceConn)
 
-	userClient := user_client.NewUserClient(userServiceConn, log)
+	relationServiceConn, err := grpc.NewClient(
+		fmt.Sprintf("%s:%d", cfg.RelationService.Address, cfg.RelationService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(middleware.UnaryClientCorrelationInterceptor()),
+	)
+	if err != nil {
+		log.Error("Failed to connect to relation service", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func(relationServiceConn *grpc.ClientConn) {
+		err := relationServiceConn.Close()
+		if err != nil {
+			log.Error("Failed to close relation service connection", slog.String("error", err.Error()))
+		}
+	}(relationServiceConn)
 
 	metricsProvider := prometheus_metrics.NewPrometheusMetricsProvider()
 
-	metricsProvider.SetServiceHealth(true)
+	userClient := user_client.NewResilientClient(user_client.NewUserClient(userServiceConn, userServiceLog), userServiceLog, metricsProvider, user_client.ResilientConfig{
+		CallTimeout:      cfg.UserService.Timeout,
+		MaxRetries:       cfg.UserService.MaxRetries,
+		RetryBackoff:     cfg.UserService.RetryBackoff,
+		CacheTTL:         cfg.UserService.CacheTTL,
+		NegativeCacheTTL: cfg.UserService.NegativeCacheTTL,
+		CacheSize:        cfg.UserService.CacheSize,
+		BreakerFailures:  cfg.UserService.BreakerFailures,
+		BreakerOpenFor:   cfg.UserService.BreakerOpenFor,
+	})
 
-	notificationRepo := repository_postgres.NewNotificationRepository(pool, log, metricsProvider)
+	notificationRepo := repository_postgres.NewNotificationRepository(pool, postgresLog, metricsProvider)
 
-	notificationService := notification_service.NewNotificationService(log, notificationRepo, userClient, metricsProvider)
+	kafkaProducer, err := producer.NewNotificationProducer(cfg.Kafka, log, metricsProvider)
+	if err != nil {
+		log.Error("Failed to initialize Kafka producer", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer kafkaProducer.Close()
+
+	deliveryRepo := repository_postgres.NewDeliveryRepository(pool, postgresLog, metricsProvider)
+	preferenceRepo := repository_postgres.NewPreferenceRepository(pool, postgresLog, metricsProvider)
+
+	var unsubscribeTokens *delivery_service.UnsubscribeTokens
+	if cfg.Delivery.UnsubscribeSecret != "" {
+		unsubscribeTokens = delivery_service.NewUnsubscribeTokens(cfg.Delivery.UnsubscribeSecret, cfg.Delivery.UnsubscribeURL)
+	} else {
+		log.Warn("delivery.unsubscribe_secret is not set, emails go out without unsubscribe links")
+	}
 
-	kafkaConsumer, err := consumer.NewNotificationConsumer(cfg.Kafka, log, notificationService, metricsProvider)
+	channels := make(map[model.Channel]ports.Channel)
+	var digestSender ports.DigestSender
+	if cfg.Delivery.Email.Enabled {
+		emailTemplates, err := email.LoadTemplates(cfg.Delivery.Email.TemplatesDir)
+		if err != nil {
+			log.Error("Failed to load email templates", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		var linker email.UnsubscribeLinker
+		if unsubscribeTokens != nil {
+			linker = unsubscribeTokens
+		}
+		emailChannel, err := email.NewChannel(cfg.Delivery.Email, emailTemplates, linker, log)
+		if err != nil {
+			log.Error("Failed to initialize email channel", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		channels[model.ChannelEmail] = emailChannel
+		digestSender = emailChannel
+	}
+	deviceTokenRepo := repository_postgres.NewDeviceTokenRepository(pool, postgresLog, metricsProvider)
+	if cfg.Delivery.Push.Enabled {
+		pushChannel, err := newPushChannel(cfg.Delivery.Push, deviceTokenRepo, log)
+		if err != nil {
+			log.Error("Failed to initialize push channel", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		channels[model.ChannelPush] = pushChannel
+	}
+	if cfg.Delivery.FakeChannels {
+		for _, name := range []model.Channel{model.ChannelEmail, model.ChannelPush} {
+			if _, ok := channels[name]; !ok {
+				channels[name] = fake.NewChannel(name, log)
+			}
+		}
+	}
+
+	deliverySvcConfig := deliveryConfig(cfg.Delivery)
+	deliverySvcConfig.Unsubscribe = unsubscribeTokens
+	deliveryService := delivery_service.NewDeliveryService(log, deliveryRepo, preferenceRepo, notificationRepo, userClient, metricsProvider,
+		deliverySvcConfig, slices.Collect(maps.Values(channels))...)
+
+	serviceOpts := []notification_service.Option{
+		notification_service.WithRestoreGracePeriod(cfg.Notifications.RestoreGracePeriod),
+		notification_service.WithActorLookup(cfg.Notifications.ActorLookupConcurrency, cfg.Notifications.ActorLookupTimeout),
+		notification_service.WithNotificationPublisher(kafkaProducer),
+	}
+	if cfg.Delivery.Enabled {
+		serviceOpts = append(serviceOpts, notification_service.WithDispatcher(deliveryService))
+	}
+
+	deviceService := device_service.NewDeviceService(log, deviceTokenRepo, metricsProvider)
+
+	webhookRepo := repository_postgres.NewWebhookRepository(pool, postgresLog, metricsProvider)
+	webhookService := webhook_service.NewWebhookService(log, webhookRepo, webhook.NewSender(cfg.Webhooks.Timeout), metricsProvider, webhook_service.Config{
+		MaxAttempts:  cfg.Webhooks.MaxAttempts,
+		BackoffBase:  cfg.Webhooks.BackoffBase,
+		BackoffMax:   cfg.Webhooks.BackoffMax,
+		Lease:        cfg.Webhooks.Lease,
+		DisableAfter: cfg.Webhooks.DisableAfter,
+	})
+	if cfg.Webhooks.Enabled {
+		serviceOpts = append(serviceOpts, notification_service.WithWebhooks(webhookService))
+	}
+
+	digestRepo := repository_postgres.NewDigestRepository(pool, postgresLog, metricsProvider)
+	digestService := digest_service.NewDigestService(log, digestRepo, notificationRepo, preferenceRepo, userClient, digestSender, metricsProvider, digest_service.Config{
+		SendHour:  cfg.Digest.SendHour,
+		WeeklyDay: weekday(cfg.Digest.WeeklyDay, log),
+		MaxItems:  cfg.Digest.MaxItems,
+		Lease:     cfg.Digest.Lease,
+	})
+
+	templates, err := localization.NewRegistry(cfg.Localization.TemplatesDir, cfg.Localization.DefaultLocale)
+	if err != nil {
+		log.Error("Failed to load notification templates", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	serviceOpts = append(serviceOpts, notification_service.WithRenderer(templates))
+
+	actorCaps := make(map[events.EventType]model.ActorCap, len(cfg.SpamProtection.ActorCaps))
+	for notificationType, limit := range cfg.SpamProtection.ActorCaps {
+		actorCaps[events.EventType(notificationType)] = model.ActorCap{Max: limit.Max, Window: limit.Window}
+	}
+	serviceOpts = append(serviceOpts, notification_service.WithActorCaps(actorCaps))
+
+	priorities := make(map[events.EventType]model.NotificationPriority, len(cfg.Notifications.PriorityByType))
+	for notificationType, name := range cfg.Notifications.PriorityByType {
+		priority := model.NotificationPriority(name)
+		if !priority.IsValid() {
+			log.Warn("Ignoring unknown notification priority",
+				slog.String("type", notificationType),
+				slog.String("priority", name))
+			continue
+		}
+		priorities[events.EventType(notificationType)] = priority
+	}
+	serviceOpts = append(serviceOpts, notification_service.WithPriorityDefaults(priorities))
+
+	notificationService := notification_service.NewNotificationService(log, notificationRepo, userClient, metricsProvider, serviceOpts...)
+
+	exportRepo := repository_postgres.NewUserDataExportRepository(pool, postgresLog, metricsProvider)
+	userDataService := userdata_service.NewUserDataService(log, notificationRepo, preferenceRepo, deviceTokenRepo, webhookRepo, digestRepo, exportRepo, metricsProvider, userdata_service.Config{
+		PurgeBatchSize: cfg.UserData.PurgeBatchSize,
+	}, userdata_service.WithUserCache(userClient))
+
+	kafkaConsumer, err := consumer.NewNotificationConsumer(cfg.Kafka, consumerLog, notificationService, userDataService, metricsProvider,
+		logger.NewSampler(cfg.Logging.Sampling.Initial, cfg.Logging.Sampling.Thereafter, cfg.Logging.Sampling.Tick))
 	if err != nil {
 		log.Error("Failed to initialize Kafka consumer", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 
-	notificationGRPCApi := notification_grpc.NewNotificationGRPCService(notificationService, log)
-	grpcServer := notification_grpc.NewServer(notificationGRPCApi, cfg.GrpcServer.Address, cfg.GrpcServer.Port, log, metricsProvider)
+	healthChecker := health.NewChecker(cfg.Health, log, metricsProvider,
+		pb.NotificationService_ServiceDesc.ServiceName,
+		extpb.NotificationExtService_ServiceDesc.ServiceName,
+	)
+	healthChecker.Register("postgres", pool.Ping)
+	healthChecker.Register("user_service", health.ClientConnProbe(userServiceConn))
+	// gRPC calls do not go through Kafka, so a consumer waiting for a
+	// rebalance is reported without taking the server out of rotation.
+	healthChecker.RegisterOptional("kafka", kafkaConsumer.Ready)
+
+	relationClient := relation_client.NewRelationClient(relationServiceConn, relationServiceLog, cfg.RelationService.Timeout)
+	broadcastRepo := repository_postgres.NewBroadcastRepository(pool, postgresLog, metricsProvider)
+	broadcastService := broadcast_service.NewBroadcastService(log, broadcastRepo, relationClient, notificationService, metricsProvider, broadcast_service.Config{
+		ChunkSize:     cfg.Broadcast.ChunkSize,
+		MaxRecipients: cfg.Broadcast.MaxRecipients,
+		Lease:         cfg.Broadcast.Lease,
+		RetryDelay:    cfg.Broadcast.RetryDelay,
+	})
+
+	notificationGRPCApi := notification_grpc.NewNotificationGRPCService(notificationService, deliveryService, webhookService, deviceService, digestService, userDataService, broadcastService, grpcLog)
+
+	var rateLimiter *middleware.RateLimiter
+	if cfg.RateLimit.Enabled {
+		var limiter ratelimit.Limiter = ratelimit.NewMemoryLimiter()
+		if cfg.RateLimit.Backend == "redis" {
+			redisClient := redis.NewClient(&redis.Options{
+				Addr:     cfg.RateLimit.Redis.Address,
+				Password: cfg.RateLimit.Redis.Password,
+				DB:       cfg.RateLimit.Redis.DB,
+			})
+			defer redisClient.Close()
+			healthChecker.RegisterOptional("redis", func(ctx context.Context) error {
+				return redisClient.Ping(ctx).Err()
+			})
+			limiter = ratelimit.NewRedisLimiter(redisClient, cfg.RateLimit.Redis.KeyPrefix)
+		}
+		rules := make(map[string]middleware.RateLimitRule, len(cfg.RateLimit.Methods))
+		for method, rule := range cfg.RateLimit.Methods {
+			rules[method] = middleware.RateLimitRule{
+				Rule: ratelimit.Rule{Rate: rule.Rate, Burst: rule.Burst},
+				By:   middleware.RateLimitBy(rule.By),
+			}
+		}
+		rateLimiter = middleware.NewRateLimiter(limiter, rules, grpcLog, metricsProvider)
+	}
+
+	grpcServer := notification_grpc.NewServer(notificationGRPCApi, healthChecker.GRPCServer(), cfg.GrpcServer.Address, cfg.GrpcServer.Port, grpcLog, metricsProvider,
+		logger.NewSampler(cfg.Logging.Sampling.Initial, cfg.Logging.Sampling.Thereafter, cfg.Logging.Sampling.Tick), rateLimiter)
 
 	metricsServer := metrics_server.NewMetricsServer(cfg.Prometheus.Address, cfg.Prometheus.Port, log)
+	metricsServer.Handle("/healthz", healthChecker.LivenessHandler())
+	metricsServer.Handle("/readyz", healthChecker.ReadinessHandler())
+	if cfg.Logging.AdminToken != "" {
+		metricsServer.Handle("/admin/log-level", admin.LogLevelHandler(log.Levels(), cfg.Logging.AdminToken, log))
+	} else {
+		log.Info("Admin log level endpoint disabled, logging.admin_token is not set")
+	}
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -92,6 +338,53 @@ func main() {
 
 	go kafkaConsumer.Start(ctx)
 
+	jobsCtx, jobsCancel := context.WithCancel(ctx)
+	defer jobsCancel()
+
+	go healthChecker.Start(jobsCtx)
+
+	if cfg.Localization.HotReload && cfg.Localization.TemplatesDir != "" {
+		go func() {
+			if err := templates.Watch(jobsCtx, log); err != nil {
+				log.Error("Notification template watcher stopped", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
+	if cfg.Compaction.Enabled {
+		compactionJob := jobs.NewCompactionJob(cfg.Compaction, notificationService, log)
+		go compactionJob.Start(jobsCtx)
+	}
+
+	if cfg.Scheduler.Enabled {
+		schedulerJob := jobs.NewSchedulerJob(cfg.Scheduler, notificationService, log)
+		go schedulerJob.Start(jobsCtx)
+	}
+
+	if cfg.Delivery.Enabled {
+		deliveryWorkerJob := jobs.NewDeliveryWorkerJob(cfg.Delivery, deliveryService, log)
+		go deliveryWorkerJob.Start(jobsCtx)
+	}
+
+	if cfg.Digest.Enabled {
+		if digestSender == nil {
+			log.Warn("digest.enabled is set but delivery.email is disabled, digests are not sent")
+		} else {
+			digestJob := jobs.NewDigestJob(cfg.Digest, digestService, log)
+			go digestJob.Start(jobsCtx)
+		}
+	}
+
+	if cfg.Broadcast.Enabled {
+		broadcastJob := jobs.NewBroadcastJob(cfg.Broadcast, broadcastService, log)
+		go broadcastJob.Start(jobsCtx)
+	}
+
+	if cfg.Webhooks.Enabled {
+		webhookWorkerJob := jobs.NewWebhookWorkerJob(cfg.Webhooks, webhookService, log)
+		go webhookWorkerJob.Start(jobsCtx)
+	}
+
 	go func() {
 		if err := grpcServer.Run(); err != nil {
 			log.Error("gRPC server error", slog.String("error", err.Error()))
@@ -109,7 +402,11 @@ func main() {
 	<-quit
 	log.Info("Shutting down services...")
 
-	metricsProvider.SetServiceHealth(false)
+	// Report NOT_SERVING first and give load balancers time to notice
+	// before the server stops taking calls.
+	healthChecker.Drain()
+	time.Sleep(cfg.Health.DrainDelay)
+	jobsCancel()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
@@ -148,5 +445,81 @@ func main() {
 		log.Error("Kafka consumer shutdown timeout exceeded", slog.String("error", shutdownCtx.Err().Error()))
 	}
 
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Error("Tracing shutdown error", slog.String("error", err.Error()))
+	}
+
 	log.Info("Server exiting")
 }
+
+func deliveryConfig(cfg config.DeliveryConfig) delivery_service.Config {
+	toChannels := func(names []string) []model.Channel {
+		channels := make([]model.Channel, 0, len(names))
+		for _, name := range names {
+			channels = append(channels, model.Channel(name))
+		}
+		return channels
+	}
+
+	byType := make(map[events.EventType][]model.Channel, len(cfg.ChannelsByType))
+	for notificationType, names := range cfg.ChannelsByType {
+		byType[events.EventType(notificationType)] = toChannels(names)
+	}
+
+	return delivery_service.Config{
+		DefaultChannels: toChannels(cfg.Channels),
+		ChannelsByType:  byType,
+		MaxAttempts:     cfg.MaxAttempts,
+		BackoffBase:     cfg.BackoffBase,
+		BackoffMax:      cfg.BackoffMax,
+		Lease:           cfg.Lease,
+	}
+}
+
+// weekday parses a day name such as monday, falling back to Monday.
+func weekday(name string, log ports.Logger) time.Weekday {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day
+		}
+	}
+	log.Warn("Unknown digest.weekly_day, using monday", slog.String("weekly_day", name))
+	return time.Monday
+}
+
+// newPushChannel builds the provider configured for every platform; a
+// provider shared by several platforms is created once.
+func newPushChannel(cfg config.PushConfig, tokens ports.DeviceTokenRepository, log ports.Logger) (*push.Channel, error) {
+	templates, err := push.LoadTemplates(cfg.TemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]ports.PushProvider)
+	providers := make(map[model.DevicePlatform]ports.PushProvider, len(cfg.Providers))
+	for platform, name := range cfg.Providers {
+		if !model.DevicePlatform(platform).IsValid() {
+			return nil, fmt.Errorf("unknown push platform %q", platform)
+		}
+		provider, ok := byName[name]
+		if !ok {
+			switch name {
+			case "fcm":
+				provider, err = push.NewFCMProvider(cfg.FCM, cfg.Timeout)
+			case "apns":
+				provider, err = push.NewAPNsProvider(cfg.APNs, cfg.Timeout)
+			case "fake":
+				provider = push.NewFakeProvider()
+			default:
+				err = fmt.Errorf("unknown push provider %q", name)
+			}
+			if err != nil {
+				return nil, err
+			}
+			byName[name] = provider
+		}
+		providers[model.DevicePlatform(platform)] = provider
+	}
+
+	return push.NewChannel(tokens, providers, templates, cfg.DeepLinkBase, log), nil
+}
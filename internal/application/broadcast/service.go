@@ -0,0 +1,311 @@
+package broadcast_service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	notification_service "pinstack-notification-service/internal/application/service"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+const (
+	DefaultChunkSize     = 500
+	DefaultMaxRecipients = 100000
+	DefaultLease         = 5 * time.Minute
+	DefaultRetryDelay    = time.Minute
+)
+
+// Config tunes fan-out. Each step sends notifications to up to ChunkSize
+// recipients, at most notification_service.MaxNotificationBatchSize; for
+// followers it is also the page size asked of the relation service, so
+// changing it mid-broadcast re-pages the remaining followers.
+// An explicit audience holds at most MaxRecipients users. A step that fails
+// on a transient error is retried after RetryDelay.
+type Config struct {
+	ChunkSize     int
+	MaxRecipients int
+	Lease         time.Duration
+	RetryDelay    time.Duration
+}
+
+// NotificationSender sends a batch of notifications the way every other
+// notification is sent: checked, stored and delivered to its channels.
+type NotificationSender interface {
+	SaveNotifications(ctx context.Context, notifications []*model.Notification) ([]error, error)
+}
+
+// Service fans a notification out to many users in the background. A
+// broadcast is stored first and worked in chunks by ProcessDue, so it
+// survives restarts and its progress can be polled. Every chunk goes
+// through the notification service.
+type Service struct {
+	repo           ports.BroadcastRepository
+	relationClient ports.RelationClient
+	notifications  NotificationSender
+	log            ports.Logger
+	metrics        ports.MetricsProvider
+	config         Config
+	now            func() time.Time
+}
+
+func NewBroadcastService(
+	log ports.Logger,
+	repo ports.BroadcastRepository,
+	relationClient ports.RelationClient,
+	notifications NotificationSender,
+	metrics ports.MetricsProvider,
+	cfg Config,
+) *Service {
+	if cfg.ChunkSize <= 0 {
+		cfg.ChunkSize = DefaultChunkSize
+	}
+	cfg.ChunkSize = min(cfg.ChunkSize, notification_service.MaxNotificationBatchSize)
+	if cfg.MaxRecipients <= 0 {
+		cfg.MaxRecipients = DefaultMaxRecipients
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = DefaultLease
+	}
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = DefaultRetryDelay
+	}
+
+	return &Service{
+		repo:           repo,
+		relationClient: relationClient,
+		notifications:  notifications,
+		log:            log,
+		metrics:        metrics,
+		config:         cfg,
+		now:            time.Now,
+	}
+}
+
+// CreateBroadcast validates and stores a broadcast to either an explicit
+// list of users or the followers of a user, and returns its ID. Repeated
+// user IDs are dropped. An empty priority leaves it to the default for the
+// type. Nothing is sent until ProcessDue picks it up.
+func (s *Service) CreateBroadcast(ctx context.Context, broadcast *model.Broadcast) (id int64, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("create_broadcast", err == nil)
+	}()
+
+	if broadcast == nil || broadcast.Type == "" {
+		s.log.ErrorContext(ctx, "Invalid broadcast")
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	if (len(broadcast.UserIDs) > 0) == (broadcast.FollowersOf > 0) {
+		s.log.ErrorContext(ctx, "Broadcast needs either user IDs or a followed user",
+			slog.Int("user_ids", len(broadcast.UserIDs)),
+			slog.Int64("followers_of", broadcast.FollowersOf),
+		)
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	if len(broadcast.UserIDs) > 0 {
+		userIDs, ok := uniqueUserIDs(broadcast.UserIDs)
+		if !ok || len(userIDs) > s.config.MaxRecipients {
+			s.log.ErrorContext(ctx, "Invalid broadcast recipients",
+				slog.Int("user_ids", len(broadcast.UserIDs)),
+				slog.Int("max_recipients", s.config.MaxRecipients),
+			)
+			return 0, custom_errors.ErrInvalidInput
+		}
+		broadcast.UserIDs = userIDs
+	}
+
+	if broadcast.Priority != "" && !broadcast.Priority.IsValid() {
+		s.log.ErrorContext(ctx, "Invalid broadcast priority", slog.String("priority", string(broadcast.Priority)))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	broadcast.Status = model.BroadcastStatusPending
+
+	id, err = s.repo.Create(ctx, broadcast)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to create broadcast",
+			slog.String("type", string(broadcast.Type)),
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+
+	s.log.InfoContext(ctx, "Broadcast created",
+		slog.Int64("broadcast_id", id),
+		slog.String("type", string(broadcast.Type)),
+		slog.Int("user_ids", len(broadcast.UserIDs)),
+		slog.Int64("followers_of", broadcast.FollowersOf),
+	)
+	return id, nil
+}
+
+// uniqueUserIDs drops repeated IDs, keeping the first occurrence. It
+// reports false if any ID is not positive.
+func uniqueUserIDs(userIDs []int64) ([]int64, bool) {
+	seen := make(map[int64]struct{}, len(userIDs))
+	unique := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id <= 0 {
+			return nil, false
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique, true
+}
+
+func (s *Service) GetBroadcast(ctx context.Context, id int64) (broadcast *model.Broadcast, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("get_broadcast", err == nil || errors.Is(err, model.ErrBroadcastNotFound))
+	}()
+
+	if id <= 0 {
+		s.log.ErrorContext(ctx, "Invalid broadcast ID", slog.Int64("broadcast_id", id))
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	return s.repo.Get(ctx, id)
+}
+
+// ProcessDue claims up to batchSize unfinished broadcasts and takes one
+// chunk of each. It returns how many chunks were done; callers keep calling
+// while it is not zero, which works through concurrent broadcasts in turn.
+func (s *Service) ProcessDue(ctx context.Context, batchSize int) (processed int, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("process_due_broadcasts", err == nil)
+	}()
+
+	if batchSize <= 0 {
+		s.log.ErrorContext(ctx, "Invalid broadcast batch size", slog.Int("batch_size", batchSize))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	broadcasts, err := s.repo.ClaimDue(ctx, s.now(), s.config.Lease, batchSize)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to claim due broadcasts", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	for _, broadcast := range broadcasts {
+		if err := ctx.Err(); err != nil {
+			return processed, err
+		}
+		if s.process(ctx, broadcast) {
+			processed++
+		}
+	}
+
+	return processed, nil
+}
+
+// process sends the next chunk of the broadcast. Failures are recorded on
+// the broadcast: a followed user that no longer exists ends it, anything
+// else is retried from the same cursor after RetryDelay. Recipients that got
+// their notification before the retry are skipped then.
+func (s *Service) process(ctx context.Context, broadcast *model.Broadcast) bool {
+	chunk, err := s.nextChunk(ctx, broadcast)
+	if err == nil {
+		err = s.send(ctx, chunk)
+	}
+	if err == nil {
+		err = s.repo.Advance(ctx, broadcast.ID, chunk)
+	}
+	if err == nil {
+		s.metrics.AddBroadcastNotifications(string(broadcast.Type), chunk.Sent)
+		if chunk.Done {
+			s.log.InfoContext(ctx, "Broadcast completed",
+				slog.Int64("broadcast_id", broadcast.ID),
+				slog.Int("processed", broadcast.Processed+len(chunk.Notifications)),
+				slog.Int("sent", broadcast.Sent+chunk.Sent),
+			)
+		}
+		return true
+	}
+
+	var retryAt *time.Time
+	if !errors.Is(err, custom_errors.ErrUserNotFound) {
+		at := s.now().Add(s.config.RetryDelay)
+		retryAt = &at
+	}
+	s.log.ErrorContext(ctx, "Broadcast chunk failed",
+		slog.Int64("broadcast_id", broadcast.ID),
+		slog.Int("cursor", broadcast.Cursor),
+		slog.Bool("retry", retryAt != nil),
+		slog.String("error", err.Error()),
+	)
+	if err := s.repo.RecordFailure(ctx, broadcast.ID, err.Error(), retryAt); err != nil {
+		s.log.ErrorContext(ctx, "Failed to record broadcast failure",
+			slog.Int64("broadcast_id", broadcast.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+	return false
+}
+
+// send hands the chunk to the notification service and counts what was
+// sent. Recipients that are gone, suppressed or already notified are final;
+// any other failure fails the chunk so that it is retried.
+func (s *Service) send(ctx context.Context, chunk *model.BroadcastChunk) error {
+	if len(chunk.Notifications) == 0 {
+		return nil
+	}
+
+	errs, err := s.notifications.SaveNotifications(ctx, chunk.Notifications)
+	if err != nil {
+		return err
+	}
+
+	chunk.Sent = 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			chunk.Sent++
+		case errors.Is(err, custom_errors.ErrUserNotFound),
+			errors.Is(err, custom_errors.ErrInvalidInput),
+			errors.Is(err, model.ErrNotificationSuppressed),
+			errors.Is(err, model.ErrBroadcastDuplicate):
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// nextChunk resolves the recipients after the broadcast's cursor.
+func (s *Service) nextChunk(ctx context.Context, broadcast *model.Broadcast) (*model.BroadcastChunk, error) {
+	chunk := &model.BroadcastChunk{From: broadcast.Cursor}
+
+	var recipients []int64
+	if broadcast.FollowersOf > 0 {
+		followers, total, err := s.relationClient.GetFollowers(ctx, broadcast.FollowersOf, s.config.ChunkSize, broadcast.Cursor+1)
+		if err != nil {
+			return nil, err
+		}
+		recipients = followers
+		chunk.Cursor = broadcast.Cursor + 1
+		chunk.Total = total
+		chunk.Done = len(followers) < s.config.ChunkSize
+	} else {
+		from := min(broadcast.Cursor, len(broadcast.UserIDs))
+		to := min(from+s.config.ChunkSize, len(broadcast.UserIDs))
+		recipients = broadcast.UserIDs[from:to]
+		chunk.Cursor = to
+		chunk.Total = len(broadcast.UserIDs)
+		chunk.Done = to == len(broadcast.UserIDs)
+	}
+
+	now := s.now()
+	chunk.Notifications = make([]*model.Notification, 0, len(recipients))
+	for _, userID := range recipients {
+		chunk.Notifications = append(chunk.Notifications, broadcast.Notification(userID, now))
+	}
+	return chunk, nil
+}
@@ -0,0 +1,280 @@
+package broadcast_service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	broadcast_service "pinstack-notification-service/internal/application/broadcast"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type broadcastMocks struct {
+	repo           *mocks.BroadcastRepository
+	relationClient *mocks.RelationClient
+	notifications  *mocks.NotificationService
+}
+
+func newBroadcastService(t *testing.T, cfg broadcast_service.Config) (*broadcast_service.Service, broadcastMocks) {
+	m := broadcastMocks{
+		repo:           mocks.NewBroadcastRepository(t),
+		relationClient: mocks.NewRelationClient(t),
+		notifications:  mocks.NewNotificationService(t),
+	}
+	svc := broadcast_service.NewBroadcastService(logger.New("dev"), m.repo, m.relationClient, m.notifications, prometheus.NewPrometheusMetricsProvider(), cfg)
+	return svc, m
+}
+
+func TestService_CreateBroadcast(t *testing.T) {
+	payload := json.RawMessage(`{"title":"Maintenance tonight"}`)
+
+	tests := []struct {
+		name         string
+		broadcast    *model.Broadcast
+		wantUserIDs  []int64
+		wantPriority model.NotificationPriority
+		wantErr      error
+	}{
+		{
+			name:        "explicit users are deduplicated",
+			broadcast:   &model.Broadcast{Type: "announcement", Payload: payload, UserIDs: []int64{3, 1, 3, 2, 1}},
+			wantUserIDs: []int64{3, 1, 2},
+		},
+		{
+			name:         "followers segment",
+			broadcast:    &model.Broadcast{Type: "new_pin", Payload: payload, FollowersOf: 42, Priority: model.NotificationPriorityHigh},
+			wantPriority: model.NotificationPriorityHigh,
+		},
+		{
+			name:      "missing type",
+			broadcast: &model.Broadcast{Payload: payload, UserIDs: []int64{1}},
+			wantErr:   custom_errors.ErrInvalidInput,
+		},
+		{
+			name:      "no audience",
+			broadcast: &model.Broadcast{Type: "announcement", Payload: payload},
+			wantErr:   custom_errors.ErrInvalidInput,
+		},
+		{
+			name:      "both audiences",
+			broadcast: &model.Broadcast{Type: "announcement", Payload: payload, UserIDs: []int64{1}, FollowersOf: 42},
+			wantErr:   custom_errors.ErrInvalidInput,
+		},
+		{
+			name:      "invalid user ID",
+			broadcast: &model.Broadcast{Type: "announcement", Payload: payload, UserIDs: []int64{1, 0}},
+			wantErr:   custom_errors.ErrInvalidInput,
+		},
+		{
+			name:      "too many users",
+			broadcast: &model.Broadcast{Type: "announcement", Payload: payload, UserIDs: []int64{1, 2, 3, 4}},
+			wantErr:   custom_errors.ErrInvalidInput,
+		},
+		{
+			name:      "unknown priority",
+			broadcast: &model.Broadcast{Type: "announcement", Payload: payload, UserIDs: []int64{1}, Priority: "urgent"},
+			wantErr:   custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := newBroadcastService(t, broadcast_service.Config{MaxRecipients: 3})
+
+			if tt.wantErr == nil {
+				m.repo.On("Create", mock.Anything, mock.MatchedBy(func(b *model.Broadcast) bool {
+					return assert.ObjectsAreEqual(tt.wantUserIDs, b.UserIDs) &&
+						b.Priority == tt.wantPriority &&
+						b.Status == model.BroadcastStatusPending
+				})).Return(int64(7), nil)
+			}
+
+			id, err := svc.CreateBroadcast(context.Background(), tt.broadcast)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, id)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(7), id)
+		})
+	}
+}
+
+func TestService_GetBroadcast(t *testing.T) {
+	svc, m := newBroadcastService(t, broadcast_service.Config{})
+
+	m.repo.On("Get", mock.Anything, int64(7)).Return(&model.Broadcast{ID: 7, Status: model.BroadcastStatusRunning}, nil)
+	m.repo.On("Get", mock.Anything, int64(8)).Return(nil, model.ErrBroadcastNotFound)
+
+	broadcast, err := svc.GetBroadcast(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, model.BroadcastStatusRunning, broadcast.Status)
+
+	_, err = svc.GetBroadcast(context.Background(), 8)
+	assert.ErrorIs(t, err, model.ErrBroadcastNotFound)
+
+	_, err = svc.GetBroadcast(context.Background(), 0)
+	assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+}
+
+func TestService_ProcessDue(t *testing.T) {
+	payload := json.RawMessage(`{"pin_id":5}`)
+
+	recipients := func(notifications []*model.Notification) []int64 {
+		ids := make([]int64, 0, len(notifications))
+		for _, n := range notifications {
+			ids = append(ids, n.UserID)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name          string
+		broadcast     *model.Broadcast
+		mockSetup     func(m broadcastMocks)
+		wantProcessed int
+	}{
+		{
+			name:      "explicit users resume at the cursor",
+			broadcast: &model.Broadcast{ID: 1, Type: "announcement", Payload: payload, Priority: model.NotificationPriorityHigh, UserIDs: []int64{10, 11, 12, 13, 14, 15, 16}, Cursor: 2},
+			mockSetup: func(m broadcastMocks) {
+				m.notifications.On("SaveNotifications", mock.Anything, mock.MatchedBy(func(ns []*model.Notification) bool {
+					return assert.ObjectsAreEqual([]int64{12, 13, 14, 15}, recipients(ns)) &&
+						ns[0].Type == "announcement" && ns[0].BroadcastID == 1 && ns[0].Priority == model.NotificationPriorityHigh
+				})).Return([]error{nil, nil, nil, model.ErrBroadcastDuplicate}, nil)
+				m.repo.On("Advance", mock.Anything, int64(1), mock.MatchedBy(func(chunk *model.BroadcastChunk) bool {
+					return chunk.From == 2 && chunk.Cursor == 6 && chunk.Total == 7 && chunk.Sent == 3 && !chunk.Done
+				})).Return(nil)
+			},
+			wantProcessed: 1,
+		},
+		{
+			name:      "final recipient errors do not fail the chunk",
+			broadcast: &model.Broadcast{ID: 2, Type: "announcement", UserIDs: []int64{10, 11, 12, 13}, Cursor: 0},
+			mockSetup: func(m broadcastMocks) {
+				m.notifications.On("SaveNotifications", mock.Anything, mock.Anything).
+					Return([]error{custom_errors.ErrUserNotFound, model.ErrBroadcastDuplicate, model.ErrNotificationSuppressed, nil}, nil)
+				m.repo.On("Advance", mock.Anything, int64(2), mock.MatchedBy(func(chunk *model.BroadcastChunk) bool {
+					return chunk.Cursor == 4 && chunk.Sent == 1 && chunk.Done
+				})).Return(nil)
+			},
+			wantProcessed: 1,
+		},
+		{
+			name:      "followers are fetched page by page",
+			broadcast: &model.Broadcast{ID: 3, Type: "new_pin", FollowersOf: 42, Cursor: 1},
+			mockSetup: func(m broadcastMocks) {
+				m.relationClient.On("GetFollowers", mock.Anything, int64(42), 4, 2).Return([]int64{20, 21, 22, 23}, 9, nil)
+				m.notifications.On("SaveNotifications", mock.Anything, mock.MatchedBy(func(ns []*model.Notification) bool {
+					return assert.ObjectsAreEqual([]int64{20, 21, 22, 23}, recipients(ns))
+				})).Return([]error{nil, nil, nil, nil}, nil)
+				m.repo.On("Advance", mock.Anything, int64(3), mock.MatchedBy(func(chunk *model.BroadcastChunk) bool {
+					return chunk.From == 1 && chunk.Cursor == 2 && chunk.Total == 9 && chunk.Sent == 4 && !chunk.Done
+				})).Return(nil)
+			},
+			wantProcessed: 1,
+		},
+		{
+			name:      "short follower page finishes the broadcast",
+			broadcast: &model.Broadcast{ID: 4, Type: "new_pin", FollowersOf: 42, Cursor: 2},
+			mockSetup: func(m broadcastMocks) {
+				m.relationClient.On("GetFollowers", mock.Anything, int64(42), 4, 3).Return([]int64{24}, 9, nil)
+				m.notifications.On("SaveNotifications", mock.Anything, mock.Anything).Return([]error{nil}, nil)
+				m.repo.On("Advance", mock.Anything, int64(4), mock.MatchedBy(func(chunk *model.BroadcastChunk) bool {
+					return chunk.Cursor == 3 && chunk.Done
+				})).Return(nil)
+			},
+			wantProcessed: 1,
+		},
+		{
+			name:      "empty follower page finishes without sending",
+			broadcast: &model.Broadcast{ID: 5, Type: "new_pin", FollowersOf: 42, Cursor: 3},
+			mockSetup: func(m broadcastMocks) {
+				m.relationClient.On("GetFollowers", mock.Anything, int64(42), 4, 4).Return([]int64{}, 9, nil)
+				m.repo.On("Advance", mock.Anything, int64(5), mock.MatchedBy(func(chunk *model.BroadcastChunk) bool {
+					return chunk.Done && chunk.Sent == 0
+				})).Return(nil)
+			},
+			wantProcessed: 1,
+		},
+		{
+			name:      "relation service error is retried",
+			broadcast: &model.Broadcast{ID: 6, Type: "new_pin", FollowersOf: 42},
+			mockSetup: func(m broadcastMocks) {
+				m.relationClient.On("GetFollowers", mock.Anything, int64(42), 4, 1).Return(nil, 0, custom_errors.ErrExternalServiceError)
+				m.repo.On("RecordFailure", mock.Anything, int64(6), custom_errors.ErrExternalServiceError.Error(), mock.MatchedBy(func(retryAt *time.Time) bool {
+					return retryAt != nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:      "missing followed user fails the broadcast",
+			broadcast: &model.Broadcast{ID: 7, Type: "new_pin", FollowersOf: 42},
+			mockSetup: func(m broadcastMocks) {
+				m.relationClient.On("GetFollowers", mock.Anything, int64(42), 4, 1).Return(nil, 0, custom_errors.ErrUserNotFound)
+				m.repo.On("RecordFailure", mock.Anything, int64(7), custom_errors.ErrUserNotFound.Error(), (*time.Time)(nil)).Return(nil)
+			},
+		},
+		{
+			name:      "transient recipient error retries the chunk",
+			broadcast: &model.Broadcast{ID: 8, Type: "announcement", UserIDs: []int64{10, 11}},
+			mockSetup: func(m broadcastMocks) {
+				m.notifications.On("SaveNotifications", mock.Anything, mock.Anything).
+					Return([]error{nil, custom_errors.ErrExternalServiceError}, nil)
+				m.repo.On("RecordFailure", mock.Anything, int64(8), custom_errors.ErrExternalServiceError.Error(), mock.MatchedBy(func(retryAt *time.Time) bool {
+					return retryAt != nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:      "save error is retried",
+			broadcast: &model.Broadcast{ID: 9, Type: "announcement", UserIDs: []int64{10}},
+			mockSetup: func(m broadcastMocks) {
+				m.notifications.On("SaveNotifications", mock.Anything, mock.Anything).Return(nil, custom_errors.ErrDatabaseQuery)
+				m.repo.On("RecordFailure", mock.Anything, int64(9), mock.Anything, mock.MatchedBy(func(retryAt *time.Time) bool {
+					return retryAt != nil
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := newBroadcastService(t, broadcast_service.Config{ChunkSize: 4, Lease: time.Minute})
+
+			m.repo.On("ClaimDue", mock.Anything, mock.AnythingOfType("time.Time"), time.Minute, 10).Return([]*model.Broadcast{tt.broadcast}, nil)
+			tt.mockSetup(m)
+
+			processed, err := svc.ProcessDue(context.Background(), 10)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProcessed, processed)
+		})
+	}
+}
+
+func TestService_ProcessDue_Errors(t *testing.T) {
+	svc, m := newBroadcastService(t, broadcast_service.Config{})
+
+	_, err := svc.ProcessDue(context.Background(), 0)
+	assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+
+	claimErr := errors.New("connection refused")
+	m.repo.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, 10).Return(nil, claimErr)
+
+	processed, err := svc.ProcessDue(context.Background(), 10)
+	assert.ErrorIs(t, err, claimErr)
+	assert.Zero(t, processed)
+}
@@ -0,0 +1,382 @@
+package delivery_service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"slices"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+const (
+	DefaultMaxAttempts = 5
+	DefaultBackoffBase = 30 * time.Second
+	DefaultBackoffMax  = time.Hour
+	DefaultLease       = 5 * time.Minute
+)
+
+// Config decides which channels a notification goes to and how failed
+// sends are retried. ChannelsByType overrides DefaultChannels per type.
+// Unsubscribe verifies links from messages; without it Unsubscribe is refused.
+type Config struct {
+	DefaultChannels []model.Channel
+	ChannelsByType  map[events.EventType][]model.Channel
+	MaxAttempts     int
+	BackoffBase     time.Duration
+	BackoffMax      time.Duration
+	Lease           time.Duration
+	Unsubscribe     *UnsubscribeTokens
+}
+
+// Service dispatches delivered notifications into the delivery queue and
+// works the queue, sending through the registered channels with retries.
+type Service struct {
+	deliveryRepo     ports.DeliveryRepository
+	preferenceRepo   ports.PreferenceRepository
+	notificationRepo ports.NotificationRepository
+	userClient       ports.Client
+	channels         map[model.Channel]ports.Channel
+	channelNames     []model.Channel
+	log              ports.Logger
+	metrics          ports.MetricsProvider
+	config           Config
+	now              func() time.Time
+}
+
+func NewDeliveryService(
+	log ports.Logger,
+	deliveryRepo ports.DeliveryRepository,
+	preferenceRepo ports.PreferenceRepository,
+	notificationRepo ports.NotificationRepository,
+	userClient ports.Client,
+	metrics ports.MetricsProvider,
+	cfg Config,
+	channels ...ports.Channel,
+) *Service {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = DefaultBackoffBase
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = DefaultBackoffMax
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = DefaultLease
+	}
+
+	registered := make(map[model.Channel]ports.Channel, len(channels))
+	names := make([]model.Channel, 0, len(channels))
+	for _, ch := range channels {
+		if _, ok := registered[ch.Name()]; !ok {
+			names = append(names, ch.Name())
+		}
+		registered[ch.Name()] = ch
+	}
+	slices.Sort(names)
+
+	return &Service{
+		deliveryRepo:     deliveryRepo,
+		preferenceRepo:   preferenceRepo,
+		notificationRepo: notificationRepo,
+		userClient:       userClient,
+		channels:         registered,
+		channelNames:     names,
+		log:              log,
+		metrics:          metrics,
+		config:           cfg,
+		now:              time.Now,
+	}
+}
+
+// Dispatch queues the notification for every channel configured for its
+// type, registered in this process and not disabled by the recipient.
+// Priority does not change the channels: it only orders the queue.
+func (s *Service) Dispatch(ctx context.Context, notification *model.Notification) (queued int, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("dispatch_notification", err == nil)
+	}()
+
+	if notification == nil || notification.ID <= 0 || notification.UserID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid notification for dispatch")
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	candidates := s.channelsFor(notification.Type)
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	preferences, err := s.preferenceRepo.ListChannelPreferences(ctx, notification.UserID)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to load channel preferences",
+			slog.Int64("user_id", notification.UserID),
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+
+	now := s.now()
+	deliveries := make([]*model.Delivery, 0, len(candidates))
+	for _, channel := range candidates {
+		if !model.ChannelEnabled(preferences, notification.Type, channel) {
+			s.metrics.IncrementChannelDeliveries(string(channel), "opted_out")
+			continue
+		}
+		deliveries = append(deliveries, &model.Delivery{
+			NotificationID: notification.ID,
+			UserID:         notification.UserID,
+			Channel:        channel,
+			Status:         model.DeliveryStatusPending,
+			NextAttemptAt:  now,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	if err := s.deliveryRepo.Enqueue(ctx, deliveries); err != nil {
+		s.log.ErrorContext(ctx, "Failed to enqueue deliveries",
+			slog.Int64("notification_id", notification.ID),
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+
+	for _, d := range deliveries {
+		s.metrics.IncrementChannelDeliveries(string(d.Channel), "queued")
+	}
+	s.log.DebugContext(ctx, "Notification dispatched to channels",
+		slog.Int64("notification_id", notification.ID),
+		slog.Int("channels", len(deliveries)),
+	)
+	return len(deliveries), nil
+}
+
+func (s *Service) channelsFor(notificationType events.EventType) []model.Channel {
+	configured, ok := s.config.ChannelsByType[notificationType]
+	if !ok {
+		configured = s.config.DefaultChannels
+	}
+
+	channels := make([]model.Channel, 0, len(configured))
+	for _, channel := range configured {
+		if _, ok := s.channels[channel]; ok {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// ProcessPending claims up to batchSize due deliveries and sends them. It
+// returns how many were sent; failures are rescheduled or given up on.
+func (s *Service) ProcessPending(ctx context.Context, batchSize int) (sent int, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("process_pending_deliveries", err == nil)
+	}()
+
+	if batchSize <= 0 {
+		s.log.ErrorContext(ctx, "Invalid delivery batch size", slog.Int("batch_size", batchSize))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	if len(s.channelNames) == 0 {
+		return 0, nil
+	}
+
+	deliveries, err := s.deliveryRepo.ClaimPending(ctx, s.now(), s.config.Lease, batchSize, s.channelNames)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to claim pending deliveries", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return sent, err
+		}
+		if s.process(ctx, delivery) {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+func (s *Service) process(ctx context.Context, delivery *model.Delivery) bool {
+	channel, ok := s.channels[delivery.Channel]
+	if !ok {
+		// ClaimPending only hands out registered channels, so this delivery
+		// can never be sent here; give it up rather than leave it to be
+		// claimed again.
+		s.fail(ctx, delivery, "no adapter registered for channel")
+		return false
+	}
+
+	notification, err := s.notificationRepo.GetByID(ctx, delivery.NotificationID)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
+			s.fail(ctx, delivery, "notification is no longer available")
+			return false
+		}
+		s.retry(ctx, delivery, err)
+		return false
+	}
+
+	recipient, err := s.userClient.GetUser(ctx, delivery.UserID)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrUserNotFound) {
+			s.fail(ctx, delivery, "recipient not found")
+			return false
+		}
+		s.retry(ctx, delivery, err)
+		return false
+	}
+
+	start := time.Now()
+	err = channel.Send(ctx, notification, recipient)
+	s.metrics.RecordChannelDeliveryDuration(string(delivery.Channel), time.Since(start))
+	if err != nil {
+		if errors.Is(err, model.ErrPermanentDeliveryFailure) {
+			s.fail(ctx, delivery, err.Error())
+			return false
+		}
+		s.retry(ctx, delivery, err)
+		return false
+	}
+
+	if err := s.deliveryRepo.MarkSent(ctx, delivery.ID); err != nil {
+		s.log.ErrorContext(ctx, "Failed to mark delivery sent",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+	s.metrics.IncrementChannelDeliveries(string(delivery.Channel), "sent")
+	return true
+}
+
+func (s *Service) retry(ctx context.Context, delivery *model.Delivery, cause error) {
+	if delivery.Attempts >= s.config.MaxAttempts {
+		s.fail(ctx, delivery, cause.Error())
+		return
+	}
+
+	nextAttemptAt := s.now().Add(s.backoff(delivery.Attempts))
+	s.log.WarnContext(ctx, "Delivery failed, will retry",
+		slog.Int64("delivery_id", delivery.ID),
+		slog.String("channel", string(delivery.Channel)),
+		slog.Int("attempts", delivery.Attempts),
+		slog.Time("next_attempt_at", nextAttemptAt),
+		slog.String("error", cause.Error()),
+	)
+	if err := s.deliveryRepo.MarkRetry(ctx, delivery.ID, nextAttemptAt, cause.Error()); err != nil {
+		s.log.ErrorContext(ctx, "Failed to reschedule delivery",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+	s.metrics.IncrementChannelDeliveries(string(delivery.Channel), "retry")
+}
+
+func (s *Service) fail(ctx context.Context, delivery *model.Delivery, reason string) {
+	s.log.ErrorContext(ctx, "Delivery failed permanently",
+		slog.Int64("delivery_id", delivery.ID),
+		slog.Int64("notification_id", delivery.NotificationID),
+		slog.String("channel", string(delivery.Channel)),
+		slog.Int("attempts", delivery.Attempts),
+		slog.String("reason", reason),
+	)
+	if err := s.deliveryRepo.MarkFailed(ctx, delivery.ID, reason); err != nil {
+		s.log.ErrorContext(ctx, "Failed to mark delivery failed",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+	s.metrics.IncrementChannelDeliveries(string(delivery.Channel), "failed")
+}
+
+// backoff doubles the wait after every attempt, capped at BackoffMax.
+func (s *Service) backoff(attempts int) time.Duration {
+	wait := s.config.BackoffBase
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= s.config.BackoffMax {
+			return s.config.BackoffMax
+		}
+	}
+	return wait
+}
+
+func (s *Service) SetChannelPreference(ctx context.Context, preference *model.ChannelPreference) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("set_channel_preference", err == nil)
+	}()
+
+	if preference == nil || preference.UserID <= 0 || !preference.Channel.IsValid() {
+		s.log.ErrorContext(ctx, "Invalid channel preference")
+		return custom_errors.ErrInvalidInput
+	}
+
+	return s.preferenceRepo.UpsertChannelPreference(ctx, preference)
+}
+
+func (s *Service) ListChannelPreferences(ctx context.Context, userID int64) (preferences []*model.ChannelPreference, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("list_channel_preferences", err == nil)
+	}()
+
+	if userID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	return s.preferenceRepo.ListChannelPreferences(ctx, userID)
+}
+
+// Unsubscribe turns off the channel named by a signed unsubscribe token for
+// every notification type.
+func (s *Service) Unsubscribe(ctx context.Context, token string) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("unsubscribe", err == nil)
+	}()
+
+	if s.config.Unsubscribe == nil {
+		s.log.ErrorContext(ctx, "Unsubscribe links are not configured")
+		return custom_errors.ErrOperationNotAllowed
+	}
+
+	userID, channel, err := s.config.Unsubscribe.Parse(token)
+	if err != nil {
+		s.log.WarnContext(ctx, "Invalid unsubscribe token")
+		return err
+	}
+
+	s.log.InfoContext(ctx, "Unsubscribing user from channel",
+		slog.Int64("user_id", userID),
+		slog.String("channel", string(channel)),
+	)
+	return s.preferenceRepo.UpsertChannelPreference(ctx, &model.ChannelPreference{
+		UserID:  userID,
+		Channel: channel,
+		Enabled: false,
+	})
+}
+
+func (s *Service) ListNotificationDeliveries(ctx context.Context, notificationID int64) (deliveries []*model.Delivery, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("list_notification_deliveries", err == nil)
+	}()
+
+	if notificationID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", notificationID))
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	return s.deliveryRepo.ListByNotification(ctx, notificationID)
+}
@@ -0,0 +1,312 @@
+package delivery_service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	delivery_service "pinstack-notification-service/internal/application/delivery"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/fake"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type deliveryMocks struct {
+	deliveryRepo     *mocks.DeliveryRepository
+	preferenceRepo   *mocks.PreferenceRepository
+	notificationRepo *mocks.NotificationRepository
+	userClient       *mocks.Client
+}
+
+func newDeliveryService(t *testing.T, cfg delivery_service.Config, channels ...*fake.Channel) (*delivery_service.Service, deliveryMocks) {
+	m := deliveryMocks{
+		deliveryRepo:     mocks.NewDeliveryRepository(t),
+		preferenceRepo:   mocks.NewPreferenceRepository(t),
+		notificationRepo: mocks.NewNotificationRepository(t),
+		userClient:       mocks.NewClient(t),
+	}
+	log := logger.New("dev")
+	metrics := prometheus.NewPrometheusMetricsProvider()
+
+	registered := make([]ports.Channel, 0, len(channels))
+	for _, ch := range channels {
+		registered = append(registered, ch)
+	}
+
+	svc := delivery_service.NewDeliveryService(log, m.deliveryRepo, m.preferenceRepo, m.notificationRepo, m.userClient, metrics, cfg, registered...)
+	return svc, m
+}
+
+func channelsOf(deliveries []*model.Delivery) []model.Channel {
+	channels := make([]model.Channel, 0, len(deliveries))
+	for _, d := range deliveries {
+		channels = append(channels, d.Channel)
+	}
+	return channels
+}
+
+func TestService_Dispatch(t *testing.T) {
+	cfg := delivery_service.Config{
+		DefaultChannels: []model.Channel{model.ChannelEmail, model.ChannelPush},
+		ChannelsByType: map[events.EventType][]model.Channel{
+			events.EventTypeFollowCreated: {model.ChannelPush},
+		},
+	}
+	notification := &model.Notification{ID: 10, UserID: 1, Type: "relation"}
+
+	tests := []struct {
+		name          string
+		notification  *model.Notification
+		preferences   []*model.ChannelPreference
+		unregistered  model.Channel
+		enqueueErr    error
+		wantChannels  []model.Channel
+		wantQueued    int
+		wantErr       error
+		skipRepoCalls bool
+	}{
+		{
+			name:         "default channels are queued",
+			notification: notification,
+			wantChannels: []model.Channel{model.ChannelEmail, model.ChannelPush},
+			wantQueued:   2,
+		},
+		{
+			name:         "type override replaces default channels",
+			notification: &model.Notification{ID: 11, UserID: 1, Type: events.EventTypeFollowCreated},
+			wantChannels: []model.Channel{model.ChannelPush},
+			wantQueued:   1,
+		},
+		{
+			name:         "channels without an adapter are skipped",
+			notification: notification,
+			unregistered: model.ChannelPush,
+			wantChannels: []model.Channel{model.ChannelEmail},
+			wantQueued:   1,
+		},
+		{
+			name:         "wildcard opt-out disables channel",
+			notification: notification,
+			preferences: []*model.ChannelPreference{
+				{UserID: 1, Channel: model.ChannelEmail, Enabled: false},
+			},
+			wantChannels: []model.Channel{model.ChannelPush},
+			wantQueued:   1,
+		},
+		{
+			name:         "type preference wins over wildcard",
+			notification: notification,
+			preferences: []*model.ChannelPreference{
+				{UserID: 1, Channel: model.ChannelEmail, Enabled: false},
+				{UserID: 1, Type: "relation", Channel: model.ChannelEmail, Enabled: true},
+				{UserID: 1, Type: "relation", Channel: model.ChannelPush, Enabled: false},
+			},
+			wantChannels: []model.Channel{model.ChannelEmail},
+			wantQueued:   1,
+		},
+		{
+			name:         "urgent notification keeps the type's channels",
+			notification: &model.Notification{ID: 12, UserID: 1, Type: events.EventTypeFollowCreated, Priority: model.NotificationPriorityCritical},
+			wantChannels: []model.Channel{model.ChannelPush},
+			wantQueued:   1,
+		},
+		{
+			name:         "enqueue error is returned",
+			notification: notification,
+			enqueueErr:   custom_errors.ErrDatabaseQuery,
+			wantChannels: []model.Channel{model.ChannelEmail, model.ChannelPush},
+			wantErr:      custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:          "invalid notification",
+			notification:  &model.Notification{UserID: 1},
+			wantErr:       custom_errors.ErrInvalidInput,
+			skipRepoCalls: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var channels []*fake.Channel
+			for _, name := range []model.Channel{model.ChannelEmail, model.ChannelPush} {
+				if name != tt.unregistered {
+					channels = append(channels, fake.NewChannel(name, nil))
+				}
+			}
+			svc, m := newDeliveryService(t, cfg, channels...)
+
+			if !tt.skipRepoCalls {
+				m.preferenceRepo.On("ListChannelPreferences", mock.Anything, int64(1)).Return(tt.preferences, nil)
+				if len(tt.wantChannels) > 0 {
+					m.deliveryRepo.On("Enqueue", mock.Anything, mock.MatchedBy(func(deliveries []*model.Delivery) bool {
+						return assert.ObjectsAreEqual(tt.wantChannels, channelsOf(deliveries))
+					})).Return(tt.enqueueErr)
+				}
+			}
+
+			queued, err := svc.Dispatch(context.Background(), tt.notification)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQueued, queued)
+		})
+	}
+}
+
+func TestService_ProcessPending(t *testing.T) {
+	cfg := delivery_service.Config{
+		MaxAttempts: 3,
+		BackoffBase: time.Minute,
+		BackoffMax:  10 * time.Minute,
+	}
+	notification := &model.Notification{ID: 10, UserID: 1, Type: "relation"}
+	recipient := &model.User{ID: 1, Username: "testuser"}
+
+	tests := []struct {
+		name        string
+		delivery    *model.Delivery
+		channelErr  error
+		setup       func(m deliveryMocks)
+		wantSent    int
+		wantOutcome string
+	}{
+		{
+			name:     "sent delivery is marked sent",
+			delivery: &model.Delivery{ID: 1, NotificationID: 10, UserID: 1, Channel: model.ChannelEmail, Attempts: 1},
+			setup: func(m deliveryMocks) {
+				m.notificationRepo.On("GetByID", mock.Anything, int64(10)).Return(notification, nil)
+				m.userClient.On("GetUser", mock.Anything, int64(1)).Return(recipient, nil)
+				m.deliveryRepo.On("MarkSent", mock.Anything, int64(1)).Return(nil)
+			},
+			wantSent: 1,
+		},
+		{
+			name:       "transient failure is retried with backoff",
+			delivery:   &model.Delivery{ID: 2, NotificationID: 10, UserID: 1, Channel: model.ChannelEmail, Attempts: 2},
+			channelErr: errors.New("smtp timeout"),
+			setup: func(m deliveryMocks) {
+				m.notificationRepo.On("GetByID", mock.Anything, int64(10)).Return(notification, nil)
+				m.userClient.On("GetUser", mock.Anything, int64(1)).Return(recipient, nil)
+				m.deliveryRepo.On("MarkRetry", mock.Anything, int64(2), mock.MatchedBy(func(next time.Time) bool {
+					wait := time.Until(next)
+					return wait > time.Minute && wait <= 2*time.Minute
+				}), "smtp timeout").Return(nil)
+			},
+		},
+		{
+			name:       "last attempt fails permanently",
+			delivery:   &model.Delivery{ID: 3, NotificationID: 10, UserID: 1, Channel: model.ChannelEmail, Attempts: 3},
+			channelErr: errors.New("smtp timeout"),
+			setup: func(m deliveryMocks) {
+				m.notificationRepo.On("GetByID", mock.Anything, int64(10)).Return(notification, nil)
+				m.userClient.On("GetUser", mock.Anything, int64(1)).Return(recipient, nil)
+				m.deliveryRepo.On("MarkFailed", mock.Anything, int64(3), "smtp timeout").Return(nil)
+			},
+		},
+		{
+			name:       "permanent channel error is not retried",
+			delivery:   &model.Delivery{ID: 4, NotificationID: 10, UserID: 1, Channel: model.ChannelEmail, Attempts: 1},
+			channelErr: fmt.Errorf("bad address: %w", model.ErrPermanentDeliveryFailure),
+			setup: func(m deliveryMocks) {
+				m.notificationRepo.On("GetByID", mock.Anything, int64(10)).Return(notification, nil)
+				m.userClient.On("GetUser", mock.Anything, int64(1)).Return(recipient, nil)
+				m.deliveryRepo.On("MarkFailed", mock.Anything, int64(4), mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:     "removed notification is not sent",
+			delivery: &model.Delivery{ID: 5, NotificationID: 10, UserID: 1, Channel: model.ChannelEmail, Attempts: 1},
+			setup: func(m deliveryMocks) {
+				m.notificationRepo.On("GetByID", mock.Anything, int64(10)).Return(nil, custom_errors.ErrNotificationNotFound)
+				m.deliveryRepo.On("MarkFailed", mock.Anything, int64(5), mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:     "channel without adapter fails permanently",
+			delivery: &model.Delivery{ID: 6, NotificationID: 10, UserID: 1, Channel: model.ChannelPush, Attempts: 1},
+			setup: func(m deliveryMocks) {
+				m.deliveryRepo.On("MarkFailed", mock.Anything, int64(6), "no adapter registered for channel").Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email := fake.NewChannel(model.ChannelEmail, nil)
+			email.FailWith(tt.channelErr)
+			svc, m := newDeliveryService(t, cfg, email)
+
+			m.deliveryRepo.On("ClaimPending", mock.Anything, mock.Anything, delivery_service.DefaultLease, 10, []model.Channel{model.ChannelEmail}).
+				Return([]*model.Delivery{tt.delivery}, nil)
+			tt.setup(m)
+
+			sent, err := svc.ProcessPending(context.Background(), 10)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSent, sent)
+			assert.Len(t, email.Sent(), tt.wantSent)
+		})
+	}
+}
+
+func TestService_ProcessPending_InvalidBatchSize(t *testing.T) {
+	svc, _ := newDeliveryService(t, delivery_service.Config{})
+
+	_, err := svc.ProcessPending(context.Background(), 0)
+
+	assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+}
+
+func TestService_SetChannelPreference(t *testing.T) {
+	tests := []struct {
+		name       string
+		preference *model.ChannelPreference
+		wantErr    error
+	}{
+		{
+			name:       "valid preference is saved",
+			preference: &model.ChannelPreference{UserID: 1, Channel: model.ChannelPush, Enabled: false},
+		},
+		{
+			name:       "unknown channel",
+			preference: &model.ChannelPreference{UserID: 1, Channel: "sms"},
+			wantErr:    custom_errors.ErrInvalidInput,
+		},
+		{
+			name:       "invalid user",
+			preference: &model.ChannelPreference{Channel: model.ChannelPush},
+			wantErr:    custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := newDeliveryService(t, delivery_service.Config{})
+			if tt.wantErr == nil {
+				m.preferenceRepo.On("UpsertChannelPreference", mock.Anything, tt.preference).Return(nil)
+			}
+
+			err := svc.SetChannelPreference(context.Background(), tt.preference)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package delivery_service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	model "pinstack-notification-service/internal/domain/models"
+	"strconv"
+	"strings"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// UnsubscribeTokens signs per-user unsubscribe links. A token names a user
+// and a channel and is valid for as long as the secret is unchanged, so
+// links in old emails keep working.
+type UnsubscribeTokens struct {
+	secret  []byte
+	baseURL string
+}
+
+func NewUnsubscribeTokens(secret, baseURL string) *UnsubscribeTokens {
+	return &UnsubscribeTokens{secret: []byte(secret), baseURL: baseURL}
+}
+
+func (u *UnsubscribeTokens) Token(userID int64, channel model.Channel) string {
+	payload := fmt.Sprintf("%d:%s", userID, channel)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(u.sign(payload))
+}
+
+// URL returns the unsubscribe link with the token in the "token" query parameter.
+func (u *UnsubscribeTokens) URL(userID int64, channel model.Channel) string {
+	link, err := url.Parse(u.baseURL)
+	if err != nil {
+		return ""
+	}
+	query := link.Query()
+	query.Set("token", u.Token(userID, channel))
+	link.RawQuery = query.Encode()
+	return link.String()
+}
+
+func (u *UnsubscribeTokens) Parse(token string) (int64, model.Channel, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, "", custom_errors.ErrInvalidInput
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return 0, "", custom_errors.ErrInvalidInput
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, u.sign(string(payload))) {
+		return 0, "", custom_errors.ErrInvalidInput
+	}
+
+	userIDStr, channelStr, ok := strings.Cut(string(payload), ":")
+	if !ok {
+		return 0, "", custom_errors.ErrInvalidInput
+	}
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	channel := model.Channel(channelStr)
+	if err != nil || userID <= 0 || !channel.IsValid() {
+		return 0, "", custom_errors.ErrInvalidInput
+	}
+
+	return userID, channel, nil
+}
+
+func (u *UnsubscribeTokens) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, u.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
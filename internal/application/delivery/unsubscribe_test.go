@@ -0,0 +1,79 @@
+package delivery_service_test
+
+import (
+	"context"
+	"net/url"
+	delivery_service "pinstack-notification-service/internal/application/delivery"
+	model "pinstack-notification-service/internal/domain/models"
+	"strings"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsubscribeTokens(t *testing.T) {
+	tokens := delivery_service.NewUnsubscribeTokens("secret", "https://pinstack.local/unsubscribe?lang=en")
+
+	link, err := url.Parse(tokens.URL(42, model.ChannelEmail))
+	require.NoError(t, err)
+	assert.Equal(t, "en", link.Query().Get("lang"))
+
+	userID, channel, err := tokens.Parse(link.Query().Get("token"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+	assert.Equal(t, model.ChannelEmail, channel)
+
+	valid := tokens.Token(42, model.ChannelEmail)
+	payload, _, _ := strings.Cut(valid, ".")
+	forged := delivery_service.NewUnsubscribeTokens("secret", "").Token(43, model.ChannelEmail)
+	_, forgedSignature, _ := strings.Cut(forged, ".")
+
+	for name, token := range map[string]string{
+		"empty":              "",
+		"no signature":       payload,
+		"signature mismatch": payload + "." + forgedSignature,
+		"other secret":       delivery_service.NewUnsubscribeTokens("other", "").Token(42, model.ChannelEmail),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := tokens.Parse(token)
+			assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+		})
+	}
+}
+
+func TestService_Unsubscribe(t *testing.T) {
+	tokens := delivery_service.NewUnsubscribeTokens("secret", "https://pinstack.local/unsubscribe")
+
+	t.Run("valid token disables channel for all types", func(t *testing.T) {
+		svc, m := newDeliveryService(t, delivery_service.Config{Unsubscribe: tokens})
+		m.preferenceRepo.On("UpsertChannelPreference", mock.Anything, &model.ChannelPreference{
+			UserID:  42,
+			Channel: model.ChannelEmail,
+			Enabled: false,
+		}).Return(nil)
+
+		err := svc.Unsubscribe(context.Background(), tokens.Token(42, model.ChannelEmail))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		svc, _ := newDeliveryService(t, delivery_service.Config{Unsubscribe: tokens})
+
+		err := svc.Unsubscribe(context.Background(), "garbage")
+
+		assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+	})
+
+	t.Run("unsubscribe links not configured", func(t *testing.T) {
+		svc, _ := newDeliveryService(t, delivery_service.Config{})
+
+		err := svc.Unsubscribe(context.Background(), tokens.Token(42, model.ChannelEmail))
+
+		assert.ErrorIs(t, err, custom_errors.ErrOperationNotAllowed)
+	})
+}
@@ -0,0 +1,74 @@
+package device_service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// MaxTokenLength bounds a device token; FCM tokens are the longest at a
+// few hundred bytes.
+const MaxTokenLength = 4096
+
+// Service keeps the registry of push device tokens.
+type Service struct {
+	repo    ports.DeviceTokenRepository
+	log     ports.Logger
+	metrics ports.MetricsProvider
+}
+
+func NewDeviceService(log ports.Logger, repo ports.DeviceTokenRepository, metrics ports.MetricsProvider) *Service {
+	return &Service{
+		repo:    repo,
+		log:     log,
+		metrics: metrics,
+	}
+}
+
+func (s *Service) RegisterDeviceToken(ctx context.Context, token *model.DeviceToken) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("register_device_token", err == nil)
+	}()
+
+	if token == nil || token.UserID <= 0 || token.Token == "" || len(token.Token) > MaxTokenLength || !token.Platform.IsValid() {
+		s.log.ErrorContext(ctx, "Invalid device token")
+		return custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Registering device token",
+		slog.Int64("user_id", token.UserID),
+		slog.String("platform", string(token.Platform)),
+		slog.String("locale", token.Locale),
+	)
+	return s.repo.Register(ctx, token)
+}
+
+func (s *Service) UnregisterDeviceToken(ctx context.Context, userID int64, token string) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("unregister_device_token", err == nil)
+	}()
+
+	if userID <= 0 || token == "" {
+		s.log.ErrorContext(ctx, "Invalid device token", slog.Int64("user_id", userID))
+		return custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Unregistering device token", slog.Int64("user_id", userID))
+	return s.repo.Unregister(ctx, userID, token)
+}
+
+func (s *Service) ListDeviceTokens(ctx context.Context, userID int64) (tokens []*model.DeviceToken, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("list_device_tokens", err == nil)
+	}()
+
+	if userID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	return s.repo.ListByUser(ctx, userID)
+}
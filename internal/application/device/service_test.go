@@ -0,0 +1,70 @@
+package device_service_test
+
+import (
+	"context"
+	device_service "pinstack-notification-service/internal/application/device"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_RegisterDeviceToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   *model.DeviceToken
+		repoErr error
+		wantErr error
+	}{
+		{
+			name:  "valid token is stored",
+			token: &model.DeviceToken{UserID: 1, Token: "fcm-token", Platform: model.DevicePlatformAndroid, Locale: "en"},
+		},
+		{
+			name:    "unknown platform",
+			token:   &model.DeviceToken{UserID: 1, Token: "token", Platform: "windows"},
+			wantErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:    "missing token",
+			token:   &model.DeviceToken{UserID: 1, Platform: model.DevicePlatformIOS},
+			wantErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:    "oversized token",
+			token:   &model.DeviceToken{UserID: 1, Token: strings.Repeat("x", device_service.MaxTokenLength+1), Platform: model.DevicePlatformIOS},
+			wantErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:    "repository error is returned",
+			token:   &model.DeviceToken{UserID: 1, Token: "apns-token", Platform: model.DevicePlatformIOS},
+			repoErr: custom_errors.ErrDatabaseQuery,
+			wantErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewDeviceTokenRepository(t)
+			if tt.wantErr == nil || tt.repoErr != nil {
+				repo.On("Register", mock.Anything, tt.token).Return(tt.repoErr)
+			}
+
+			svc := device_service.NewDeviceService(logger.New("dev"), repo, prometheus.NewPrometheusMetricsProvider())
+			err := svc.RegisterDeviceToken(context.Background(), tt.token)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package digest_service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"slices"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+const (
+	DefaultSendHour = 8
+	DefaultMaxItems = 50
+	DefaultLease    = 5 * time.Minute
+
+	// metricsChannel labels digests in the channel delivery metrics.
+	metricsChannel = string(model.ChannelDigest)
+)
+
+// Config decides when digests go out: daily digests at SendHour UTC,
+// weekly ones at SendHour on WeeklyDay. A digest lists at most MaxItems
+// notifications, the newest ones, and counts the rest.
+type Config struct {
+	SendHour  int
+	WeeklyDay time.Weekday
+	MaxItems  int
+	Lease     time.Duration
+}
+
+// Service sends periodic digests of unread notifications. Every run moves
+// the subscription watermark to the end of the window it looked at, so a
+// notification is summarized at most once whether or not a digest was sent.
+type Service struct {
+	repo             ports.DigestRepository
+	notificationRepo ports.NotificationRepository
+	preferenceRepo   ports.PreferenceRepository
+	userClient       ports.Client
+	sender           ports.DigestSender
+	log              ports.Logger
+	metrics          ports.MetricsProvider
+	config           Config
+	now              func() time.Time
+}
+
+func NewDigestService(
+	log ports.Logger,
+	repo ports.DigestRepository,
+	notificationRepo ports.NotificationRepository,
+	preferenceRepo ports.PreferenceRepository,
+	userClient ports.Client,
+	sender ports.DigestSender,
+	metrics ports.MetricsProvider,
+	cfg Config,
+) *Service {
+	if cfg.SendHour < 0 || cfg.SendHour > 23 {
+		cfg.SendHour = DefaultSendHour
+	}
+	if cfg.MaxItems <= 0 {
+		cfg.MaxItems = DefaultMaxItems
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = DefaultLease
+	}
+
+	return &Service{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+		userClient:       userClient,
+		sender:           sender,
+		log:              log,
+		metrics:          metrics,
+		config:           cfg,
+		now:              time.Now,
+	}
+}
+
+// SetDigestFrequency subscribes the user to daily or weekly digests, or
+// turns them off. The first digest is due at the next send time.
+func (s *Service) SetDigestFrequency(ctx context.Context, userID int64, frequency model.DigestFrequency) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("set_digest_frequency", err == nil)
+	}()
+
+	if userID <= 0 || !frequency.IsValid() {
+		s.log.ErrorContext(ctx, "Invalid digest frequency",
+			slog.Int64("user_id", userID),
+			slog.String("frequency", string(frequency)),
+		)
+		return custom_errors.ErrInvalidInput
+	}
+
+	subscription := &model.DigestSubscription{
+		UserID:    userID,
+		Frequency: frequency,
+		NextRunAt: s.nextRun(frequency, s.now()),
+	}
+
+	s.log.InfoContext(ctx, "Setting digest frequency",
+		slog.Int64("user_id", userID),
+		slog.String("frequency", string(frequency)),
+	)
+	return s.repo.Upsert(ctx, subscription)
+}
+
+// GetDigestSettings returns the user's subscription; a user who never
+// subscribed gets frequency off.
+func (s *Service) GetDigestSettings(ctx context.Context, userID int64) (subscription *model.DigestSubscription, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("get_digest_settings", err == nil)
+	}()
+
+	if userID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	subscription, err = s.repo.Get(ctx, userID)
+	if errors.Is(err, custom_errors.ErrNotificationNotFound) {
+		return &model.DigestSubscription{UserID: userID, Frequency: model.DigestFrequencyOff}, nil
+	}
+	return subscription, err
+}
+
+// ProcessDue claims up to batchSize due subscriptions and sends their
+// digests. It returns how many digests were sent.
+func (s *Service) ProcessDue(ctx context.Context, batchSize int) (sent int, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("process_due_digests", err == nil)
+	}()
+
+	if batchSize <= 0 {
+		s.log.ErrorContext(ctx, "Invalid digest batch size", slog.Int("batch_size", batchSize))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	now := s.now()
+	subscriptions, err := s.repo.ClaimDue(ctx, now, s.config.Lease, batchSize)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to claim due digests", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	for _, subscription := range subscriptions {
+		if err := ctx.Err(); err != nil {
+			return sent, err
+		}
+		if s.process(ctx, subscription, now) {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// process builds and sends one digest covering (CoveredUntil, now]. On a
+// transient failure the subscription is left alone and picked up again
+// when its lease runs out.
+func (s *Service) process(ctx context.Context, subscription *model.DigestSubscription, now time.Time) bool {
+	userID := subscription.UserID
+	nextRunAt := s.nextRun(subscription.Frequency, now)
+
+	preferences, err := s.preferenceRepo.ListChannelPreferences(ctx, userID)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to load channel preferences",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		s.metrics.IncrementChannelDeliveries(metricsChannel, "retry")
+		return false
+	}
+
+	types := model.DigestTypesFor(preferences)
+	notifications, total, err := s.notificationRepo.ListUnreadDelivered(ctx, userID, subscription.CoveredUntil, now, types, s.config.MaxItems)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to list unread notifications for digest",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		s.metrics.IncrementChannelDeliveries(metricsChannel, "retry")
+		return false
+	}
+
+	if len(notifications) == 0 {
+		s.advance(ctx, userID, now, nil, nextRunAt)
+		s.metrics.IncrementChannelDeliveries(metricsChannel, "skipped")
+		return false
+	}
+
+	recipient, err := s.userClient.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrUserNotFound) {
+			s.log.WarnContext(ctx, "Digest recipient not found", slog.Int64("user_id", userID))
+			s.advance(ctx, userID, now, nil, nextRunAt)
+			s.metrics.IncrementChannelDeliveries(metricsChannel, "failed")
+			return false
+		}
+		s.log.WarnContext(ctx, "Failed to get digest recipient, will retry",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		s.metrics.IncrementChannelDeliveries(metricsChannel, "retry")
+		return false
+	}
+
+	digest := &model.Digest{
+		UserID:    userID,
+		Frequency: subscription.Frequency,
+		Since:     subscription.CoveredUntil,
+		Until:     now,
+		Groups:    groupByType(notifications),
+		Total:     total,
+	}
+
+	start := time.Now()
+	err = s.sender.SendDigest(ctx, recipient, digest)
+	s.metrics.RecordChannelDeliveryDuration(metricsChannel, time.Since(start))
+	if err != nil {
+		if errors.Is(err, model.ErrPermanentDeliveryFailure) {
+			s.log.ErrorContext(ctx, "Digest failed permanently",
+				slog.Int64("user_id", userID),
+				slog.String("error", err.Error()),
+			)
+			s.advance(ctx, userID, now, nil, nextRunAt)
+			s.metrics.IncrementChannelDeliveries(metricsChannel, "failed")
+			return false
+		}
+		s.log.WarnContext(ctx, "Digest failed, will retry",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		s.metrics.IncrementChannelDeliveries(metricsChannel, "retry")
+		return false
+	}
+
+	s.advance(ctx, userID, now, &now, nextRunAt)
+	s.metrics.IncrementChannelDeliveries(metricsChannel, "sent")
+	s.log.DebugContext(ctx, "Digest sent",
+		slog.Int64("user_id", userID),
+		slog.Int("notifications", digest.Total),
+		slog.Int("groups", len(digest.Groups)),
+	)
+	return true
+}
+
+func (s *Service) advance(ctx context.Context, userID int64, coveredUntil time.Time, sentAt *time.Time, nextRunAt time.Time) {
+	if err := s.repo.Advance(ctx, userID, coveredUntil, sentAt, nextRunAt); err != nil {
+		s.log.ErrorContext(ctx, "Failed to record digest watermark",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// nextRun returns the first send time strictly after now for the
+// frequency. An off subscription is never due; its next run is kept only
+// so the column stays meaningful.
+func (s *Service) nextRun(frequency model.DigestFrequency, now time.Time) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), s.config.SendHour, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	if frequency == model.DigestFrequencyWeekly {
+		for next.Weekday() != s.config.WeeklyDay {
+			next = next.AddDate(0, 0, 1)
+		}
+	}
+	return next
+}
+
+// groupByType groups notifications by type keeping their order within a
+// group. Larger groups come first, ties by type name.
+func groupByType(notifications []*model.Notification) []*model.DigestGroup {
+	byType := make(map[events.EventType]*model.DigestGroup)
+	groups := make([]*model.DigestGroup, 0)
+	for _, n := range notifications {
+		group, ok := byType[n.Type]
+		if !ok {
+			group = &model.DigestGroup{Type: n.Type}
+			byType[n.Type] = group
+			groups = append(groups, group)
+		}
+		group.Notifications = append(group.Notifications, n)
+	}
+
+	slices.SortStableFunc(groups, func(a, b *model.DigestGroup) int {
+		if c := cmp.Compare(len(b.Notifications), len(a.Notifications)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Type, b.Type)
+	})
+	return groups
+}
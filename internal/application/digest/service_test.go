@@ -0,0 +1,254 @@
+package digest_service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	digest_service "pinstack-notification-service/internal/application/digest"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type digestMocks struct {
+	repo             *mocks.DigestRepository
+	notificationRepo *mocks.NotificationRepository
+	preferenceRepo   *mocks.PreferenceRepository
+	userClient       *mocks.Client
+	sender           *mocks.DigestSender
+}
+
+func newDigestService(t *testing.T, cfg digest_service.Config) (*digest_service.Service, digestMocks) {
+	m := digestMocks{
+		repo:             mocks.NewDigestRepository(t),
+		notificationRepo: mocks.NewNotificationRepository(t),
+		preferenceRepo:   mocks.NewPreferenceRepository(t),
+		userClient:       mocks.NewClient(t),
+		sender:           mocks.NewDigestSender(t),
+	}
+	svc := digest_service.NewDigestService(logger.New("dev"), m.repo, m.notificationRepo, m.preferenceRepo, m.userClient, m.sender,
+		prometheus.NewPrometheusMetricsProvider(), cfg)
+	return svc, m
+}
+
+func notification(id int64, eventType events.EventType) *model.Notification {
+	payload, _ := json.Marshal(map[string]any{"follower_id": id * 10})
+	return &model.Notification{ID: id, UserID: 1, Type: eventType, State: model.NotificationStateUnread, Payload: payload}
+}
+
+func TestService_SetDigestFrequency(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    int64
+		frequency model.DigestFrequency
+		wantErr   error
+	}{
+		{name: "daily", userID: 1, frequency: model.DigestFrequencyDaily},
+		{name: "weekly", userID: 1, frequency: model.DigestFrequencyWeekly},
+		{name: "off", userID: 1, frequency: model.DigestFrequencyOff},
+		{name: "unknown frequency", userID: 1, frequency: "hourly", wantErr: custom_errors.ErrInvalidInput},
+		{name: "invalid user", userID: 0, frequency: model.DigestFrequencyDaily, wantErr: custom_errors.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := digest_service.Config{SendHour: 9, WeeklyDay: time.Friday}
+			svc, m := newDigestService(t, cfg)
+			before := time.Now()
+
+			if tt.wantErr == nil {
+				m.repo.On("Upsert", mock.Anything, mock.MatchedBy(func(s *model.DigestSubscription) bool {
+					next := s.NextRunAt
+					ok := s.UserID == tt.userID && s.Frequency == tt.frequency &&
+						next.After(before) && next.Hour() == 9 && next.Minute() == 0 && next.Sub(before) <= 7*24*time.Hour
+					if tt.frequency == model.DigestFrequencyWeekly {
+						ok = ok && next.Weekday() == time.Friday
+					} else {
+						ok = ok && next.Sub(before) <= 24*time.Hour
+					}
+					return ok
+				})).Return(nil)
+			}
+
+			err := svc.SetDigestFrequency(context.Background(), tt.userID, tt.frequency)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_GetDigestSettings_NeverSubscribed(t *testing.T) {
+	svc, m := newDigestService(t, digest_service.Config{})
+	m.repo.On("Get", mock.Anything, int64(7)).Return(nil, custom_errors.ErrNotificationNotFound)
+
+	settings, err := svc.GetDigestSettings(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, model.DigestFrequencyOff, settings.Frequency)
+}
+
+func TestService_ProcessDue(t *testing.T) {
+	coveredUntil := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	recipient := &model.User{ID: 1, Username: "alice", Email: "alice@example.com"}
+	const postLiked events.EventType = "post_liked"
+	allTypes := &model.DigestTypes{Types: []events.EventType{}, Exclude: true}
+
+	tests := []struct {
+		name        string
+		preferences []*model.ChannelPreference
+		wantTypes   *model.DigestTypes
+		unread      []*model.Notification
+		total       int
+		userErr     error
+		sendErr     error
+		wantSent    int
+		wantGroups  []events.EventType
+		wantCounts  []int
+		wantTotal   int
+		wantAdvance bool
+		wantSentAt  bool
+	}{
+		{
+			name:        "groups unread notifications by type, largest group first",
+			unread:      []*model.Notification{notification(5, postLiked), notification(4, events.EventTypeFollowCreated), notification(3, events.EventTypeFollowCreated)},
+			total:       3,
+			wantSent:    1,
+			wantGroups:  []events.EventType{events.EventTypeFollowCreated, postLiked},
+			wantCounts:  []int{2, 1},
+			wantTotal:   3,
+			wantAdvance: true,
+			wantSentAt:  true,
+		},
+		{
+			name:        "more than MaxItems lists the newest and counts the rest",
+			unread:      []*model.Notification{notification(25, postLiked), notification(24, postLiked)},
+			total:       25,
+			wantSent:    1,
+			wantGroups:  []events.EventType{postLiked},
+			wantCounts:  []int{2},
+			wantTotal:   25,
+			wantAdvance: true,
+			wantSentAt:  true,
+		},
+		{
+			name:        "nothing new moves the watermark without sending",
+			unread:      []*model.Notification{},
+			wantAdvance: true,
+		},
+		{
+			name: "types the user excluded from digests are left out",
+			preferences: []*model.ChannelPreference{
+				{UserID: 1, Type: postLiked, Channel: model.ChannelDigest, Enabled: false},
+			},
+			wantTypes:   &model.DigestTypes{Types: []events.EventType{postLiked}, Exclude: true},
+			unread:      []*model.Notification{notification(4, events.EventTypeFollowCreated)},
+			total:       1,
+			wantSent:    1,
+			wantGroups:  []events.EventType{events.EventTypeFollowCreated},
+			wantCounts:  []int{1},
+			wantTotal:   1,
+			wantAdvance: true,
+			wantSentAt:  true,
+		},
+		{
+			name: "digests turned off are skipped",
+			preferences: []*model.ChannelPreference{
+				{UserID: 1, Channel: model.ChannelDigest, Enabled: false},
+				{UserID: 1, Channel: model.ChannelEmail, Enabled: true},
+			},
+			wantTypes:   &model.DigestTypes{Types: []events.EventType{}, Exclude: false},
+			unread:      []*model.Notification{},
+			wantAdvance: true,
+		},
+		{
+			name:    "transient send failure leaves the subscription leased for a retry",
+			unread:  []*model.Notification{notification(5, postLiked)},
+			total:   1,
+			sendErr: errors.New("connection reset"),
+		},
+		{
+			name:        "permanent send failure moves on to the next run",
+			unread:      []*model.Notification{notification(5, postLiked)},
+			total:       1,
+			sendErr:     model.ErrPermanentDeliveryFailure,
+			wantAdvance: true,
+		},
+		{
+			name:        "deleted recipient moves on to the next run",
+			unread:      []*model.Notification{notification(5, postLiked)},
+			total:       1,
+			userErr:     custom_errors.ErrUserNotFound,
+			wantAdvance: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := newDigestService(t, digest_service.Config{SendHour: 8, MaxItems: 20})
+			subscription := &model.DigestSubscription{UserID: 1, Frequency: model.DigestFrequencyDaily, CoveredUntil: &coveredUntil}
+			wantTypes := tt.wantTypes
+			if wantTypes == nil {
+				wantTypes = allTypes
+			}
+
+			m.repo.On("ClaimDue", mock.Anything, mock.Anything, digest_service.DefaultLease, 10).
+				Return([]*model.DigestSubscription{subscription}, nil)
+			m.preferenceRepo.On("ListChannelPreferences", mock.Anything, int64(1)).Return(tt.preferences, nil)
+			m.notificationRepo.On("ListUnreadDelivered", mock.Anything, int64(1), &coveredUntil, mock.AnythingOfType("time.Time"), wantTypes, 20).
+				Return(tt.unread, tt.total, nil)
+
+			var until time.Time
+			if len(tt.wantGroups) > 0 || tt.sendErr != nil || tt.userErr != nil {
+				m.userClient.On("GetUser", mock.Anything, int64(1)).Return(recipient, tt.userErr)
+			}
+			if tt.userErr == nil && (len(tt.wantGroups) > 0 || tt.sendErr != nil) {
+				m.sender.On("SendDigest", mock.Anything, recipient, mock.MatchedBy(func(d *model.Digest) bool {
+					if d.Since != &coveredUntil {
+						return false
+					}
+					until = d.Until
+					if tt.wantGroups == nil {
+						return true
+					}
+					groups := make([]events.EventType, 0, len(d.Groups))
+					counts := make([]int, 0, len(d.Groups))
+					for _, g := range d.Groups {
+						groups = append(groups, g.Type)
+						counts = append(counts, len(g.Notifications))
+					}
+					return d.Total == tt.wantTotal && assert.ObjectsAreEqual(tt.wantGroups, groups) && assert.ObjectsAreEqual(tt.wantCounts, counts)
+				})).Return(tt.sendErr)
+			}
+			if tt.wantAdvance {
+				m.repo.On("Advance", mock.Anything, int64(1), mock.AnythingOfType("time.Time"),
+					mock.MatchedBy(func(sentAt *time.Time) bool { return (sentAt != nil) == tt.wantSentAt }),
+					mock.MatchedBy(func(next time.Time) bool { return next.Hour() == 8 && next.After(time.Now()) }),
+				).Return(nil)
+			}
+
+			sent, err := svc.ProcessDue(context.Background(), 10)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSent, sent)
+			if tt.wantAdvance {
+				// The watermark always moves to the end of the window listed.
+				window := m.notificationRepo.Calls[0].Arguments.Get(3).(time.Time)
+				m.repo.AssertCalled(t, "Advance", mock.Anything, int64(1), window, mock.Anything, mock.Anything)
+			}
+			if tt.wantSentAt {
+				m.repo.AssertCalled(t, "Advance", mock.Anything, int64(1), until, &until, mock.Anything)
+			}
+		})
+	}
+}
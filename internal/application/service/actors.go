@@ -0,0 +1,93 @@
+package notification_service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	"sync"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+const (
+	DefaultActorLookupConcurrency = 8
+	DefaultActorLookupTimeout     = 2 * time.Second
+)
+
+// WithActorLookup bounds how many users are looked up at once when
+// notifications are enriched with their actors, and how long the lookups
+// may take altogether before the rest are given up on.
+func WithActorLookup(concurrency int, timeout time.Duration) Option {
+	return func(s *Service) {
+		if concurrency > 0 {
+			s.actorLookupConcurrency = concurrency
+		}
+		if timeout > 0 {
+			s.actorLookupTimeout = timeout
+		}
+	}
+}
+
+// enrichActors sets Actor on every notification whose payload names one.
+// Each distinct user is looked up once. Users that are gone or could not be
+// fetched leave Actor nil: the notification is still returned, just without
+// the profile.
+func (s *Service) enrichActors(ctx context.Context, notifications []*model.Notification) {
+	byActor := make(map[int64][]*model.Notification)
+	for _, notification := range notifications {
+		if id := notification.ActorID(); id > 0 {
+			byActor[id] = append(byActor[id], notification)
+		}
+	}
+	if len(byActor) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.actorLookupTimeout)
+	defer cancel()
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, s.actorLookupConcurrency)
+	)
+	actors := make(map[int64]*model.Actor, len(byActor))
+	for id := range byActor {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			user, err := s.userClient.GetUser(ctx, id)
+			if err != nil {
+				if errors.Is(err, custom_errors.ErrUserNotFound) {
+					s.log.DebugContext(ctx, "Notification actor not found", slog.Int64("actor_id", id))
+				} else {
+					s.log.WarnContext(ctx, "Failed to look up notification actor",
+						slog.Int64("actor_id", id),
+						slog.String("error", err.Error()),
+					)
+				}
+				return
+			}
+
+			mu.Lock()
+			actors[id] = model.ActorFromUser(user)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	for id, actor := range actors {
+		for _, notification := range byActor[id] {
+			notification.Actor = actor
+		}
+	}
+}
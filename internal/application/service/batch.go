@@ -0,0 +1,151 @@
+package notification_service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	"sync"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// MaxNotificationBatchSize caps how many notifications SaveNotifications
+// stores in one call.
+const MaxNotificationBatchSize = 500
+
+// SaveNotifications stores many notifications with a single insert. Each
+// notification is checked as SaveNotification would check it, with earlier
+// notifications of the batch counting toward actor caps, and fails on its
+// own: the returned slice holds one error per notification, nil for the
+// stored ones, whose IDs are set. Every recipient is looked up once however
+// many notifications it gets. The call fails as a whole, storing nothing,
+// only if the batch is empty or too large or the insert fails. A broadcast
+// recipient who already has the broadcast's notification gets
+// model.ErrBroadcastDuplicate and is not notified again.
+func (s *Service) SaveNotifications(ctx context.Context, notifications []*model.Notification) (errs []error, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("save_notifications", err == nil)
+	}()
+
+	if len(notifications) == 0 || len(notifications) > MaxNotificationBatchSize {
+		s.log.ErrorContext(ctx, "Invalid notification batch size",
+			slog.Int("count", len(notifications)),
+			slog.Int("max", MaxNotificationBatchSize),
+		)
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	errs = make([]error, len(notifications))
+	userIDs := make([]int64, 0, len(notifications))
+	for i, notification := range notifications {
+		if notification == nil || notification.UserID <= 0 {
+			errs[i] = custom_errors.ErrInvalidInput
+			continue
+		}
+		userIDs = append(userIDs, notification.UserID)
+	}
+
+	recipientErrs := s.checkRecipients(ctx, userIDs)
+
+	pending := make([]*model.Notification, 0, len(notifications))
+	pendingIdx := make([]int, 0, len(notifications))
+	batched := make(map[actorCapKey]int)
+	for i, notification := range notifications {
+		if errs[i] != nil {
+			continue
+		}
+		if err := recipientErrs[notification.UserID]; err != nil {
+			errs[i] = err
+			continue
+		}
+		key := actorCapKey{userID: notification.UserID, actorID: notification.ActorID(), notificationType: notification.Type}
+		if err := s.prepare(ctx, notification, batched[key]); err != nil {
+			errs[i] = err
+			continue
+		}
+		batched[key]++
+		pending = append(pending, notification)
+		pendingIdx = append(pendingIdx, i)
+	}
+
+	if len(pending) > 0 {
+		if _, err := s.notificationRepo.CreateMany(ctx, pending); err != nil {
+			s.log.ErrorContext(ctx, "Failed to save notification batch",
+				slog.Int("count", len(pending)),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+	}
+
+	saved := 0
+	for j, notification := range pending {
+		if notification.ID == 0 {
+			errs[pendingIdx[j]] = model.ErrBroadcastDuplicate
+			continue
+		}
+		saved++
+		if notification.DeliverAt == nil {
+			s.deliver(ctx, notification)
+		}
+	}
+
+	s.log.InfoContext(ctx, "Notification batch saved",
+		slog.Int("count", len(notifications)),
+		slog.Int("saved", saved),
+		slog.Int("failed", len(notifications)-saved),
+	)
+	return errs, nil
+}
+
+// checkRecipients looks every distinct user up once, with the same bound on
+// concurrent lookups as actor enrichment. It returns the error for each user
+// that is gone or could not be fetched.
+func (s *Service) checkRecipients(ctx context.Context, userIDs []int64) map[int64]error {
+	unique := make(map[int64]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		unique[id] = struct{}{}
+	}
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, s.actorLookupConcurrency)
+	)
+	errs := make(map[int64]error)
+	for id := range unique {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			select {
+			case sem <- struct{}{}:
+				_, err = s.userClient.GetUser(ctx, id)
+				<-sem
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+			if err == nil {
+				return
+			}
+
+			if errors.Is(err, custom_errors.ErrUserNotFound) {
+				s.log.DebugContext(ctx, "Recipient not found in notification batch", slog.Int64("user_id", id))
+				err = custom_errors.ErrUserNotFound
+			} else {
+				s.log.ErrorContext(ctx, "Failed to get recipient for notification batch",
+					slog.Int64("user_id", id),
+					slog.String("error", err.Error()),
+				)
+			}
+
+			mu.Lock()
+			errs[id] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
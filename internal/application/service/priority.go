@@ -0,0 +1,44 @@
+package notification_service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// WithPriorityDefaults sets the priority of notifications whose sender did
+// not pick one, per notification type. Types not listed default to normal.
+// Invalid priorities are ignored.
+func WithPriorityDefaults(defaults map[events.EventType]model.NotificationPriority) Option {
+	return func(s *Service) {
+		s.priorityDefaults = make(map[events.EventType]model.NotificationPriority, len(defaults))
+		for notificationType, priority := range defaults {
+			if priority.IsValid() {
+				s.priorityDefaults[notificationType] = priority
+			}
+		}
+	}
+}
+
+// resolvePriority fills in the default priority for the notification type
+// and rejects priorities the service does not know.
+func (s *Service) resolvePriority(ctx context.Context, notification *model.Notification) error {
+	if notification.Priority == "" {
+		notification.Priority = model.NotificationPriorityNormal
+		if priority, ok := s.priorityDefaults[notification.Type]; ok {
+			notification.Priority = priority
+		}
+		return nil
+	}
+	if !notification.Priority.IsValid() {
+		s.log.ErrorContext(ctx, "Invalid notification priority",
+			slog.Int64("user_id", notification.UserID),
+			slog.String("priority", string(notification.Priority)),
+		)
+		return custom_errors.ErrInvalidInput
+	}
+	return nil
+}
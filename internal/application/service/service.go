@@ -9,71 +9,144 @@ import (
 	"time"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
 )
 
+// DefaultRestoreGracePeriod is how long a removed notification stays restorable
+// unless overridden with WithRestoreGracePeriod.
+const DefaultRestoreGracePeriod = 24 * time.Hour
+
 type Service struct {
-	notificationRepo ports.NotificationRepository
-	userClient       ports.Client
-	log              ports.Logger
-	metrics          ports.MetricsProvider
+	notificationRepo   ports.NotificationRepository
+	userClient         ports.Client
+	log                ports.Logger
+	metrics            ports.MetricsProvider
+	publisher          ports.NotificationPublisher
+	dispatcher         Dispatcher
+	webhooks           WebhookNotifier
+	renderer           ports.NotificationRenderer
+	restoreGracePeriod time.Duration
+	actorCaps          map[events.EventType]model.ActorCap
+	priorityDefaults   map[events.EventType]model.NotificationPriority
+
+	actorLookupConcurrency int
+	actorLookupTimeout     time.Duration
+}
+
+// Option configures optional Service behaviour.
+type Option func(*Service)
+
+// WithRestoreGracePeriod sets how long after removal a notification can still be restored.
+func WithRestoreGracePeriod(period time.Duration) Option {
+	return func(s *Service) {
+		if period > 0 {
+			s.restoreGracePeriod = period
+		}
+	}
+}
+
+// WithNotificationPublisher announces every notification once it is delivered.
+func WithNotificationPublisher(publisher ports.NotificationPublisher) Option {
+	return func(s *Service) {
+		s.publisher = publisher
+	}
+}
+
+// Dispatcher queues a delivered notification for its out-of-app channels.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, notification *model.Notification) (int, error)
+}
+
+// WithDispatcher fans every delivered notification out to the email and push
+// channels in addition to the in-app feed.
+func WithDispatcher(dispatcher Dispatcher) Option {
+	return func(s *Service) {
+		s.dispatcher = dispatcher
+	}
+}
+
+// WebhookNotifier queues notification lifecycle events for partner webhooks.
+type WebhookNotifier interface {
+	NotificationCreated(ctx context.Context, notification *model.Notification) error
+	NotificationRead(ctx context.Context, notification *model.Notification) error
+	NotificationsReadAll(ctx context.Context, userID int64, count int64) error
+}
+
+// WithWebhooks reports delivered and read notifications to webhook
+// subscriptions. Like dispatch, this is best effort.
+func WithWebhooks(webhooks WebhookNotifier) Option {
+	return func(s *Service) {
+		s.webhooks = webhooks
+	}
 }
 
-func NewNotificationService(log ports.Logger, notificationRepo ports.NotificationRepository, userClient ports.Client, metrics ports.MetricsProvider) *Service {
-	return &Service{
-		log:              log,
-		notificationRepo: notificationRepo,
-		userClient:       userClient,
-		metrics:          metrics,
+// WithRenderer renders notification titles and bodies for
+// LocalizeNotifications. Without it notifications carry no text.
+func WithRenderer(renderer ports.NotificationRenderer) Option {
+	return func(s *Service) {
+		s.renderer = renderer
 	}
 }
 
+func NewNotificationService(log ports.Logger, notificationRepo ports.NotificationRepository, userClient ports.Client, metrics ports.MetricsProvider, opts ...Option) *Service {
+	s := &Service{
+		log:                log,
+		notificationRepo:   notificationRepo,
+		userClient:         userClient,
+		metrics:            metrics,
+		restoreGracePeriod: DefaultRestoreGracePeriod,
+
+		actorLookupConcurrency: DefaultActorLookupConcurrency,
+		actorLookupTimeout:     DefaultActorLookupTimeout,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
 func (s *Service) SaveNotification(ctx context.Context, notification *model.Notification) (id int64, err error) {
 	defer func() {
-		s.metrics.IncrementNotificationOperations("save_notification", err == nil)
+		s.metrics.IncrementNotificationOperations("save_notification", err == nil || errors.Is(err, model.ErrNotificationSuppressed))
 	}()
 
 	if notification == nil {
-		s.log.Error("Notification is nil")
+		s.log.ErrorContext(ctx, "Notification is nil")
 		return 0, custom_errors.ErrInvalidInput
 	}
 
 	if notification.UserID <= 0 {
-		s.log.Error("Invalid user ID in notification", slog.Int64("user_id", notification.UserID))
+		s.log.ErrorContext(ctx, "Invalid user ID in notification", slog.Int64("user_id", notification.UserID))
 		return 0, custom_errors.ErrInvalidInput
 	}
 
 	_, err = s.userClient.GetUser(ctx, notification.UserID)
 	if err != nil {
-		s.log.Error("Failed to get user", slog.Int64("user_id", notification.UserID))
+		s.log.ErrorContext(ctx, "Failed to get user", slog.Int64("user_id", notification.UserID))
 		switch {
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			s.log.Debug("User not found in save notification", slog.Int64("user_id", notification.UserID), slog.String("error", err.Error()))
+			s.log.DebugContext(ctx, "User not found in save notification", slog.Int64("user_id", notification.UserID), slog.String("error", err.Error()))
 			return 0, custom_errors.ErrUserNotFound
 		default:
-			s.log.Error("Failed to get user", slog.Int64("user_id", notification.UserID))
+			s.log.ErrorContext(ctx, "Failed to get user", slog.Int64("user_id", notification.UserID))
 			return 0, err
 		}
 	}
 
-	if notification.Type == "" {
-		s.log.Error("Empty notification type", slog.Int64("user_id", notification.UserID))
-		return 0, custom_errors.ErrInvalidInput
-	}
-
-	if notification.CreatedAt.IsZero() {
-		notification.CreatedAt = time.Now()
+	if err := s.prepare(ctx, notification, 0); err != nil {
+		return 0, err
 	}
 
-	notification.IsRead = false
-
-	s.log.Info("Sending notification",
+	s.log.InfoContext(ctx, "Sending notification",
 		slog.Int64("user_id", notification.UserID),
 		slog.String("type", string(notification.Type)),
+		slog.String("priority", string(notification.Priority)),
+		slog.Bool("scheduled", notification.DeliverAt != nil),
 	)
 
 	notificationID, err := s.notificationRepo.Create(ctx, notification)
 	if err != nil {
-		s.log.Error("Failed to send notification",
+		s.log.ErrorContext(ctx, "Failed to send notification",
 			slog.Int64("user_id", notification.UserID),
 			slog.String("type", string(notification.Type)),
 			slog.String("error", err.Error()),
@@ -81,42 +154,178 @@ func (s *Service) SaveNotification(ctx context.Context, notification *model.Noti
 		return 0, err
 	}
 
-	s.log.Info("Notification sent successfully",
+	if notification.DeliverAt != nil {
+		s.log.InfoContext(ctx, "Notification scheduled successfully",
+			slog.Int64("notification_id", notificationID),
+			slog.Int64("user_id", notification.UserID),
+			slog.Time("deliver_at", *notification.DeliverAt),
+		)
+		return notificationID, nil
+	}
+
+	s.log.InfoContext(ctx, "Notification sent successfully",
 		slog.Int64("notification_id", notificationID),
 		slog.Int64("user_id", notification.UserID),
 		slog.String("type", string(notification.Type)),
 	)
 
+	s.deliver(ctx, notification)
+
 	return notificationID, nil
 }
 
+// prepare checks a notification whose recipient exists and fills in what
+// the caller left out: priority, schedule, creation time and state. It
+// returns model.ErrNotificationSuppressed when spam protection drops it.
+func (s *Service) prepare(ctx context.Context, notification *model.Notification, batched int) error {
+	if notification.Type == "" {
+		s.log.ErrorContext(ctx, "Empty notification type", slog.Int64("user_id", notification.UserID))
+		return custom_errors.ErrInvalidInput
+	}
+
+	if err := s.resolvePriority(ctx, notification); err != nil {
+		return err
+	}
+
+	if notification.ExpiresAt != nil {
+		notBefore := time.Now()
+		if notification.DeliverAt != nil && notification.DeliverAt.After(notBefore) {
+			notBefore = *notification.DeliverAt
+		}
+		if !notification.ExpiresAt.After(notBefore) {
+			s.log.ErrorContext(ctx, "Notification expires before it is delivered",
+				slog.Int64("user_id", notification.UserID),
+				slog.Time("expires_at", *notification.ExpiresAt),
+			)
+			return custom_errors.ErrInvalidInput
+		}
+	}
+
+	if err := s.checkActorCap(ctx, notification, batched); err != nil {
+		return err
+	}
+
+	if notification.DeliverAt != nil {
+		if notification.DeliverAt.After(time.Now()) {
+			notification.CreatedAt = *notification.DeliverAt
+		} else {
+			notification.DeliverAt = nil
+		}
+	}
+
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now()
+	}
+
+	notification.IsRead = false
+	notification.State = model.NotificationStateUnread
+
+	return nil
+}
+
+// deliver is best effort: the notification is already stored and visible in
+// the feed, so a failed announcement or dispatch is logged, not returned.
+func (s *Service) deliver(ctx context.Context, notification *model.Notification) {
+	s.publishDelivered(ctx, notification)
+	s.dispatch(ctx, notification)
+	s.notifyWebhooks(ctx, "webhook_created", notification.ID, func(w WebhookNotifier) error {
+		return w.NotificationCreated(ctx, notification)
+	})
+}
+
+// notifyRead reports a single read to webhooks. The repository only returns
+// whether the row changed, so the notification is loaded for the payload.
+func (s *Service) notifyRead(ctx context.Context, id int64) {
+	if s.webhooks == nil {
+		return
+	}
+
+	s.notifyWebhooks(ctx, "webhook_read", id, func(w WebhookNotifier) error {
+		notification, err := s.notificationRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		return w.NotificationRead(ctx, notification)
+	})
+}
+
+func (s *Service) notifyWebhooks(ctx context.Context, operation string, notificationID int64, notify func(WebhookNotifier) error) {
+	if s.webhooks == nil {
+		return
+	}
+
+	err := notify(s.webhooks)
+	s.metrics.IncrementNotificationOperations(operation, err == nil)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to queue webhook event",
+			slog.String("operation", operation),
+			slog.Int64("notification_id", notificationID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func (s *Service) dispatch(ctx context.Context, notification *model.Notification) {
+	if s.dispatcher == nil {
+		return
+	}
+
+	_, err := s.dispatcher.Dispatch(ctx, notification)
+	s.metrics.IncrementNotificationOperations("dispatch_channels", err == nil)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to dispatch notification to channels",
+			slog.Int64("notification_id", notification.ID),
+			slog.Int64("user_id", notification.UserID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func (s *Service) publishDelivered(ctx context.Context, notification *model.Notification) {
+	if s.publisher == nil {
+		return
+	}
+
+	err := s.publisher.PublishDelivered(ctx, notification)
+	s.metrics.IncrementNotificationOperations("publish_delivered", err == nil)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to publish delivered notification",
+			slog.Int64("notification_id", notification.ID),
+			slog.Int64("user_id", notification.UserID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
 func (s *Service) GetNotificationDetails(ctx context.Context, id int64) (notification *model.Notification, err error) {
 	defer func() {
 		s.metrics.IncrementNotificationOperations("get_notification_details", err == nil)
 	}()
 
 	if id <= 0 {
-		s.log.Error("Invalid notification ID", slog.Int64("id", id))
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", id))
 		return nil, custom_errors.ErrInvalidInput
 	}
 
-	s.log.Info("Requesting notification details", slog.Int64("id", id))
+	s.log.InfoContext(ctx, "Requesting notification details", slog.Int64("id", id))
 
 	notification, err = s.notificationRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
-			s.log.Debug("Notification not found", slog.Int64("id", id))
+			s.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
 			return nil, custom_errors.ErrNotificationNotFound
 		}
 
-		s.log.Error("Failed to retrieve notification details",
+		s.log.ErrorContext(ctx, "Failed to retrieve notification details",
 			slog.Int64("id", id),
 			slog.String("error", err.Error()),
 		)
 		return nil, err
 	}
 
-	s.log.Info("Notification details retrieved",
+	s.enrichActors(ctx, []*model.Notification{notification})
+
+	s.log.InfoContext(ctx, "Notification details retrieved",
 		slog.Int64("id", notification.ID),
 		slog.Int64("user_id", notification.UserID),
 		slog.String("type", string(notification.Type)),
@@ -125,28 +334,51 @@ func (s *Service) GetNotificationDetails(ctx context.Context, id int64) (notific
 	return notification, nil
 }
 
+// LocalizeNotifications sets Text on every notification, rendered for
+// locale. A notification that fails to render is left without text rather
+// than failing the read.
+func (s *Service) LocalizeNotifications(notifications []*model.Notification, locale string) {
+	if s.renderer == nil {
+		return
+	}
+
+	for _, notification := range notifications {
+		text, err := s.renderer.Render(notification, locale)
+		if err != nil {
+			s.log.Warn("Failed to render notification text",
+				slog.Int64("notification_id", notification.ID),
+				slog.String("type", string(notification.Type)),
+				slog.String("locale", locale),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		notification.Text = text
+	}
+}
+
 func (s *Service) GetUnreadCount(ctx context.Context, userID int64) (count int, err error) {
 	defer func() {
 		s.metrics.IncrementNotificationOperations("get_unread_count", err == nil)
 	}()
 
 	if userID <= 0 {
-		s.log.Error("Invalid user ID", slog.Int64("user_id", userID))
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
 		return 0, custom_errors.ErrInvalidInput
 	}
 
-	s.log.Info("Retrieving unread notification count", slog.Int64("user_id", userID))
+	s.log.InfoContext(ctx, "Retrieving unread notification count", slog.Int64("user_id", userID))
 
 	count, err = s.notificationRepo.CountUnread(ctx, userID)
 	if err != nil {
-		s.log.Error("Failed to retrieve unread notification count",
+		s.log.ErrorContext(ctx, "Failed to retrieve unread notification count",
 			slog.Int64("user_id", userID),
 			slog.String("error", err.Error()),
 		)
 		return 0, err
 	}
 
-	s.log.Info("Unread notification count retrieved",
+	s.log.InfoContext(ctx, "Unread notification count retrieved",
 		slog.Int64("user_id", userID),
 		slog.Int("count", count),
 	)
@@ -154,38 +386,48 @@ func (s *Service) GetUnreadCount(ctx context.Context, userID int64) (count int,
 	return count, nil
 }
 
-func (s *Service) GetUserNotificationFeed(ctx context.Context, userID int64, limit, page int) (notifications []*model.Notification, totalCount int32, err error) {
+func (s *Service) GetUserNotificationFeed(ctx context.Context, userID int64, filter *model.FeedFilter, limit, page int) (notifications []*model.Notification, totalCount int32, err error) {
 	defer func() {
 		s.metrics.IncrementNotificationOperations("get_notification_feed", err == nil)
 	}()
 
 	if userID <= 0 {
-		s.log.Error("Invalid user ID", slog.Int64("user_id", userID))
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
 		return nil, 0, custom_errors.ErrInvalidInput
 	}
 
+	if filter != nil {
+		for _, state := range filter.States {
+			if !state.IsValid() {
+				s.log.ErrorContext(ctx, "Invalid notification state in feed filter", slog.String("state", string(state)))
+				return nil, 0, custom_errors.ErrInvalidInput
+			}
+		}
+	}
+
 	if limit <= 0 {
-		s.log.Debug("Using default limit for notifications feed", slog.Int("limit", limit))
+		s.log.DebugContext(ctx, "Using default limit for notifications feed", slog.Int("limit", limit))
 		limit = 10
 	}
 
 	if page <= 0 {
-		s.log.Debug("Using first page for notifications feed", slog.Int("page", page))
+		s.log.DebugContext(ctx, "Using first page for notifications feed", slog.Int("page", page))
 		page = 1
 	}
 
 	offset := (page - 1) * limit
 
-	s.log.Info("Retrieving user notification feed",
+	s.log.InfoContext(ctx, "Retrieving user notification feed",
 		slog.Int64("user_id", userID),
 		slog.Int("limit", limit),
 		slog.Int("page", page),
 		slog.Int("offset", offset),
+		slog.Any("states", filter.StateStrings()),
 	)
 
-	notifications, totalCount, err = s.notificationRepo.ListByUser(ctx, userID, limit, offset)
+	notifications, totalCount, err = s.notificationRepo.ListByUser(ctx, userID, filter, limit, offset)
 	if err != nil {
-		s.log.Error("Failed to retrieve notification feed",
+		s.log.ErrorContext(ctx, "Failed to retrieve notification feed",
 			slog.Int64("user_id", userID),
 			slog.Int("limit", limit),
 			slog.Int("page", page),
@@ -194,7 +436,9 @@ func (s *Service) GetUserNotificationFeed(ctx context.Context, userID int64, lim
 		return nil, 0, err
 	}
 
-	s.log.Info("User notification feed retrieved",
+	s.enrichActors(ctx, notifications)
+
+	s.log.InfoContext(ctx, "User notification feed retrieved",
 		slog.Int64("user_id", userID),
 		slog.Int("count", len(notifications)),
 		slog.Int("total_count", int(totalCount)),
@@ -210,27 +454,28 @@ func (s *Service) ReadNotification(ctx context.Context, id int64) (err error) {
 	}()
 
 	if id <= 0 {
-		s.log.Error("Invalid notification ID", slog.Int64("id", id))
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", id))
 		return custom_errors.ErrInvalidInput
 	}
 
-	s.log.Info("Reading notification", slog.Int64("id", id))
+	s.log.InfoContext(ctx, "Reading notification", slog.Int64("id", id))
 
 	err = s.notificationRepo.MarkAsRead(ctx, id)
 	if err != nil {
 		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
-			s.log.Debug("Notification not found", slog.Int64("id", id))
+			s.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
 			return custom_errors.ErrNotificationNotFound
 		}
 
-		s.log.Error("Failed to read notification",
+		s.log.ErrorContext(ctx, "Failed to read notification",
 			slog.Int64("id", id),
 			slog.String("error", err.Error()),
 		)
 		return err
 	}
 
-	s.log.Info("Notification marked as read", slog.Int64("id", id))
+	s.log.InfoContext(ctx, "Notification marked as read", slog.Int64("id", id))
+	s.notifyRead(ctx, id)
 	return nil
 }
 
@@ -240,22 +485,158 @@ func (s *Service) ReadAllUserNotifications(ctx context.Context, userID int64) (e
 	}()
 
 	if userID <= 0 {
-		s.log.Error("Invalid user ID", slog.Int64("user_id", userID))
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
 		return custom_errors.ErrInvalidInput
 	}
 
-	s.log.Info("Reading all notifications for user", slog.Int64("user_id", userID))
+	s.log.InfoContext(ctx, "Reading all notifications for user", slog.Int64("user_id", userID))
+
+	updated, err := s.notificationRepo.MarkAllAsRead(ctx, userID)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to mark all notifications as read",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	s.log.InfoContext(ctx, "All user notifications marked as read",
+		slog.Int64("user_id", userID),
+		slog.Int64("count", updated),
+	)
+	if updated > 0 {
+		s.notifyWebhooks(ctx, "webhook_read_all", 0, func(w WebhookNotifier) error {
+			return w.NotificationsReadAll(ctx, userID, updated)
+		})
+	}
+	return nil
+}
 
-	err = s.notificationRepo.MarkAllAsRead(ctx, userID)
+func (s *Service) ReadUserNotificationsUpTo(ctx context.Context, userID int64, watermark *model.ReadWatermark) (updated int64, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("read_notifications_up_to", err == nil)
+	}()
+
+	if userID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	if !watermark.HasBound() {
+		s.log.ErrorContext(ctx, "Read watermark has no bound", slog.Int64("user_id", userID))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Reading user notifications up to watermark",
+		slog.Int64("user_id", userID),
+		slog.Any("read_before", watermark.ReadBefore),
+		slog.Any("max_id", watermark.MaxID),
+		slog.Any("types", watermark.TypeStrings()),
+	)
+
+	updated, err = s.notificationRepo.MarkAllAsReadUpTo(ctx, userID, watermark)
 	if err != nil {
-		s.log.Error("Failed to mark all notifications as read",
+		s.log.ErrorContext(ctx, "Failed to mark notifications as read up to watermark",
 			slog.Int64("user_id", userID),
 			slog.String("error", err.Error()),
 		)
+		return 0, err
+	}
+
+	s.log.InfoContext(ctx, "User notifications marked as read up to watermark",
+		slog.Int64("user_id", userID),
+		slog.Int64("count", updated),
+	)
+	if updated > 0 {
+		s.notifyWebhooks(ctx, "webhook_read_all", 0, func(w WebhookNotifier) error {
+			return w.NotificationsReadAll(ctx, userID, updated)
+		})
+	}
+	return updated, nil
+}
+
+func (s *Service) UpdateNotificationState(ctx context.Context, id int64, state model.NotificationState) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("update_notification_state", err == nil)
+	}()
+
+	if id <= 0 {
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", id))
+		return custom_errors.ErrInvalidInput
+	}
+
+	if !state.IsValid() {
+		s.log.ErrorContext(ctx, "Invalid notification state", slog.Int64("id", id), slog.String("state", string(state)))
+		return custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Updating notification state", slog.Int64("id", id), slog.String("state", string(state)))
+
+	err = s.notificationRepo.UpdateState(ctx, id, state)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
+			s.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
+			return custom_errors.ErrNotificationNotFound
+		}
+		s.log.ErrorContext(ctx, "Failed to update notification state",
+			slog.Int64("id", id),
+			slog.String("state", string(state)),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	s.log.InfoContext(ctx, "Notification state updated", slog.Int64("id", id), slog.String("state", string(state)))
+	if state == model.NotificationStateRead {
+		s.notifyRead(ctx, id)
+	}
+	return nil
+}
+
+func (s *Service) SetNotificationPinned(ctx context.Context, id int64, pinned bool) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("set_notification_pinned", err == nil)
+	}()
+
+	if id <= 0 {
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", id))
+		return custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Setting notification pinned flag", slog.Int64("id", id), slog.Bool("pinned", pinned))
+
+	notification, err := s.notificationRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
+			s.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
+			return custom_errors.ErrNotificationNotFound
+		}
+		s.log.ErrorContext(ctx, "Failed to get notification for pinning",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	if pinned && notification.State == model.NotificationStateArchived {
+		s.log.DebugContext(ctx, "Archived notification cannot be pinned", slog.Int64("id", id))
+		return custom_errors.ErrOperationNotAllowed
+	}
+
+	err = s.notificationRepo.SetPinned(ctx, id, pinned)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
+			s.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
+			return custom_errors.ErrNotificationNotFound
+		}
+		s.log.ErrorContext(ctx, "Failed to set notification pinned flag",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
 		return err
 	}
 
-	s.log.Info("All user notifications marked as read", slog.Int64("user_id", userID))
+	s.log.InfoContext(ctx, "Notification pinned flag set", slog.Int64("id", id), slog.Bool("pinned", pinned))
 	return nil
 }
 
@@ -265,26 +646,234 @@ func (s *Service) RemoveNotification(ctx context.Context, id int64) (err error)
 	}()
 
 	if id <= 0 {
-		s.log.Error("Invalid notification ID", slog.Int64("id", id))
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", id))
 		return custom_errors.ErrInvalidInput
 	}
 
-	s.log.Info("Removing notification", slog.Int64("id", id))
+	s.log.InfoContext(ctx, "Removing notification", slog.Int64("id", id))
 
 	err = s.notificationRepo.Delete(ctx, id)
 	if err != nil {
 		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
-			s.log.Debug("Notification not found", slog.Int64("id", id))
+			s.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
 			return custom_errors.ErrNotificationNotFound
 		}
 
-		s.log.Error("Failed to remove notification",
+		s.log.ErrorContext(ctx, "Failed to remove notification",
 			slog.Int64("id", id),
 			slog.String("error", err.Error()),
 		)
 		return err
 	}
 
-	s.log.Info("Notification removed successfully", slog.Int64("id", id))
+	s.log.InfoContext(ctx, "Notification removed successfully", slog.Int64("id", id))
 	return nil
 }
+
+func (s *Service) RestoreNotification(ctx context.Context, id int64) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("restore_notification", err == nil)
+	}()
+
+	if id <= 0 {
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", id))
+		return custom_errors.ErrInvalidInput
+	}
+
+	deletedAfter := time.Now().Add(-s.restoreGracePeriod)
+	s.log.InfoContext(ctx, "Restoring notification", slog.Int64("id", id), slog.Time("deleted_after", deletedAfter))
+
+	err = s.notificationRepo.Restore(ctx, id, deletedAfter)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrNotificationNotFound) {
+			s.log.DebugContext(ctx, "Restorable notification not found", slog.Int64("id", id))
+			return custom_errors.ErrNotificationNotFound
+		}
+
+		s.log.ErrorContext(ctx, "Failed to restore notification",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	s.log.InfoContext(ctx, "Notification restored successfully", slog.Int64("id", id))
+	return nil
+}
+
+// PurgeDeletedNotifications hard-deletes tombstones older than olderThan in
+// batches of batchSize until nothing is left or ctx is cancelled. olderThan
+// must not be shorter than the restore grace period, otherwise notifications
+// that could still be restored would be lost.
+func (s *Service) PurgeDeletedNotifications(ctx context.Context, olderThan time.Duration, batchSize int) (purged int64, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("purge_deleted_notifications", err == nil)
+	}()
+
+	if olderThan < s.restoreGracePeriod || batchSize <= 0 {
+		s.log.ErrorContext(ctx, "Invalid purge parameters",
+			slog.Duration("older_than", olderThan),
+			slog.Duration("restore_grace_period", s.restoreGracePeriod),
+			slog.Int("batch_size", batchSize),
+		)
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	deletedBefore := time.Now().Add(-olderThan)
+	s.log.DebugContext(ctx, "Purging deleted notifications", slog.Time("deleted_before", deletedBefore), slog.Int("batch_size", batchSize))
+
+	purged, err = purgeInBatches(ctx, batchSize, func(ctx context.Context) (int64, error) {
+		return s.notificationRepo.PurgeDeleted(ctx, deletedBefore, batchSize)
+	})
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to purge deleted notifications",
+			slog.Int64("purged", purged),
+			slog.String("error", err.Error()),
+		)
+		return purged, err
+	}
+
+	if purged > 0 {
+		s.log.InfoContext(ctx, "Deleted notifications purged", slog.Int64("count", purged))
+	}
+	return purged, nil
+}
+
+// PurgeExpiredNotifications hard-deletes notifications whose expires_at has
+// passed, in batches of batchSize.
+func (s *Service) PurgeExpiredNotifications(ctx context.Context, batchSize int) (purged int64, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("purge_expired_notifications", err == nil)
+	}()
+
+	if batchSize <= 0 {
+		s.log.ErrorContext(ctx, "Invalid batch size", slog.Int("batch_size", batchSize))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	expiredBefore := time.Now()
+	s.log.DebugContext(ctx, "Purging expired notifications", slog.Time("expired_before", expiredBefore), slog.Int("batch_size", batchSize))
+
+	purged, err = purgeInBatches(ctx, batchSize, func(ctx context.Context) (int64, error) {
+		return s.notificationRepo.PurgeExpired(ctx, expiredBefore, batchSize)
+	})
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to purge expired notifications",
+			slog.Int64("purged", purged),
+			slog.String("error", err.Error()),
+		)
+		return purged, err
+	}
+
+	if purged > 0 {
+		s.log.InfoContext(ctx, "Expired notifications purged", slog.Int64("count", purged))
+	}
+	return purged, nil
+}
+
+// purgeInBatches calls purge until it removes fewer than batchSize rows or ctx is cancelled.
+func purgeInBatches(ctx context.Context, batchSize int, purge func(ctx context.Context) (int64, error)) (int64, error) {
+	var purged int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return purged, err
+		}
+
+		count, err := purge(ctx)
+		if err != nil {
+			return purged, err
+		}
+
+		purged += count
+		if count < int64(batchSize) {
+			return purged, nil
+		}
+	}
+}
+
+// DeliverDueNotifications claims scheduled notifications that are due in
+// batches of batchSize and delivers them, until a partial batch is claimed.
+func (s *Service) DeliverDueNotifications(ctx context.Context, batchSize int) (delivered int, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("deliver_due_notifications", err == nil)
+	}()
+
+	if batchSize <= 0 {
+		s.log.ErrorContext(ctx, "Invalid batch size", slog.Int("batch_size", batchSize))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return delivered, err
+		}
+
+		var notifications []*model.Notification
+		notifications, err = s.notificationRepo.ClaimDue(ctx, time.Now(), batchSize)
+		if err != nil {
+			s.log.ErrorContext(ctx, "Failed to claim due notifications",
+				slog.Int("delivered", delivered),
+				slog.String("error", err.Error()),
+			)
+			return delivered, err
+		}
+
+		for _, notification := range notifications {
+			s.deliver(ctx, notification)
+		}
+
+		delivered += len(notifications)
+		if len(notifications) < batchSize {
+			break
+		}
+	}
+
+	if delivered > 0 {
+		s.log.InfoContext(ctx, "Scheduled notifications delivered", slog.Int("count", delivered))
+	}
+	return delivered, nil
+}
+
+func (s *Service) CancelScheduledNotification(ctx context.Context, id int64) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("cancel_scheduled_notification", err == nil)
+	}()
+
+	if id <= 0 {
+		s.log.ErrorContext(ctx, "Invalid notification ID", slog.Int64("id", id))
+		return custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Cancelling scheduled notification", slog.Int64("id", id))
+
+	err = s.notificationRepo.CancelScheduled(ctx, id)
+	if err == nil {
+		s.log.InfoContext(ctx, "Scheduled notification cancelled", slog.Int64("id", id))
+		return nil
+	}
+
+	if !errors.Is(err, custom_errors.ErrNotificationNotFound) {
+		s.log.ErrorContext(ctx, "Failed to cancel scheduled notification",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	// Nothing pending with this ID: tell "already delivered" apart from "unknown".
+	_, err = s.notificationRepo.GetByID(ctx, id)
+	switch {
+	case err == nil:
+		s.log.DebugContext(ctx, "Notification already delivered, cannot cancel", slog.Int64("id", id))
+		return custom_errors.ErrOperationNotAllowed
+	case errors.Is(err, custom_errors.ErrNotificationNotFound):
+		s.log.DebugContext(ctx, "Scheduled notification not found", slog.Int64("id", id))
+		return custom_errors.ErrNotificationNotFound
+	default:
+		s.log.ErrorContext(ctx, "Failed to get notification for cancel",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+}
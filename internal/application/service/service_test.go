@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -201,6 +202,7 @@ func TestService_GetNotificationDetails(t *testing.T) {
 			metrics := prometheus.NewPrometheusMetricsProvider()
 
 			tt.mockSetup(mockRepo)
+			mockUserClient.On("GetUser", mock.Anything, int64(42)).Return(&model.User{ID: 42, Username: "follower"}, nil).Maybe()
 
 			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
 			got, err := service.GetNotificationDetails(context.Background(), tt.id)
@@ -334,7 +336,7 @@ func TestService_GetUserNotificationFeed(t *testing.T) {
 			page:   1,
 			mockSetup: func(repo *mocks.NotificationRepository) {
 				// Page 1, limit 10 should result in offset 0
-				repo.On("ListByUser", mock.Anything, int64(5), 10, 0).Return(notifications, int32(2), nil)
+				repo.On("ListByUser", mock.Anything, int64(5), (*model.FeedFilter)(nil), 10, 0).Return(notifications, int32(2), nil)
 			},
 			want:      notifications,
 			wantTotal: 2,
@@ -347,7 +349,7 @@ func TestService_GetUserNotificationFeed(t *testing.T) {
 			page:   2,
 			mockSetup: func(repo *mocks.NotificationRepository) {
 				// Page 2, limit 5 should result in offset 5
-				repo.On("ListByUser", mock.Anything, int64(5), 5, 5).Return(notifications[1:], int32(2), nil)
+				repo.On("ListByUser", mock.Anything, int64(5), (*model.FeedFilter)(nil), 5, 5).Return(notifications[1:], int32(2), nil)
 			},
 			want:      notifications[1:],
 			wantTotal: 2,
@@ -360,7 +362,7 @@ func TestService_GetUserNotificationFeed(t *testing.T) {
 			page:   1,
 			mockSetup: func(repo *mocks.NotificationRepository) {
 				// Negative limit should be changed to default (10)
-				repo.On("ListByUser", mock.Anything, int64(5), 10, 0).Return(notifications, int32(2), nil)
+				repo.On("ListByUser", mock.Anything, int64(5), (*model.FeedFilter)(nil), 10, 0).Return(notifications, int32(2), nil)
 			},
 			want:      notifications,
 			wantTotal: 2,
@@ -373,7 +375,7 @@ func TestService_GetUserNotificationFeed(t *testing.T) {
 			page:   -1,
 			mockSetup: func(repo *mocks.NotificationRepository) {
 				// Negative page should be changed to 1
-				repo.On("ListByUser", mock.Anything, int64(5), 10, 0).Return(notifications, int32(2), nil)
+				repo.On("ListByUser", mock.Anything, int64(5), (*model.FeedFilter)(nil), 10, 0).Return(notifications, int32(2), nil)
 			},
 			want:      notifications,
 			wantTotal: 2,
@@ -385,7 +387,7 @@ func TestService_GetUserNotificationFeed(t *testing.T) {
 			limit:  10,
 			page:   1,
 			mockSetup: func(repo *mocks.NotificationRepository) {
-				repo.On("ListByUser", mock.Anything, int64(5), 10, 0).Return(nil, int32(0), custom_errors.ErrDatabaseQuery)
+				repo.On("ListByUser", mock.Anything, int64(5), (*model.FeedFilter)(nil), 10, 0).Return(nil, int32(0), custom_errors.ErrDatabaseQuery)
 			},
 			want:        nil,
 			wantTotal:   0,
@@ -413,9 +415,10 @@ func TestService_GetUserNotificationFeed(t *testing.T) {
 			metrics := prometheus.NewPrometheusMetricsProvider()
 
 			tt.mockSetup(mockRepo)
+			mockUserClient.On("GetUser", mock.Anything, mock.Anything).Return(nil, custom_errors.ErrUserNotFound).Maybe()
 
 			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
-			got, gotTotal, err := service.GetUserNotificationFeed(context.Background(), tt.userID, tt.limit, tt.page)
+			got, gotTotal, err := service.GetUserNotificationFeed(context.Background(), tt.userID, nil, tt.limit, tt.page)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -511,7 +514,7 @@ func TestService_ReadAllUserNotifications(t *testing.T) {
 			name:   "successful mark all as read",
 			userID: 1,
 			mockSetup: func(repo *mocks.NotificationRepository) {
-				repo.On("MarkAllAsRead", mock.Anything, int64(1)).Return(nil)
+				repo.On("MarkAllAsRead", mock.Anything, int64(1)).Return(int64(3), nil)
 			},
 			wantErr: false,
 		},
@@ -519,7 +522,7 @@ func TestService_ReadAllUserNotifications(t *testing.T) {
 			name:   "repository error",
 			userID: 1,
 			mockSetup: func(repo *mocks.NotificationRepository) {
-				repo.On("MarkAllAsRead", mock.Anything, int64(1)).Return(custom_errors.ErrDatabaseQuery)
+				repo.On("MarkAllAsRead", mock.Anything, int64(1)).Return(int64(0), custom_errors.ErrDatabaseQuery)
 			},
 			wantErr:     true,
 			expectedErr: custom_errors.ErrDatabaseQuery,
@@ -557,6 +560,263 @@ func TestService_ReadAllUserNotifications(t *testing.T) {
 	}
 }
 
+func TestService_ReadUserNotificationsUpTo(t *testing.T) {
+	readBefore := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+	maxID := int64(42)
+
+	tests := []struct {
+		name          string
+		userID        int64
+		watermark     *model.ReadWatermark
+		mockSetup     func(*mocks.NotificationRepository)
+		wantErr       bool
+		expectedErr   error
+		expectedCount int64
+	}{
+		{
+			name:      "successful mark as read up to timestamp",
+			userID:    1,
+			watermark: &model.ReadWatermark{ReadBefore: &readBefore},
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("MarkAllAsReadUpTo", mock.Anything, int64(1), mock.Anything).Return(int64(4), nil)
+			},
+			wantErr:       false,
+			expectedCount: 4,
+		},
+		{
+			name:      "successful mark as read up to max ID with type filter",
+			userID:    1,
+			watermark: &model.ReadWatermark{MaxID: &maxID, Types: []events.EventType{events.EventTypeFollowCreated}},
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("MarkAllAsReadUpTo", mock.Anything, int64(1), mock.Anything).Return(int64(2), nil)
+			},
+			wantErr:       false,
+			expectedCount: 2,
+		},
+		{
+			name:      "repository error",
+			userID:    1,
+			watermark: &model.ReadWatermark{MaxID: &maxID},
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("MarkAllAsReadUpTo", mock.Anything, int64(1), mock.Anything).Return(int64(0), custom_errors.ErrDatabaseQuery)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:        "invalid user ID",
+			userID:      0,
+			watermark:   &model.ReadWatermark{MaxID: &maxID},
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:        "watermark without bound",
+			userID:      1,
+			watermark:   &model.ReadWatermark{Types: []events.EventType{events.EventTypeFollowCreated}},
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:        "nil watermark",
+			userID:      1,
+			watermark:   nil,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			count, err := service.ReadUserNotificationsUpTo(context.Background(), tt.userID, tt.watermark)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, int64(0), count)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestService_UpdateNotificationState(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		state       model.NotificationState
+		mockSetup   func(*mocks.NotificationRepository)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:  "successful mark as unread",
+			id:    1,
+			state: model.NotificationStateUnread,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("UpdateState", mock.Anything, int64(1), model.NotificationStateUnread).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "successful archive",
+			id:    1,
+			state: model.NotificationStateArchived,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("UpdateState", mock.Anything, int64(1), model.NotificationStateArchived).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "notification not found",
+			id:    999,
+			state: model.NotificationStateRead,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("UpdateState", mock.Anything, int64(999), model.NotificationStateRead).Return(custom_errors.ErrNotificationNotFound)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name:        "invalid state",
+			id:          1,
+			state:       model.NotificationState("deleted"),
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:        "invalid ID",
+			id:          0,
+			state:       model.NotificationStateRead,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			err := service.UpdateNotificationState(context.Background(), tt.id, tt.state)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_SetNotificationPinned(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		pinned      bool
+		mockSetup   func(*mocks.NotificationRepository)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:   "successful pin",
+			id:     1,
+			pinned: true,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("GetByID", mock.Anything, int64(1)).Return(&model.Notification{ID: 1, State: model.NotificationStateRead}, nil)
+				repo.On("SetPinned", mock.Anything, int64(1), true).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "unpin archived notification",
+			id:     1,
+			pinned: false,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("GetByID", mock.Anything, int64(1)).Return(&model.Notification{ID: 1, State: model.NotificationStateArchived}, nil)
+				repo.On("SetPinned", mock.Anything, int64(1), false).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "pin archived notification not allowed",
+			id:     1,
+			pinned: true,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("GetByID", mock.Anything, int64(1)).Return(&model.Notification{ID: 1, State: model.NotificationStateArchived}, nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrOperationNotAllowed,
+		},
+		{
+			name:   "notification not found",
+			id:     999,
+			pinned: true,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("GetByID", mock.Anything, int64(999)).Return(nil, custom_errors.ErrNotificationNotFound)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name:        "invalid ID",
+			id:          0,
+			pinned:      true,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			err := service.SetNotificationPinned(context.Background(), tt.id, tt.pinned)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestService_RemoveNotification(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -623,3 +883,1136 @@ func TestService_RemoveNotification(t *testing.T) {
 		})
 	}
 }
+
+func TestService_RestoreNotification(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		mockSetup   func(*mocks.NotificationRepository)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "successful restore",
+			id:   1,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("Restore", mock.Anything, int64(1), mock.MatchedBy(func(deletedAfter time.Time) bool {
+					return time.Since(deletedAfter) >= notification_service.DefaultRestoreGracePeriod
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "notification not found or grace period expired",
+			id:   999,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("Restore", mock.Anything, int64(999), mock.Anything).Return(custom_errors.ErrNotificationNotFound)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name: "repository error",
+			id:   1,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("Restore", mock.Anything, int64(1), mock.Anything).Return(custom_errors.ErrDatabaseQuery)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:        "invalid ID",
+			id:          0,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			err := service.RestoreNotification(context.Background(), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_PurgeDeletedNotifications(t *testing.T) {
+	tests := []struct {
+		name           string
+		olderThan      time.Duration
+		batchSize      int
+		mockSetup      func(*mocks.NotificationRepository)
+		wantErr        bool
+		expectedErr    error
+		expectedPurged int64
+	}{
+		{
+			name:      "purges in batches until a partial batch",
+			olderThan: 30 * 24 * time.Hour,
+			batchSize: 2,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("PurgeDeleted", mock.Anything, mock.Anything, 2).Return(int64(2), nil).Twice()
+				repo.On("PurgeDeleted", mock.Anything, mock.Anything, 2).Return(int64(1), nil).Once()
+			},
+			wantErr:        false,
+			expectedPurged: 5,
+		},
+		{
+			name:      "nothing to purge",
+			olderThan: 30 * 24 * time.Hour,
+			batchSize: 100,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("PurgeDeleted", mock.Anything, mock.Anything, 100).Return(int64(0), nil).Once()
+			},
+			wantErr:        false,
+			expectedPurged: 0,
+		},
+		{
+			name:      "repository error keeps purged count",
+			olderThan: 30 * 24 * time.Hour,
+			batchSize: 1,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("PurgeDeleted", mock.Anything, mock.Anything, 1).Return(int64(1), nil).Once()
+				repo.On("PurgeDeleted", mock.Anything, mock.Anything, 1).Return(int64(0), custom_errors.ErrDatabaseQuery).Once()
+			},
+			wantErr:        true,
+			expectedErr:    custom_errors.ErrDatabaseQuery,
+			expectedPurged: 1,
+		},
+		{
+			name:        "retention shorter than restore grace period",
+			olderThan:   time.Hour,
+			batchSize:   100,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:        "invalid batch size",
+			olderThan:   30 * 24 * time.Hour,
+			batchSize:   0,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			purged, err := service.PurgeDeletedNotifications(context.Background(), tt.olderThan, tt.batchSize)
+
+			assert.Equal(t, tt.expectedPurged, purged)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_SendNotification_Delivery(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		deliverAt      *time.Time
+		publisherSetup func(*mocks.NotificationPublisher)
+		wantScheduled  bool
+	}{
+		{
+			name:      "immediate notification is published",
+			deliverAt: nil,
+			publisherSetup: func(p *mocks.NotificationPublisher) {
+				p.On("PublishDelivered", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.ID == 10
+				})).Return(nil)
+			},
+			wantScheduled: false,
+		},
+		{
+			name:      "publish failure does not fail the save",
+			deliverAt: nil,
+			publisherSetup: func(p *mocks.NotificationPublisher) {
+				p.On("PublishDelivered", mock.Anything, mock.Anything).Return(custom_errors.ErrExternalServiceError)
+			},
+			wantScheduled: false,
+		},
+		{
+			name:           "future notification is scheduled and not published",
+			deliverAt:      &future,
+			publisherSetup: func(p *mocks.NotificationPublisher) {},
+			wantScheduled:  true,
+		},
+		{
+			name:      "past deliver_at is delivered immediately",
+			deliverAt: &past,
+			publisherSetup: func(p *mocks.NotificationPublisher) {
+				p.On("PublishDelivered", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantScheduled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			mockPublisher := mocks.NewNotificationPublisher(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1, Username: "testuser"}, nil)
+			mockRepo.On("Create", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(1).(*model.Notification).ID = 10
+				}).
+				Return(int64(10), nil)
+			tt.publisherSetup(mockPublisher)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics,
+				notification_service.WithNotificationPublisher(mockPublisher))
+
+			notification := &model.Notification{
+				UserID:    1,
+				Type:      events.EventTypeFollowCreated,
+				DeliverAt: tt.deliverAt,
+				Payload:   json.RawMessage(`{"follower_id":42}`),
+			}
+			id, err := service.SaveNotification(context.Background(), notification)
+
+			require.NoError(t, err)
+			assert.Equal(t, int64(10), id)
+			assert.Equal(t, tt.wantScheduled, notification.DeliverAt != nil)
+			if tt.wantScheduled {
+				assert.Equal(t, *tt.deliverAt, notification.CreatedAt)
+			}
+		})
+	}
+}
+
+func TestService_SendNotification_Dispatch(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name            string
+		deliverAt       *time.Time
+		dispatcherSetup func(*mocks.DeliveryService)
+	}{
+		{
+			name: "delivered notification is dispatched to channels",
+			dispatcherSetup: func(d *mocks.DeliveryService) {
+				d.On("Dispatch", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.ID == 10
+				})).Return(2, nil)
+			},
+		},
+		{
+			name: "dispatch failure does not fail the save",
+			dispatcherSetup: func(d *mocks.DeliveryService) {
+				d.On("Dispatch", mock.Anything, mock.Anything).Return(0, custom_errors.ErrDatabaseQuery)
+			},
+		},
+		{
+			name:            "scheduled notification is not dispatched yet",
+			deliverAt:       &future,
+			dispatcherSetup: func(d *mocks.DeliveryService) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			mockDispatcher := mocks.NewDeliveryService(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1, Username: "testuser"}, nil)
+			mockRepo.On("Create", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(1).(*model.Notification).ID = 10
+				}).
+				Return(int64(10), nil)
+			tt.dispatcherSetup(mockDispatcher)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics,
+				notification_service.WithDispatcher(mockDispatcher))
+
+			id, err := service.SaveNotification(context.Background(), &model.Notification{
+				UserID:    1,
+				Type:      events.EventTypeFollowCreated,
+				DeliverAt: tt.deliverAt,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, int64(10), id)
+		})
+	}
+}
+
+func TestService_DeliverDueNotifications(t *testing.T) {
+	tests := []struct {
+		name              string
+		batchSize         int
+		mockSetup         func(*mocks.NotificationRepository, *mocks.NotificationPublisher)
+		wantErr           bool
+		expectedErr       error
+		expectedDelivered int
+	}{
+		{
+			name:      "delivers batches until a partial batch",
+			batchSize: 2,
+			mockSetup: func(repo *mocks.NotificationRepository, p *mocks.NotificationPublisher) {
+				repo.On("ClaimDue", mock.Anything, mock.Anything, 2).Return([]*model.Notification{{ID: 1}, {ID: 2}}, nil).Once()
+				repo.On("ClaimDue", mock.Anything, mock.Anything, 2).Return([]*model.Notification{{ID: 3}}, nil).Once()
+				p.On("PublishDelivered", mock.Anything, mock.Anything).Return(nil).Times(3)
+			},
+			wantErr:           false,
+			expectedDelivered: 3,
+		},
+		{
+			name:      "nothing due",
+			batchSize: 10,
+			mockSetup: func(repo *mocks.NotificationRepository, p *mocks.NotificationPublisher) {
+				repo.On("ClaimDue", mock.Anything, mock.Anything, 10).Return([]*model.Notification{}, nil).Once()
+			},
+			wantErr:           false,
+			expectedDelivered: 0,
+		},
+		{
+			name:      "claim error",
+			batchSize: 10,
+			mockSetup: func(repo *mocks.NotificationRepository, p *mocks.NotificationPublisher) {
+				repo.On("ClaimDue", mock.Anything, mock.Anything, 10).Return(nil, custom_errors.ErrDatabaseQuery).Once()
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:        "invalid batch size",
+			batchSize:   0,
+			mockSetup:   func(repo *mocks.NotificationRepository, p *mocks.NotificationPublisher) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			mockPublisher := mocks.NewNotificationPublisher(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo, mockPublisher)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics,
+				notification_service.WithNotificationPublisher(mockPublisher))
+			delivered, err := service.DeliverDueNotifications(context.Background(), tt.batchSize)
+
+			assert.Equal(t, tt.expectedDelivered, delivered)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_CancelScheduledNotification(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		mockSetup   func(*mocks.NotificationRepository)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "successful cancel",
+			id:   1,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("CancelScheduled", mock.Anything, int64(1)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "already delivered",
+			id:   2,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("CancelScheduled", mock.Anything, int64(2)).Return(custom_errors.ErrNotificationNotFound)
+				repo.On("GetByID", mock.Anything, int64(2)).Return(&model.Notification{ID: 2}, nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrOperationNotAllowed,
+		},
+		{
+			name: "notification not found",
+			id:   999,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("CancelScheduled", mock.Anything, int64(999)).Return(custom_errors.ErrNotificationNotFound)
+				repo.On("GetByID", mock.Anything, int64(999)).Return(nil, custom_errors.ErrNotificationNotFound)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name: "repository error",
+			id:   1,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("CancelScheduled", mock.Anything, int64(1)).Return(custom_errors.ErrDatabaseQuery)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:        "invalid ID",
+			id:          0,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			err := service.CancelScheduledNotification(context.Background(), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_SendNotification_Expiry(t *testing.T) {
+	tests := []struct {
+		name        string
+		deliverAt   *time.Time
+		expiresAt   *time.Time
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:      "expires in the future",
+			expiresAt: timePtr(time.Now().Add(time.Hour)),
+			wantErr:   false,
+		},
+		{
+			name:      "expires after scheduled delivery",
+			deliverAt: timePtr(time.Now().Add(time.Hour)),
+			expiresAt: timePtr(time.Now().Add(2 * time.Hour)),
+			wantErr:   false,
+		},
+		{
+			name:        "already expired",
+			expiresAt:   timePtr(time.Now().Add(-time.Minute)),
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:        "expires before scheduled delivery",
+			deliverAt:   timePtr(time.Now().Add(2 * time.Hour)),
+			expiresAt:   timePtr(time.Now().Add(time.Hour)),
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1, Username: "testuser"}, nil)
+			if !tt.wantErr {
+				mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.ExpiresAt != nil && n.ExpiresAt.Equal(*tt.expiresAt)
+				})).Return(int64(1), nil)
+			}
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			_, err := service.SaveNotification(context.Background(), &model.Notification{
+				UserID:    1,
+				Type:      "live_now",
+				DeliverAt: tt.deliverAt,
+				ExpiresAt: tt.expiresAt,
+			})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_SendNotification_ActorCaps(t *testing.T) {
+	caps := map[events.EventType]model.ActorCap{
+		events.EventTypeFollowCreated: {Max: 1, Window: 24 * time.Hour},
+	}
+	followPayload := json.RawMessage(`{"follower_id":42,"followee_id":1}`)
+
+	tests := []struct {
+		name         string
+		notification *model.Notification
+		mockSetup    func(mockRepo *mocks.NotificationRepository)
+		wantID       int64
+		expectedErr  error
+	}{
+		{
+			name:         "under the cap",
+			notification: &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+			mockSetup: func(mockRepo *mocks.NotificationRepository) {
+				mockRepo.On("CountFromActorSince", mock.Anything, int64(1), int64(42), events.EventTypeFollowCreated, mock.AnythingOfType("time.Time")).Return(0, nil)
+				mockRepo.On("Create", mock.Anything, mock.Anything).Return(int64(7), nil)
+			},
+			wantID: 7,
+		},
+		{
+			name:         "over the cap is suppressed and recorded",
+			notification: &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+			mockSetup: func(mockRepo *mocks.NotificationRepository) {
+				mockRepo.On("CountFromActorSince", mock.Anything, int64(1), int64(42), events.EventTypeFollowCreated, mock.AnythingOfType("time.Time")).Return(1, nil)
+				mockRepo.On("CreateSuppression", mock.Anything, mock.MatchedBy(func(s *model.NotificationSuppression) bool {
+					return s.UserID == 1 && s.ActorID == 42 && s.Type == events.EventTypeFollowCreated && s.Reason == model.SuppressionReasonActorCap
+				})).Return(int64(1), nil)
+			},
+			expectedErr: model.ErrNotificationSuppressed,
+		},
+		{
+			name:         "failed suppression record still suppresses",
+			notification: &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+			mockSetup: func(mockRepo *mocks.NotificationRepository) {
+				mockRepo.On("CountFromActorSince", mock.Anything, int64(1), int64(42), events.EventTypeFollowCreated, mock.AnythingOfType("time.Time")).Return(3, nil)
+				mockRepo.On("CreateSuppression", mock.Anything, mock.Anything).Return(int64(0), custom_errors.ErrDatabaseQuery)
+			},
+			expectedErr: model.ErrNotificationSuppressed,
+		},
+		{
+			name:         "count failure lets the notification through",
+			notification: &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+			mockSetup: func(mockRepo *mocks.NotificationRepository) {
+				mockRepo.On("CountFromActorSince", mock.Anything, int64(1), int64(42), events.EventTypeFollowCreated, mock.AnythingOfType("time.Time")).Return(0, custom_errors.ErrDatabaseQuery)
+				mockRepo.On("Create", mock.Anything, mock.Anything).Return(int64(8), nil)
+			},
+			wantID: 8,
+		},
+		{
+			name:         "types without a cap are not checked",
+			notification: &model.Notification{UserID: 1, Type: "live_now", Payload: json.RawMessage(`{"actor_id":42}`)},
+			mockSetup: func(mockRepo *mocks.NotificationRepository) {
+				mockRepo.On("Create", mock.Anything, mock.Anything).Return(int64(9), nil)
+			},
+			wantID: 9,
+		},
+		{
+			name:         "notifications without an actor are not checked",
+			notification: &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated},
+			mockSetup: func(mockRepo *mocks.NotificationRepository) {
+				mockRepo.On("Create", mock.Anything, mock.Anything).Return(int64(10), nil)
+			},
+			wantID: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1, Username: "testuser"}, nil)
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics, notification_service.WithActorCaps(caps))
+			id, err := service.SaveNotification(context.Background(), tt.notification)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Zero(t, id)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantID, id)
+			}
+		})
+	}
+}
+
+func TestService_SendNotification_Priority(t *testing.T) {
+	defaults := map[events.EventType]model.NotificationPriority{
+		events.EventTypeFollowCreated: model.NotificationPriorityHigh,
+	}
+
+	tests := []struct {
+		name         string
+		notification *model.Notification
+		wantPriority model.NotificationPriority
+		expectedErr  error
+	}{
+		{
+			name:         "explicit priority is kept",
+			notification: &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated, Priority: model.NotificationPriorityLow},
+			wantPriority: model.NotificationPriorityLow,
+		},
+		{
+			name:         "type default fills in missing priority",
+			notification: &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated},
+			wantPriority: model.NotificationPriorityHigh,
+		},
+		{
+			name:         "types without a default are normal",
+			notification: &model.Notification{UserID: 1, Type: "relation"},
+			wantPriority: model.NotificationPriorityNormal,
+		},
+		{
+			name:         "unknown priority is rejected",
+			notification: &model.Notification{UserID: 1, Type: "relation", Priority: "urgent"},
+			expectedErr:  custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1, Username: "testuser"}, nil)
+			if tt.expectedErr == nil {
+				mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(notif *model.Notification) bool {
+					return notif.Priority == tt.wantPriority
+				})).Return(int64(5), nil)
+			}
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics, notification_service.WithPriorityDefaults(defaults))
+			id, err := service.SaveNotification(context.Background(), tt.notification)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Zero(t, id)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int64(5), id)
+			}
+		})
+	}
+}
+
+func TestService_PurgeExpiredNotifications(t *testing.T) {
+	tests := []struct {
+		name           string
+		batchSize      int
+		mockSetup      func(*mocks.NotificationRepository)
+		wantErr        bool
+		expectedErr    error
+		expectedPurged int64
+	}{
+		{
+			name:      "purges in batches",
+			batchSize: 10,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("PurgeExpired", mock.Anything, mock.Anything, 10).Return(int64(10), nil).Once()
+				repo.On("PurgeExpired", mock.Anything, mock.Anything, 10).Return(int64(4), nil).Once()
+			},
+			expectedPurged: 14,
+		},
+		{
+			name:      "repository error",
+			batchSize: 10,
+			mockSetup: func(repo *mocks.NotificationRepository) {
+				repo.On("PurgeExpired", mock.Anything, mock.Anything, 10).Return(int64(0), custom_errors.ErrDatabaseQuery).Once()
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:        "invalid batch size",
+			batchSize:   -1,
+			mockSetup:   func(repo *mocks.NotificationRepository) {},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics)
+			purged, err := service.PurgeExpiredNotifications(context.Background(), tt.batchSize)
+
+			assert.Equal(t, tt.expectedPurged, purged)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestService_Webhooks(t *testing.T) {
+	notification := &model.Notification{ID: 10, UserID: 1, Type: events.EventTypeFollowCreated}
+
+	tests := []struct {
+		name  string
+		setup func(*mocks.NotificationRepository, *mocks.WebhookService)
+		run   func(*notification_service.Service) error
+	}{
+		{
+			name: "saved notification is reported as created",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("Create", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) { args.Get(1).(*model.Notification).ID = 10 }).
+					Return(int64(10), nil)
+				w.On("NotificationCreated", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.ID == 10
+				})).Return(nil)
+			},
+			run: func(s *notification_service.Service) error {
+				_, err := s.SaveNotification(context.Background(), &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated})
+				return err
+			},
+		},
+		{
+			name: "webhook failure does not fail the save",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("Create", mock.Anything, mock.Anything).Return(int64(10), nil)
+				w.On("NotificationCreated", mock.Anything, mock.Anything).Return(custom_errors.ErrDatabaseQuery)
+			},
+			run: func(s *notification_service.Service) error {
+				_, err := s.SaveNotification(context.Background(), &model.Notification{UserID: 1, Type: events.EventTypeFollowCreated})
+				return err
+			},
+		},
+		{
+			name: "read notification is reported with its details",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("MarkAsRead", mock.Anything, int64(10)).Return(nil)
+				r.On("GetByID", mock.Anything, int64(10)).Return(notification, nil)
+				w.On("NotificationRead", mock.Anything, notification).Return(nil)
+			},
+			run: func(s *notification_service.Service) error {
+				return s.ReadNotification(context.Background(), 10)
+			},
+		},
+		{
+			name: "state change to read is reported",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("UpdateState", mock.Anything, int64(10), model.NotificationStateRead).Return(nil)
+				r.On("GetByID", mock.Anything, int64(10)).Return(notification, nil)
+				w.On("NotificationRead", mock.Anything, notification).Return(nil)
+			},
+			run: func(s *notification_service.Service) error {
+				return s.UpdateNotificationState(context.Background(), 10, model.NotificationStateRead)
+			},
+		},
+		{
+			name: "archiving is not reported",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("UpdateState", mock.Anything, int64(10), model.NotificationStateArchived).Return(nil)
+			},
+			run: func(s *notification_service.Service) error {
+				return s.UpdateNotificationState(context.Background(), 10, model.NotificationStateArchived)
+			},
+		},
+		{
+			name: "read all is reported with its count",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("MarkAllAsRead", mock.Anything, int64(1)).Return(int64(3), nil)
+				w.On("NotificationsReadAll", mock.Anything, int64(1), int64(3)).Return(nil)
+			},
+			run: func(s *notification_service.Service) error {
+				return s.ReadAllUserNotifications(context.Background(), 1)
+			},
+		},
+		{
+			name: "read all without unread notifications is not reported",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("MarkAllAsRead", mock.Anything, int64(1)).Return(int64(0), nil)
+			},
+			run: func(s *notification_service.Service) error {
+				return s.ReadAllUserNotifications(context.Background(), 1)
+			},
+		},
+		{
+			name: "bulk read is reported with its count",
+			setup: func(r *mocks.NotificationRepository, w *mocks.WebhookService) {
+				r.On("MarkAllAsReadUpTo", mock.Anything, int64(1), mock.Anything).Return(int64(4), nil)
+				w.On("NotificationsReadAll", mock.Anything, int64(1), int64(4)).Return(nil)
+			},
+			run: func(s *notification_service.Service) error {
+				maxID := int64(50)
+				_, err := s.ReadUserNotificationsUpTo(context.Background(), 1, &model.ReadWatermark{MaxID: &maxID})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			mockWebhooks := mocks.NewWebhookService(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1, Username: "testuser"}, nil).Maybe()
+			tt.setup(mockRepo, mockWebhooks)
+
+			service := notification_service.NewNotificationService(log, mockRepo, mockUserClient, metrics,
+				notification_service.WithWebhooks(mockWebhooks))
+
+			require.NoError(t, tt.run(service))
+		})
+	}
+}
+
+func TestService_LocalizeNotifications(t *testing.T) {
+	t.Run("renders text for every notification", func(t *testing.T) {
+		renderer := mocks.NewNotificationRenderer(t)
+		first := &model.Notification{ID: 1, UserID: 1, Type: events.EventTypeFollowCreated}
+		second := &model.Notification{ID: 2, UserID: 1, Type: events.EventTypeFollowDeleted}
+		renderer.On("Render", first, "ru").Return(&model.RenderedText{Locale: "ru", Title: "Новый подписчик"}, nil)
+		renderer.On("Render", second, "ru").Return(nil, assert.AnError)
+
+		service := notification_service.NewNotificationService(logger.New("dev"), mocks.NewNotificationRepository(t), mocks.NewClient(t),
+			prometheus.NewPrometheusMetricsProvider(), notification_service.WithRenderer(renderer))
+		service.LocalizeNotifications([]*model.Notification{first, second}, "ru")
+
+		require.NotNil(t, first.Text)
+		assert.Equal(t, "Новый подписчик", first.Text.Title)
+		assert.Nil(t, second.Text)
+	})
+
+	t.Run("without renderer notifications carry no text", func(t *testing.T) {
+		notification := &model.Notification{ID: 1, UserID: 1, Type: events.EventTypeFollowCreated}
+
+		service := notification_service.NewNotificationService(logger.New("dev"), mocks.NewNotificationRepository(t), mocks.NewClient(t),
+			prometheus.NewPrometheusMetricsProvider())
+		service.LocalizeNotifications([]*model.Notification{notification}, "en")
+
+		assert.Nil(t, notification.Text)
+	})
+}
+
+func TestService_GetUserNotificationFeed_Actors(t *testing.T) {
+	fullName := "Jane Doe"
+	avatarURL := "https://cdn.pinstack.local/avatars/42.png"
+	newFeed := func() []*model.Notification {
+		return []*model.Notification{
+			{ID: 1, UserID: 5, Type: events.EventTypeFollowCreated, Payload: json.RawMessage(`{"follower_id":42}`)},
+			{ID: 2, UserID: 5, Type: events.EventTypeFollowCreated, Payload: json.RawMessage(`{"follower_id":43}`)},
+			{ID: 3, UserID: 5, Type: events.EventTypeFollowCreated, Payload: json.RawMessage(`{"follower_id":42}`)},
+			{ID: 4, UserID: 5, Type: "system", Payload: json.RawMessage(`{"message":"hi"}`)},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		setup      func(*mocks.Client)
+		wantActors map[int64]*model.Actor
+	}{
+		{
+			name: "each actor is looked up once and attached to its notifications",
+			setup: func(c *mocks.Client) {
+				c.On("GetUser", mock.Anything, int64(42)).
+					Return(&model.User{ID: 42, Username: "jane", Email: "jane@example.com", FullName: &fullName, AvatarURL: &avatarURL}, nil).Once()
+				c.On("GetUser", mock.Anything, int64(43)).
+					Return(&model.User{ID: 43, Username: "john"}, nil).Once()
+			},
+			wantActors: map[int64]*model.Actor{
+				1: {ID: 42, Username: "jane", FullName: &fullName, AvatarURL: &avatarURL},
+				2: {ID: 43, Username: "john"},
+				3: {ID: 42, Username: "jane", FullName: &fullName, AvatarURL: &avatarURL},
+			},
+		},
+		{
+			name: "missing and failed lookups leave the actor empty",
+			setup: func(c *mocks.Client) {
+				c.On("GetUser", mock.Anything, int64(42)).Return(nil, custom_errors.ErrUserNotFound).Once()
+				c.On("GetUser", mock.Anything, int64(43)).Return(nil, custom_errors.ErrExternalServiceError).Once()
+			},
+			wantActors: map[int64]*model.Actor{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			feed := newFeed()
+
+			mockRepo.On("ListByUser", mock.Anything, int64(5), (*model.FeedFilter)(nil), 10, 0).Return(feed, int32(len(feed)), nil)
+			tt.setup(mockUserClient)
+
+			service := notification_service.NewNotificationService(logger.New("dev"), mockRepo, mockUserClient,
+				prometheus.NewPrometheusMetricsProvider(), notification_service.WithActorLookup(1, time.Second))
+			got, _, err := service.GetUserNotificationFeed(context.Background(), 5, nil, 10, 1)
+
+			require.NoError(t, err)
+			require.Len(t, got, len(feed))
+			for _, n := range got {
+				assert.Equal(t, tt.wantActors[n.ID], n.Actor, "notification %d", n.ID)
+			}
+		})
+	}
+}
+
+func TestService_SaveNotifications(t *testing.T) {
+	payload := json.RawMessage(`{"pin_id":5}`)
+
+	setIDs := func(args mock.Arguments) {
+		for i, n := range args.Get(1).([]*model.Notification) {
+			n.ID = int64(100 + i)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		notifications []*model.Notification
+		mockSetup     func(*mocks.NotificationRepository, *mocks.Client)
+		wantErrs      []error
+		wantIDs       []int64
+		expectedErr   error
+	}{
+		{
+			name: "partial failure",
+			notifications: []*model.Notification{
+				{UserID: 1, Type: "new_pin", Payload: payload},
+				{UserID: 2, Type: "new_pin", Payload: payload},
+				{UserID: 1, Type: "new_comment", Payload: payload},
+				{UserID: 0, Type: "new_pin", Payload: payload},
+				{UserID: 3, Payload: payload},
+				{UserID: 4, Type: "new_pin", Payload: payload},
+			},
+			mockSetup: func(mockRepo *mocks.NotificationRepository, mockUserClient *mocks.Client) {
+				mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1}, nil).Once()
+				mockUserClient.On("GetUser", mock.Anything, int64(2)).Return(nil, custom_errors.ErrUserNotFound).Once()
+				mockUserClient.On("GetUser", mock.Anything, int64(3)).Return(&model.User{ID: 3}, nil).Once()
+				mockUserClient.On("GetUser", mock.Anything, int64(4)).Return(nil, custom_errors.ErrExternalServiceError).Once()
+				mockRepo.On("CreateMany", mock.Anything, mock.MatchedBy(func(ns []*model.Notification) bool {
+					return len(ns) == 2 && ns[0].Type == "new_pin" && ns[1].Type == "new_comment" &&
+						ns[0].State == model.NotificationStateUnread && ns[1].Priority == model.NotificationPriorityNormal
+				})).Run(setIDs).Return([]int64{100, 101}, nil)
+			},
+			wantErrs: []error{nil, custom_errors.ErrUserNotFound, nil, custom_errors.ErrInvalidInput, custom_errors.ErrInvalidInput, custom_errors.ErrExternalServiceError},
+			wantIDs:  []int64{100, 0, 101, 0, 0, 0},
+		},
+		{
+			name: "nothing to store",
+			notifications: []*model.Notification{
+				{UserID: 2, Type: "new_pin", Payload: payload},
+				nil,
+			},
+			mockSetup: func(mockRepo *mocks.NotificationRepository, mockUserClient *mocks.Client) {
+				mockUserClient.On("GetUser", mock.Anything, int64(2)).Return(nil, custom_errors.ErrUserNotFound)
+			},
+			wantErrs: []error{custom_errors.ErrUserNotFound, custom_errors.ErrInvalidInput},
+			wantIDs:  []int64{0},
+		},
+		{
+			name: "broadcast recipient already notified",
+			notifications: []*model.Notification{
+				{UserID: 1, Type: "new_pin", Payload: payload, BroadcastID: 3},
+				{UserID: 2, Type: "new_pin", Payload: payload, BroadcastID: 3},
+			},
+			mockSetup: func(mockRepo *mocks.NotificationRepository, mockUserClient *mocks.Client) {
+				mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1}, nil)
+				mockUserClient.On("GetUser", mock.Anything, int64(2)).Return(&model.User{ID: 2}, nil)
+				mockRepo.On("CreateMany", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					args.Get(1).([]*model.Notification)[1].ID = 100
+				}).Return([]int64{0, 100}, nil)
+			},
+			wantErrs: []error{model.ErrBroadcastDuplicate, nil},
+			wantIDs:  []int64{0, 100},
+		},
+		{
+			name:          "empty batch",
+			notifications: []*model.Notification{},
+			mockSetup:     func(*mocks.NotificationRepository, *mocks.Client) {},
+			expectedErr:   custom_errors.ErrInvalidInput,
+		},
+		{
+			name:          "batch too large",
+			notifications: make([]*model.Notification, notification_service.MaxNotificationBatchSize+1),
+			mockSetup:     func(*mocks.NotificationRepository, *mocks.Client) {},
+			expectedErr:   custom_errors.ErrInvalidInput,
+		},
+		{
+			name: "insert failure fails the batch",
+			notifications: []*model.Notification{
+				{UserID: 1, Type: "new_pin", Payload: payload},
+			},
+			mockSetup: func(mockRepo *mocks.NotificationRepository, mockUserClient *mocks.Client) {
+				mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1}, nil)
+				mockRepo.On("CreateMany", mock.Anything, mock.Anything).Return(nil, custom_errors.ErrDatabaseQuery)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+			tt.mockSetup(mockRepo, mockUserClient)
+
+			service := notification_service.NewNotificationService(logger.New("dev"), mockRepo, mockUserClient, prometheus.NewPrometheusMetricsProvider())
+			errs, err := service.SaveNotifications(context.Background(), tt.notifications)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, errs)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, errs, len(tt.wantErrs))
+			for i, wantErr := range tt.wantErrs {
+				if wantErr == nil {
+					assert.NoError(t, errs[i], "notification %d", i)
+				} else {
+					assert.ErrorIs(t, errs[i], wantErr, "notification %d", i)
+				}
+			}
+			for i, wantID := range tt.wantIDs {
+				assert.Equal(t, wantID, tt.notifications[i].ID, "notification %d", i)
+			}
+		})
+	}
+}
+
+func TestService_SaveNotifications_ActorCaps(t *testing.T) {
+	caps := map[events.EventType]model.ActorCap{
+		events.EventTypeFollowCreated: {Max: 2, Window: 24 * time.Hour},
+	}
+	followPayload := json.RawMessage(`{"follower_id":42,"followee_id":1}`)
+	otherPayload := json.RawMessage(`{"follower_id":43,"followee_id":1}`)
+
+	tests := []struct {
+		name          string
+		notifications []*model.Notification
+		stored        int
+		mockSetup     func(*mocks.NotificationRepository)
+		wantErrs      []error
+	}{
+		{
+			name: "earlier notifications of the batch count toward the cap",
+			notifications: []*model.Notification{
+				{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+				{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+				{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+			},
+			stored: 1,
+			mockSetup: func(mockRepo *mocks.NotificationRepository) {
+				mockRepo.On("CreateSuppression", mock.Anything, mock.MatchedBy(func(s *model.NotificationSuppression) bool {
+					return s.UserID == 1 && s.ActorID == 42 && s.Reason == model.SuppressionReasonActorCap
+				})).Return(int64(1), nil).Twice()
+			},
+			wantErrs: []error{nil, model.ErrNotificationSuppressed, model.ErrNotificationSuppressed},
+		},
+		{
+			name: "other actors have their own cap",
+			notifications: []*model.Notification{
+				{UserID: 1, Type: events.EventTypeFollowCreated, Payload: followPayload},
+				{UserID: 1, Type: events.EventTypeFollowCreated, Payload: otherPayload},
+			},
+			stored:    1,
+			mockSetup: func(*mocks.NotificationRepository) {},
+			wantErrs:  []error{nil, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewNotificationRepository(t)
+			mockUserClient := mocks.NewClient(t)
+
+			mockUserClient.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1}, nil).Once()
+			mockRepo.On("CountFromActorSince", mock.Anything, int64(1), mock.Anything, events.EventTypeFollowCreated, mock.AnythingOfType("time.Time")).Return(tt.stored, nil)
+			mockRepo.On("CreateMany", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				for i, n := range args.Get(1).([]*model.Notification) {
+					n.ID = int64(100 + i)
+				}
+			}).Return(nil, nil)
+			tt.mockSetup(mockRepo)
+
+			service := notification_service.NewNotificationService(logger.New("dev"), mockRepo, mockUserClient,
+				prometheus.NewPrometheusMetricsProvider(), notification_service.WithActorCaps(caps))
+			errs, err := service.SaveNotifications(context.Background(), tt.notifications)
+
+			require.NoError(t, err)
+			require.Len(t, errs, len(tt.wantErrs))
+			for i, wantErr := range tt.wantErrs {
+				if wantErr == nil {
+					assert.NoError(t, errs[i], "notification %d", i)
+				} else {
+					assert.ErrorIs(t, errs[i], wantErr, "notification %d", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package notification_service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// WithActorCaps caps, per notification type, how many notifications a
+// recipient gets from the same actor within a window. Notifications over the
+// cap are recorded as suppressions instead of being stored. Concurrent saves
+// for the same recipient and actor may overshoot the cap slightly.
+func WithActorCaps(caps map[events.EventType]model.ActorCap) Option {
+	return func(s *Service) {
+		s.actorCaps = make(map[events.EventType]model.ActorCap, len(caps))
+		for notificationType, limit := range caps {
+			if limit.Max > 0 && limit.Window > 0 {
+				s.actorCaps[notificationType] = limit
+			}
+		}
+	}
+}
+
+// checkActorCap returns model.ErrNotificationSuppressed when the recipient
+// already got the cap of notifications of this type from the actor. batched
+// counts the ones accepted earlier in the same batch, which are not stored
+// yet. When the count cannot be read the notification is let through: losing
+// spam protection for a moment is better than losing notifications.
+func (s *Service) checkActorCap(ctx context.Context, notification *model.Notification, batched int) error {
+	limit, ok := s.actorCaps[notification.Type]
+	if !ok {
+		return nil
+	}
+	actorID := notification.ActorID()
+	if actorID <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	count, err := s.notificationRepo.CountFromActorSince(ctx, notification.UserID, actorID, notification.Type, now.Add(-limit.Window))
+	if err != nil {
+		s.log.WarnContext(ctx, "Failed to check actor cap, saving notification anyway",
+			slog.Int64("user_id", notification.UserID),
+			slog.Int64("actor_id", actorID),
+			slog.String("type", string(notification.Type)),
+			slog.String("error", err.Error()))
+		return nil
+	}
+	if count+batched < limit.Max {
+		return nil
+	}
+
+	s.suppress(ctx, &model.NotificationSuppression{
+		UserID:    notification.UserID,
+		ActorID:   actorID,
+		Type:      notification.Type,
+		Reason:    model.SuppressionReasonActorCap,
+		CreatedAt: now,
+	})
+	return model.ErrNotificationSuppressed
+}
+
+// actorCapKey identifies the notifications one actor cap counts.
+type actorCapKey struct {
+	userID           int64
+	actorID          int64
+	notificationType events.EventType
+}
+
+// suppress counts and records a dropped notification. The record is for
+// audit only, so failing to write it does not change the outcome.
+func (s *Service) suppress(ctx context.Context, suppression *model.NotificationSuppression) {
+	s.metrics.IncrementSuppressedNotifications(string(suppression.Type), string(suppression.Reason))
+	s.log.InfoContext(ctx, "Notification suppressed",
+		slog.Int64("user_id", suppression.UserID),
+		slog.Int64("actor_id", suppression.ActorID),
+		slog.String("type", string(suppression.Type)),
+		slog.String("reason", string(suppression.Reason)))
+
+	if _, err := s.notificationRepo.CreateSuppression(ctx, suppression); err != nil {
+		s.log.ErrorContext(ctx, "Failed to record notification suppression",
+			slog.Int64("user_id", suppression.UserID),
+			slog.String("error", err.Error()))
+	}
+}
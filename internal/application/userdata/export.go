@@ -0,0 +1,145 @@
+package userdata_service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// ExportUserData writes everything stored for the user to w in the given
+// format, starting at cursor (empty for the beginning). With pageSize > 0
+// at most pageSize records are written and the cursor of the next page is
+// returned; an empty cursor means the export is complete. Every page is a
+// complete document on its own.
+func (s *Service) ExportUserData(ctx context.Context, w io.Writer, userID int64, format model.ExportFormat, cursor string, pageSize int) (next string, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("export_user_data", err == nil)
+	}()
+
+	if format == "" {
+		format = model.ExportFormatNDJSON
+	}
+	if userID <= 0 || !format.IsValid() || pageSize < 0 || pageSize > MaxExportPageSize {
+		s.log.ErrorContext(ctx, "Invalid export request",
+			slog.Int64("user_id", userID),
+			slog.String("format", string(format)),
+			slog.Int("page_size", pageSize),
+		)
+		return "", custom_errors.ErrInvalidInput
+	}
+	from, err := model.ParseExportCursor(cursor)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Invalid export cursor", slog.Int64("user_id", userID), slog.String("cursor", cursor))
+		return "", custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Exporting user data",
+		slog.Int64("user_id", userID),
+		slog.String("format", string(format)),
+		slog.String("cursor", cursor),
+		slog.Int("page_size", pageSize),
+	)
+
+	ew := newExportWriter(w, format, userID, time.Now())
+	records := 0
+	nextCursor, err := s.exportRepo.ExportUserData(ctx, userID, from, pageSize, func(record *model.ExportRecord) error {
+		records++
+		return ew.write(record)
+	})
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to export user data",
+			slog.Int64("user_id", userID),
+			slog.Int("records", records),
+			slog.String("error", err.Error()),
+		)
+		return "", err
+	}
+	if nextCursor != nil {
+		next = nextCursor.Encode()
+	}
+	if err := ew.close(next); err != nil {
+		s.log.ErrorContext(ctx, "Failed to finish user data export", slog.Int64("user_id", userID), slog.String("error", err.Error()))
+		return "", err
+	}
+
+	s.log.InfoContext(ctx, "User data exported",
+		slog.Int64("user_id", userID),
+		slog.Int("records", records),
+		slog.String("next_cursor", next),
+	)
+	return next, nil
+}
+
+// exportWriter turns records into an ndjson stream or one json document:
+//
+//	{"user_id":1,"exported_at":"...","records":[{"kind":"...","data":{...}}],"next_cursor":"..."}
+type exportWriter struct {
+	w          *bufio.Writer
+	format     model.ExportFormat
+	userID     int64
+	exportedAt time.Time
+	started    bool
+	records    int
+}
+
+func newExportWriter(w io.Writer, format model.ExportFormat, userID int64, exportedAt time.Time) *exportWriter {
+	return &exportWriter{w: bufio.NewWriter(w), format: format, userID: userID, exportedAt: exportedAt.UTC()}
+}
+
+func (e *exportWriter) header() error {
+	if e.started || e.format != model.ExportFormatJSON {
+		return nil
+	}
+	e.started = true
+	head, err := json.Marshal(struct {
+		UserID     int64     `json:"user_id"`
+		ExportedAt time.Time `json:"exported_at"`
+	}{e.userID, e.exportedAt})
+	if err != nil {
+		return err
+	}
+	// Reopen the object to append the records array after its fields.
+	_, err = e.w.Write(append(head[:len(head)-1], `,"records":[`...))
+	return err
+}
+
+func (e *exportWriter) write(record *model.ExportRecord) error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	switch {
+	case e.format == model.ExportFormatNDJSON:
+		line = append(line, '\n')
+	case e.records > 0:
+		line = append([]byte{','}, line...)
+	}
+	e.records++
+	_, err = e.w.Write(line)
+	return err
+}
+
+func (e *exportWriter) close(nextCursor string) error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	if e.format == model.ExportFormatJSON {
+		tail, err := json.Marshal(nextCursor)
+		if err != nil {
+			return err
+		}
+		if _, err := e.w.Write(append(append([]byte(`],"next_cursor":`), tail...), '}')); err != nil {
+			return err
+		}
+	}
+	return e.w.Flush()
+}
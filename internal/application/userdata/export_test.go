@@ -0,0 +1,134 @@
+package userdata_service_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	userdata_service "pinstack-notification-service/internal/application/userdata"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ExportUserData(t *testing.T) {
+	const userID = int64(7)
+
+	records := []*model.ExportRecord{
+		{Kind: model.ExportRecordChannelPreference, Key: 1, Data: json.RawMessage(`{"channel":"email","enabled":false}`)},
+		{Kind: model.ExportRecordNotification, Key: 42, Data: json.RawMessage(`{"id":42,"type":"follow_created"}`)},
+	}
+	emit := func(args mock.Arguments) {
+		fn := args.Get(4).(func(*model.ExportRecord) error)
+		for _, record := range records {
+			require.NoError(t, fn(record))
+		}
+	}
+	start := &model.ExportCursor{Kind: model.ExportRecordChannelPreference}
+	pageStart := &model.ExportCursor{Kind: model.ExportRecordChannelPreference, AfterKey: 3}
+	pageEnd := &model.ExportCursor{Kind: model.ExportRecordNotification, AfterKey: 42}
+
+	tests := []struct {
+		name      string
+		format    model.ExportFormat
+		cursor    string
+		pageSize  int
+		setup     func(*mocks.UserDataExportRepository)
+		wantNext  string
+		wantLines []string
+		wantJSON  bool
+		wantErr   error
+	}{
+		{
+			name: "ndjson by default, one record per line",
+			setup: func(repo *mocks.UserDataExportRepository) {
+				repo.On("ExportUserData", mock.Anything, userID, start, 0, mock.Anything).Run(emit).Return(nil, nil)
+			},
+			wantLines: []string{
+				`{"kind":"channel_preference","data":{"channel":"email","enabled":false}}`,
+				`{"kind":"notification","data":{"id":42,"type":"follow_created"}}`,
+			},
+		},
+		{
+			name:     "json page continues from cursor and returns the next one",
+			format:   model.ExportFormatJSON,
+			cursor:   pageStart.Encode(),
+			pageSize: 2,
+			setup: func(repo *mocks.UserDataExportRepository) {
+				repo.On("ExportUserData", mock.Anything, userID, pageStart, 2, mock.Anything).Run(emit).Return(pageEnd, nil)
+			},
+			wantNext: pageEnd.Encode(),
+			wantJSON: true,
+		},
+		{
+			name:    "unknown format",
+			format:  "csv",
+			setup:   func(*mocks.UserDataExportRepository) {},
+			wantErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:    "malformed cursor",
+			cursor:  "not-a-cursor",
+			setup:   func(*mocks.UserDataExportRepository) {},
+			wantErr: custom_errors.ErrInvalidInput,
+		},
+		{
+			name:     "page size over the limit",
+			pageSize: userdata_service.MaxExportPageSize + 1,
+			setup:    func(*mocks.UserDataExportRepository) {},
+			wantErr:  custom_errors.ErrInvalidInput,
+		},
+		{
+			name: "repository error",
+			setup: func(repo *mocks.UserDataExportRepository) {
+				repo.On("ExportUserData", mock.Anything, userID, start, 0, mock.Anything).Return(nil, custom_errors.ErrDatabaseQuery)
+			},
+			wantErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exportRepo := mocks.NewUserDataExportRepository(t)
+			tt.setup(exportRepo)
+
+			svc := userdata_service.NewUserDataService(logger.New("dev"), mocks.NewNotificationRepository(t), mocks.NewPreferenceRepository(t),
+				mocks.NewDeviceTokenRepository(t), mocks.NewWebhookRepository(t), mocks.NewDigestRepository(t), exportRepo,
+				prometheus.NewPrometheusMetricsProvider(), userdata_service.Config{})
+
+			var out bytes.Buffer
+			next, err := svc.ExportUserData(context.Background(), &out, userID, tt.format, tt.cursor, tt.pageSize)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNext, next)
+
+			if tt.wantLines != nil {
+				assert.Equal(t, tt.wantLines, strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n"))
+			}
+			if tt.wantJSON {
+				var doc struct {
+					UserID     int64                `json:"user_id"`
+					Records    []model.ExportRecord `json:"records"`
+					NextCursor string               `json:"next_cursor"`
+				}
+				require.NoError(t, json.Unmarshal(out.Bytes(), &doc))
+				assert.Equal(t, userID, doc.UserID)
+				assert.Len(t, doc.Records, len(records))
+				assert.Equal(t, model.ExportRecordNotification, doc.Records[1].Kind)
+				assert.Equal(t, tt.wantNext, doc.NextCursor)
+			}
+		})
+	}
+}
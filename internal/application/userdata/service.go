@@ -0,0 +1,180 @@
+package userdata_service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+const (
+	DefaultPurgeBatchSize = 1000
+	// MaxExportPageSize bounds one page of a paged export.
+	MaxExportPageSize = 10000
+)
+
+type Config struct {
+	PurgeBatchSize int
+}
+
+// Option configures optional Service behaviour.
+type Option func(*Service)
+
+// UserCache drops what is cached about a user.
+type UserCache interface {
+	Forget(userID int64)
+}
+
+// WithUserCache forgets a purged user in the cache of user lookups, so the
+// deleted account is not served from it until its entry expires.
+func WithUserCache(cache UserCache) Option {
+	return func(s *Service) {
+		s.userCache = cache
+	}
+}
+
+// Service handles data kept for a user as a whole: exporting it on request
+// and removing it when the account is deleted.
+type Service struct {
+	notificationRepo ports.NotificationRepository
+	preferenceRepo   ports.PreferenceRepository
+	deviceTokenRepo  ports.DeviceTokenRepository
+	webhookRepo      ports.WebhookRepository
+	digestRepo       ports.DigestRepository
+	exportRepo       ports.UserDataExportRepository
+	userCache        UserCache
+	log              ports.Logger
+	metrics          ports.MetricsProvider
+	config           Config
+}
+
+func NewUserDataService(
+	log ports.Logger,
+	notificationRepo ports.NotificationRepository,
+	preferenceRepo ports.PreferenceRepository,
+	deviceTokenRepo ports.DeviceTokenRepository,
+	webhookRepo ports.WebhookRepository,
+	digestRepo ports.DigestRepository,
+	exportRepo ports.UserDataExportRepository,
+	metrics ports.MetricsProvider,
+	cfg Config,
+	opts ...Option,
+) *Service {
+	if cfg.PurgeBatchSize <= 0 {
+		cfg.PurgeBatchSize = DefaultPurgeBatchSize
+	}
+	s := &Service{
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+		deviceTokenRepo:  deviceTokenRepo,
+		webhookRepo:      webhookRepo,
+		digestRepo:       digestRepo,
+		exportRepo:       exportRepo,
+		log:              log,
+		metrics:          metrics,
+		config:           cfg,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// PurgeUser first removes what would make the service reach out to the
+// user (preferences, digest, device tokens, webhooks), then their
+// notifications and the notifications naming them as actor, batch by
+// batch. Every step is logged with its count as the audit trail. A failed
+// step returns the error; running the purge again picks up where it stopped.
+// The user is forgotten in the user cache before anything is removed.
+func (s *Service) PurgeUser(ctx context.Context, userID int64) (report *model.UserPurgeReport, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("purge_user_data", err == nil)
+	}()
+
+	if userID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid user ID", slog.Int64("user_id", userID))
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	s.log.InfoContext(ctx, "Purging user data", slog.Int64("user_id", userID))
+	report = &model.UserPurgeReport{UserID: userID}
+
+	if s.userCache != nil {
+		s.userCache.Forget(userID)
+	}
+
+	steps := []struct {
+		name  string
+		count *int64
+		run   func() (int64, error)
+	}{
+		{"channel_preferences", &report.ChannelPreferences, func() (int64, error) {
+			return s.preferenceRepo.DeleteChannelPreferences(ctx, userID)
+		}},
+		{"digest_subscriptions", &report.DigestSubscriptions, func() (int64, error) {
+			return s.digestRepo.Delete(ctx, userID)
+		}},
+		{"device_tokens", &report.DeviceTokens, func() (int64, error) {
+			return s.deviceTokenRepo.DeleteByUser(ctx, userID)
+		}},
+		{"webhook_subscriptions", &report.WebhookSubscriptions, func() (int64, error) {
+			return s.webhookRepo.DeleteSubscriptionsByOwner(ctx, model.UserWebhookOwner(userID))
+		}},
+		{"notifications", &report.Notifications, func() (int64, error) {
+			return s.inBatches(ctx, userID, "notifications", s.notificationRepo.DeleteByUser)
+		}},
+		{"actor_notifications", &report.ActorNotifications, func() (int64, error) {
+			return s.inBatches(ctx, userID, "actor_notifications", s.notificationRepo.DeleteByActor)
+		}},
+		{"suppressions", &report.Suppressions, func() (int64, error) {
+			return s.inBatches(ctx, userID, "suppressions", s.notificationRepo.DeleteSuppressionsByUser)
+		}},
+	}
+
+	for _, step := range steps {
+		removed, err := step.run()
+		*step.count = removed
+		if err != nil {
+			s.log.ErrorContext(ctx, "User data purge step failed",
+				slog.Int64("user_id", userID),
+				slog.String("step", step.name),
+				slog.Int64("removed", removed),
+				slog.String("error", err.Error()),
+			)
+			return report, err
+		}
+		s.log.InfoContext(ctx, "User data purge step done",
+			slog.Int64("user_id", userID),
+			slog.String("step", step.name),
+			slog.Int64("removed", removed),
+		)
+	}
+
+	s.log.InfoContext(ctx, "User data purged", slog.Any("report", report))
+	return report, nil
+}
+
+func (s *Service) inBatches(ctx context.Context, userID int64, step string, deleteBatch func(context.Context, int64, int) (int64, error)) (total int64, err error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		deleted, err := deleteBatch(ctx, userID, s.config.PurgeBatchSize)
+		total += deleted
+		if err != nil {
+			return total, err
+		}
+		if deleted > 0 {
+			s.log.DebugContext(ctx, "User data purge batch done",
+				slog.Int64("user_id", userID),
+				slog.String("step", step),
+				slog.Int64("removed", deleted),
+			)
+		}
+		if deleted < int64(s.config.PurgeBatchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package userdata_service_test
+
+import (
+	"context"
+	userdata_service "pinstack-notification-service/internal/application/userdata"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type userDataMocks struct {
+	notifications *mocks.NotificationRepository
+	preferences   *mocks.PreferenceRepository
+	deviceTokens  *mocks.DeviceTokenRepository
+	webhooks      *mocks.WebhookRepository
+	digests       *mocks.DigestRepository
+}
+
+// userCache records the users forgotten by a purge.
+type userCache struct {
+	forgotten []int64
+}
+
+func (c *userCache) Forget(userID int64) {
+	c.forgotten = append(c.forgotten, userID)
+}
+
+func TestService_PurgeUser(t *testing.T) {
+	const userID = int64(7)
+
+	ownData := func(m userDataMocks) {
+		m.preferences.On("DeleteChannelPreferences", mock.Anything, userID).Return(int64(3), nil)
+		m.digests.On("Delete", mock.Anything, userID).Return(int64(1), nil)
+		m.deviceTokens.On("DeleteByUser", mock.Anything, userID).Return(int64(2), nil)
+		m.webhooks.On("DeleteSubscriptionsByOwner", mock.Anything, "user:7").Return(int64(1), nil)
+	}
+
+	tests := []struct {
+		name       string
+		userID     int64
+		setup      func(userDataMocks)
+		wantReport *model.UserPurgeReport
+		wantErr    error
+	}{
+		{
+			name:   "removes owned data and actor notifications in batches",
+			userID: userID,
+			setup: func(m userDataMocks) {
+				ownData(m)
+				m.notifications.On("DeleteByUser", mock.Anything, userID, 2).Return(int64(2), nil).Twice()
+				m.notifications.On("DeleteByUser", mock.Anything, userID, 2).Return(int64(1), nil).Once()
+				m.notifications.On("DeleteByActor", mock.Anything, userID, 2).Return(int64(0), nil).Once()
+				m.notifications.On("DeleteSuppressionsByUser", mock.Anything, userID, 2).Return(int64(1), nil).Once()
+			},
+			wantReport: &model.UserPurgeReport{
+				UserID:               userID,
+				Notifications:        5,
+				ChannelPreferences:   3,
+				DeviceTokens:         2,
+				WebhookSubscriptions: 1,
+				DigestSubscriptions:  1,
+				Suppressions:         1,
+			},
+		},
+		{
+			name:   "failed batch stops the purge with partial counts",
+			userID: userID,
+			setup: func(m userDataMocks) {
+				ownData(m)
+				m.notifications.On("DeleteByUser", mock.Anything, userID, 2).Return(int64(2), nil).Once()
+				m.notifications.On("DeleteByUser", mock.Anything, userID, 2).Return(int64(0), custom_errors.ErrDatabaseQuery).Once()
+			},
+			wantReport: &model.UserPurgeReport{
+				UserID:               userID,
+				Notifications:        2,
+				ChannelPreferences:   3,
+				DeviceTokens:         2,
+				WebhookSubscriptions: 1,
+				DigestSubscriptions:  1,
+			},
+			wantErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:    "invalid user id",
+			userID:  0,
+			setup:   func(userDataMocks) {},
+			wantErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := userDataMocks{
+				notifications: mocks.NewNotificationRepository(t),
+				preferences:   mocks.NewPreferenceRepository(t),
+				deviceTokens:  mocks.NewDeviceTokenRepository(t),
+				webhooks:      mocks.NewWebhookRepository(t),
+				digests:       mocks.NewDigestRepository(t),
+			}
+			tt.setup(m)
+			cache := &userCache{}
+
+			svc := userdata_service.NewUserDataService(logger.New("dev"), m.notifications, m.preferences, m.deviceTokens, m.webhooks, m.digests, mocks.NewUserDataExportRepository(t),
+				prometheus.NewPrometheusMetricsProvider(), userdata_service.Config{PurgeBatchSize: 2}, userdata_service.WithUserCache(cache))
+			report, err := svc.PurgeUser(context.Background(), tt.userID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantReport, report)
+			if tt.userID > 0 {
+				assert.Equal(t, []int64{tt.userID}, cache.forgotten)
+			} else {
+				assert.Empty(t, cache.forgotten)
+			}
+		})
+	}
+}
@@ -0,0 +1,449 @@
+package webhook_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"strconv"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+const (
+	DefaultMaxAttempts  = 8
+	DefaultBackoffBase  = 30 * time.Second
+	DefaultBackoffMax   = 6 * time.Hour
+	DefaultLease        = 5 * time.Minute
+	DefaultDisableAfter = 10
+	DefaultAttemptsPage = 50
+	MaxAttemptsPage     = 500
+
+	// metricsChannel labels webhook sends in the channel delivery metrics.
+	metricsChannel = "webhook"
+)
+
+// Config controls retries of failed callbacks. A subscription is disabled
+// once DisableAfter attempts in a row have failed.
+type Config struct {
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	Lease        time.Duration
+	DisableAfter int
+}
+
+// Service fans notification events out to matching webhook subscriptions
+// and works the webhook queue, signing every request with the
+// subscription secret.
+type Service struct {
+	repo    ports.WebhookRepository
+	sender  ports.WebhookSender
+	log     ports.Logger
+	metrics ports.MetricsProvider
+	config  Config
+	now     func() time.Time
+}
+
+func NewWebhookService(log ports.Logger, repo ports.WebhookRepository, sender ports.WebhookSender, metrics ports.MetricsProvider, cfg Config) *Service {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = DefaultBackoffBase
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = DefaultBackoffMax
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = DefaultLease
+	}
+	if cfg.DisableAfter <= 0 {
+		cfg.DisableAfter = DefaultDisableAfter
+	}
+
+	return &Service{
+		repo:    repo,
+		sender:  sender,
+		log:     log,
+		metrics: metrics,
+		config:  cfg,
+		now:     time.Now,
+	}
+}
+
+// eventPayload is the JSON body of every callback.
+type eventPayload struct {
+	Event      model.WebhookEvent `json:"event"`
+	OccurredAt time.Time          `json:"occurred_at"`
+	Data       any                `json:"data"`
+}
+
+type notificationData struct {
+	Notification *model.Notification `json:"notification"`
+}
+
+type readAllData struct {
+	UserID int64 `json:"user_id"`
+	Count  int64 `json:"count"`
+}
+
+func (s *Service) NotificationCreated(ctx context.Context, notification *model.Notification) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("webhook_notification_created", err == nil)
+	}()
+
+	if notification == nil || notification.ID <= 0 {
+		return custom_errors.ErrInvalidInput
+	}
+	return s.enqueue(ctx, model.WebhookEventNotificationCreated, notification.Type, notificationData{Notification: notification})
+}
+
+func (s *Service) NotificationRead(ctx context.Context, notification *model.Notification) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("webhook_notification_read", err == nil)
+	}()
+
+	if notification == nil || notification.ID <= 0 {
+		return custom_errors.ErrInvalidInput
+	}
+	return s.enqueue(ctx, model.WebhookEventNotificationRead, notification.Type, notificationData{Notification: notification})
+}
+
+func (s *Service) NotificationsReadAll(ctx context.Context, userID int64, count int64) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("webhook_notifications_read_all", err == nil)
+	}()
+
+	if userID <= 0 {
+		return custom_errors.ErrInvalidInput
+	}
+	return s.enqueue(ctx, model.WebhookEventNotificationsReadAll, "", readAllData{UserID: userID, Count: count})
+}
+
+// enqueue queues one callback per enabled subscription that wants the event.
+// Subscriptions are few (one per partner), so they are listed on every event.
+func (s *Service) enqueue(ctx context.Context, event model.WebhookEvent, notificationType events.EventType, data any) error {
+	subscriptions, err := s.repo.ListEnabledSubscriptions(ctx)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to list webhook subscriptions", slog.String("error", err.Error()))
+		return err
+	}
+
+	matching := make([]*model.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if subscription.Matches(event, notificationType) {
+			matching = append(matching, subscription)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(eventPayload{Event: event, OccurredAt: s.now().UTC(), Data: data})
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to marshal webhook payload", slog.String("event", string(event)), slog.String("error", err.Error()))
+		return err
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(matching))
+	for _, subscription := range matching {
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			Event:          event,
+			Payload:        payload,
+			Status:         model.DeliveryStatusPending,
+		})
+	}
+
+	if err := s.repo.EnqueueDeliveries(ctx, deliveries); err != nil {
+		s.log.ErrorContext(ctx, "Failed to enqueue webhook deliveries",
+			slog.String("event", string(event)),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	for range deliveries {
+		s.metrics.IncrementChannelDeliveries(metricsChannel, "queued")
+	}
+	return nil
+}
+
+// ProcessPending claims up to batchSize due callbacks and posts them. It
+// returns how many were accepted by the receiver.
+func (s *Service) ProcessPending(ctx context.Context, batchSize int) (sent int, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("process_pending_webhooks", err == nil)
+	}()
+
+	if batchSize <= 0 {
+		s.log.ErrorContext(ctx, "Invalid webhook batch size", slog.Int("batch_size", batchSize))
+		return 0, custom_errors.ErrInvalidInput
+	}
+
+	deliveries, err := s.repo.ClaimPendingDeliveries(ctx, s.now(), s.config.Lease, batchSize)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to claim pending webhook deliveries", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return sent, err
+		}
+		if s.process(ctx, delivery) {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+func (s *Service) process(ctx context.Context, delivery *model.WebhookDelivery) bool {
+	subscription, err := s.repo.GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookSubscriptionNotFound) {
+			s.fail(ctx, delivery, "subscription was deleted")
+			return false
+		}
+		s.retry(ctx, delivery, err.Error())
+		return false
+	}
+
+	timestamp := s.now().Unix()
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(HeaderEvent, string(delivery.Event))
+	header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	header.Set(HeaderIdempotencyKey, delivery.IdempotencyKey)
+	header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	start := time.Now()
+	status, sendErr := s.sender.Post(ctx, subscription.URL, header, delivery.Payload)
+	elapsed := time.Since(start)
+	s.metrics.RecordChannelDeliveryDuration(metricsChannel, elapsed)
+
+	attempt := &model.WebhookAttempt{
+		DeliveryID:     delivery.ID,
+		SubscriptionID: subscription.ID,
+		Attempt:        delivery.Attempts,
+		ResponseStatus: status,
+		Duration:       elapsed,
+		AttemptedAt:    s.now(),
+	}
+	success := sendErr == nil && status >= 200 && status < 300
+	switch {
+	case sendErr != nil:
+		attempt.Error = sendErr.Error()
+	case !success:
+		attempt.Error = fmt.Sprintf("unexpected response status %d", status)
+	}
+	if err := s.repo.RecordAttempt(ctx, attempt); err != nil {
+		s.log.ErrorContext(ctx, "Failed to record webhook attempt",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	disabled, err := s.repo.RecordSubscriptionResult(ctx, subscription.ID, success, s.config.DisableAfter)
+	if err != nil {
+		s.log.ErrorContext(ctx, "Failed to record webhook subscription result",
+			slog.Int64("subscription_id", subscription.ID),
+			slog.String("error", err.Error()),
+		)
+	} else if disabled && subscription.Enabled {
+		s.log.WarnContext(ctx, "Webhook subscription disabled after repeated failures",
+			slog.Int64("subscription_id", subscription.ID),
+			slog.String("owner", subscription.Owner),
+			slog.Int("disable_after", s.config.DisableAfter),
+		)
+	}
+
+	if success {
+		if err := s.repo.MarkDeliverySent(ctx, delivery.ID); err != nil {
+			s.log.ErrorContext(ctx, "Failed to mark webhook delivery sent",
+				slog.Int64("delivery_id", delivery.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+		s.metrics.IncrementChannelDeliveries(metricsChannel, "sent")
+		return true
+	}
+
+	if sendErr == nil && !retryableStatus(status) {
+		s.fail(ctx, delivery, attempt.Error)
+		return false
+	}
+	s.retry(ctx, delivery, attempt.Error)
+	return false
+}
+
+// retryableStatus reports whether a non-2xx response may succeed later.
+// Other client errors mean the receiver rejected the request itself.
+func retryableStatus(status int) bool {
+	return status >= 500 || status == http.StatusRequestTimeout || status == http.StatusTooManyRequests
+}
+
+func (s *Service) retry(ctx context.Context, delivery *model.WebhookDelivery, reason string) {
+	if delivery.Attempts >= s.config.MaxAttempts {
+		s.fail(ctx, delivery, reason)
+		return
+	}
+
+	nextAttemptAt := s.now().Add(s.backoff(delivery.Attempts))
+	s.log.WarnContext(ctx, "Webhook delivery failed, will retry",
+		slog.Int64("delivery_id", delivery.ID),
+		slog.Int64("subscription_id", delivery.SubscriptionID),
+		slog.Int("attempts", delivery.Attempts),
+		slog.Time("next_attempt_at", nextAttemptAt),
+		slog.String("error", reason),
+	)
+	if err := s.repo.MarkDeliveryRetry(ctx, delivery.ID, nextAttemptAt); err != nil {
+		s.log.ErrorContext(ctx, "Failed to reschedule webhook delivery",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+	s.metrics.IncrementChannelDeliveries(metricsChannel, "retry")
+}
+
+func (s *Service) fail(ctx context.Context, delivery *model.WebhookDelivery, reason string) {
+	s.log.ErrorContext(ctx, "Webhook delivery failed permanently",
+		slog.Int64("delivery_id", delivery.ID),
+		slog.Int64("subscription_id", delivery.SubscriptionID),
+		slog.Int("attempts", delivery.Attempts),
+		slog.String("reason", reason),
+	)
+	if err := s.repo.MarkDeliveryFailed(ctx, delivery.ID); err != nil {
+		s.log.ErrorContext(ctx, "Failed to mark webhook delivery failed",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+	s.metrics.IncrementChannelDeliveries(metricsChannel, "failed")
+}
+
+// backoff doubles the wait after every attempt, capped at BackoffMax.
+func (s *Service) backoff(attempts int) time.Duration {
+	wait := s.config.BackoffBase
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= s.config.BackoffMax {
+			return s.config.BackoffMax
+		}
+	}
+	return wait
+}
+
+// CreateSubscription validates and stores a subscription. When no secret is
+// given a random one is generated; the returned subscription carries it so
+// it can be handed to the partner once.
+func (s *Service) CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) (created *model.WebhookSubscription, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("create_webhook_subscription", err == nil)
+	}()
+
+	if subscription == nil || subscription.Owner == "" || !validURL(subscription.URL) {
+		s.log.ErrorContext(ctx, "Invalid webhook subscription")
+		return nil, custom_errors.ErrInvalidInput
+	}
+	for _, event := range subscription.Events {
+		if !event.IsValid() {
+			s.log.ErrorContext(ctx, "Invalid webhook event", slog.String("event", string(event)))
+			return nil, custom_errors.ErrInvalidInput
+		}
+	}
+
+	if subscription.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			s.log.ErrorContext(ctx, "Failed to generate webhook secret", slog.String("error", err.Error()))
+			return nil, err
+		}
+		subscription.Secret = secret
+	}
+
+	if _, err := s.repo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	s.log.InfoContext(ctx, "Webhook subscription created",
+		slog.Int64("id", subscription.ID),
+		slog.String("owner", subscription.Owner),
+	)
+	return subscription, nil
+}
+
+func validURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func (s *Service) ListSubscriptions(ctx context.Context, owner string) (subscriptions []*model.WebhookSubscription, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("list_webhook_subscriptions", err == nil)
+	}()
+
+	if owner == "" {
+		s.log.ErrorContext(ctx, "Webhook owner is required")
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	return s.repo.ListSubscriptions(ctx, owner)
+}
+
+func (s *Service) DeleteSubscription(ctx context.Context, id int64) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("delete_webhook_subscription", err == nil)
+	}()
+
+	if id <= 0 {
+		s.log.ErrorContext(ctx, "Invalid webhook subscription ID", slog.Int64("id", id))
+		return custom_errors.ErrInvalidInput
+	}
+
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+// EnableSubscription turns a subscription back on and clears its failure
+// streak; callbacks queued while it was disabled are sent again.
+func (s *Service) EnableSubscription(ctx context.Context, id int64) (err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("enable_webhook_subscription", err == nil)
+	}()
+
+	if id <= 0 {
+		s.log.ErrorContext(ctx, "Invalid webhook subscription ID", slog.Int64("id", id))
+		return custom_errors.ErrInvalidInput
+	}
+
+	return s.repo.EnableSubscription(ctx, id)
+}
+
+func (s *Service) ListAttempts(ctx context.Context, subscriptionID int64, limit int) (attempts []*model.WebhookAttempt, err error) {
+	defer func() {
+		s.metrics.IncrementNotificationOperations("list_webhook_attempts", err == nil)
+	}()
+
+	if subscriptionID <= 0 {
+		s.log.ErrorContext(ctx, "Invalid webhook subscription ID", slog.Int64("id", subscriptionID))
+		return nil, custom_errors.ErrInvalidInput
+	}
+	if limit <= 0 {
+		limit = DefaultAttemptsPage
+	}
+	limit = min(limit, MaxAttemptsPage)
+
+	return s.repo.ListAttempts(ctx, subscriptionID, limit)
+}
@@ -0,0 +1,262 @@
+package webhook_service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	webhook_service "pinstack-notification-service/internal/application/webhook"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newWebhookService(t *testing.T, cfg webhook_service.Config) (*webhook_service.Service, *mocks.WebhookRepository, *mocks.WebhookSender) {
+	repo := mocks.NewWebhookRepository(t)
+	sender := mocks.NewWebhookSender(t)
+	svc := webhook_service.NewWebhookService(logger.New("dev"), repo, sender, prometheus.NewPrometheusMetricsProvider(), cfg)
+	return svc, repo, sender
+}
+
+func TestService_NotificationCreated(t *testing.T) {
+	notification := &model.Notification{ID: 10, UserID: 1, Type: events.EventTypeFollowCreated, Payload: json.RawMessage(`{"follower_id":2}`)}
+
+	tests := []struct {
+		name          string
+		subscriptions []*model.WebhookSubscription
+		wantQueued    []int64
+	}{
+		{
+			name: "matching subscriptions are queued",
+			subscriptions: []*model.WebhookSubscription{
+				{ID: 1, Enabled: true},
+				{ID: 2, Enabled: true, Events: []model.WebhookEvent{model.WebhookEventNotificationCreated}},
+				{ID: 3, Enabled: true, NotificationTypes: []events.EventType{events.EventTypeFollowCreated}},
+			},
+			wantQueued: []int64{1, 2, 3},
+		},
+		{
+			name: "event and type filters are applied",
+			subscriptions: []*model.WebhookSubscription{
+				{ID: 1, Enabled: true, Events: []model.WebhookEvent{model.WebhookEventNotificationRead}},
+				{ID: 2, Enabled: true, NotificationTypes: []events.EventType{events.EventTypeFollowDeleted}},
+				{ID: 3, Enabled: true},
+			},
+			wantQueued: []int64{3},
+		},
+		{
+			name: "nothing is queued without a match",
+			subscriptions: []*model.WebhookSubscription{
+				{ID: 1, Enabled: true, Events: []model.WebhookEvent{model.WebhookEventNotificationsReadAll}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _ := newWebhookService(t, webhook_service.Config{})
+			repo.On("ListEnabledSubscriptions", mock.Anything).Return(tt.subscriptions, nil)
+
+			var queued []*model.WebhookDelivery
+			if len(tt.wantQueued) > 0 {
+				repo.On("EnqueueDeliveries", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) { queued = args.Get(1).([]*model.WebhookDelivery) }).
+					Return(nil)
+			}
+
+			require.NoError(t, svc.NotificationCreated(context.Background(), notification))
+
+			ids := make([]int64, 0, len(queued))
+			for _, d := range queued {
+				ids = append(ids, d.SubscriptionID)
+				assert.Equal(t, model.WebhookEventNotificationCreated, d.Event)
+
+				var body struct {
+					Event string `json:"event"`
+					Data  struct {
+						Notification struct {
+							ID int64 `json:"id"`
+						} `json:"notification"`
+					} `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(d.Payload, &body))
+				assert.Equal(t, "notification.created", body.Event)
+				assert.Equal(t, int64(10), body.Data.Notification.ID)
+			}
+			if len(tt.wantQueued) > 0 {
+				assert.Equal(t, tt.wantQueued, ids)
+			}
+		})
+	}
+}
+
+func TestService_NotificationsReadAll_IgnoresTypeFilter(t *testing.T) {
+	svc, repo, _ := newWebhookService(t, webhook_service.Config{})
+	repo.On("ListEnabledSubscriptions", mock.Anything).Return([]*model.WebhookSubscription{
+		{ID: 1, Enabled: true, NotificationTypes: []events.EventType{events.EventTypeFollowCreated}},
+	}, nil)
+	repo.On("EnqueueDeliveries", mock.Anything, mock.MatchedBy(func(deliveries []*model.WebhookDelivery) bool {
+		return len(deliveries) == 1 && deliveries[0].Event == model.WebhookEventNotificationsReadAll &&
+			string(deliveries[0].Payload) != ""
+	})).Return(nil)
+
+	require.NoError(t, svc.NotificationsReadAll(context.Background(), 1, 5))
+}
+
+func TestService_ProcessPending(t *testing.T) {
+	subscription := &model.WebhookSubscription{ID: 7, Owner: "partner", URL: "https://partner.example.com/hooks", Secret: "s3cret", Enabled: true}
+	payload := json.RawMessage(`{"event":"notification.created"}`)
+
+	tests := []struct {
+		name        string
+		attempts    int
+		status      int
+		sendErr     error
+		disabled    bool
+		wantSent    int
+		wantOutcome string
+	}{
+		{name: "2xx marks the delivery sent", attempts: 1, status: http.StatusNoContent, wantSent: 1, wantOutcome: "sent"},
+		{name: "5xx is retried", attempts: 1, status: http.StatusBadGateway, wantOutcome: "retry"},
+		{name: "429 is retried", attempts: 1, status: http.StatusTooManyRequests, wantOutcome: "retry"},
+		{name: "transport error is retried", attempts: 2, sendErr: errors.New("connection refused"), wantOutcome: "retry"},
+		{name: "other 4xx fails without retry", attempts: 1, status: http.StatusGone, wantOutcome: "failed"},
+		{name: "last attempt fails", attempts: 3, status: http.StatusInternalServerError, wantOutcome: "failed"},
+		{name: "subscription disabled by the failure", attempts: 1, status: http.StatusServiceUnavailable, disabled: true, wantOutcome: "retry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, sender := newWebhookService(t, webhook_service.Config{MaxAttempts: 3, DisableAfter: 4})
+			delivery := &model.WebhookDelivery{
+				ID:             99,
+				SubscriptionID: subscription.ID,
+				Event:          model.WebhookEventNotificationCreated,
+				IdempotencyKey: "5f0c6f0e-2b7c-4c59-9a47-0d1f2a3b4c5d",
+				Payload:        payload,
+				Attempts:       tt.attempts,
+			}
+
+			repo.On("ClaimPendingDeliveries", mock.Anything, mock.Anything, webhook_service.DefaultLease, 10).
+				Return([]*model.WebhookDelivery{delivery}, nil)
+			repo.On("GetSubscription", mock.Anything, subscription.ID).Return(subscription, nil)
+			sender.On("Post", mock.Anything, subscription.URL, mock.MatchedBy(func(h http.Header) bool {
+				ts, err := strconv.ParseInt(h.Get(webhook_service.HeaderTimestamp), 10, 64)
+				return err == nil &&
+					webhook_service.Verify("s3cret", ts, payload, h.Get(webhook_service.HeaderSignature)) &&
+					h.Get(webhook_service.HeaderIdempotencyKey) == delivery.IdempotencyKey &&
+					h.Get(webhook_service.HeaderEvent) == "notification.created"
+			}), []byte(payload)).Return(tt.status, tt.sendErr)
+
+			success := tt.wantOutcome == "sent"
+			repo.On("RecordAttempt", mock.Anything, mock.MatchedBy(func(a *model.WebhookAttempt) bool {
+				return a.DeliveryID == 99 && a.SubscriptionID == 7 && a.Attempt == tt.attempts &&
+					a.ResponseStatus == tt.status && (a.Error == "") == success
+			})).Return(nil)
+			repo.On("RecordSubscriptionResult", mock.Anything, subscription.ID, success, 4).Return(tt.disabled, nil)
+
+			switch tt.wantOutcome {
+			case "sent":
+				repo.On("MarkDeliverySent", mock.Anything, int64(99)).Return(nil)
+			case "retry":
+				repo.On("MarkDeliveryRetry", mock.Anything, int64(99), mock.AnythingOfType("time.Time")).Return(nil)
+			case "failed":
+				repo.On("MarkDeliveryFailed", mock.Anything, int64(99)).Return(nil)
+			}
+
+			sent, err := svc.ProcessPending(context.Background(), 10)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSent, sent)
+		})
+	}
+}
+
+func TestService_ProcessPending_DeletedSubscription(t *testing.T) {
+	svc, repo, _ := newWebhookService(t, webhook_service.Config{})
+	repo.On("ClaimPendingDeliveries", mock.Anything, mock.Anything, mock.Anything, 10).
+		Return([]*model.WebhookDelivery{{ID: 5, SubscriptionID: 3, Attempts: 1}}, nil)
+	repo.On("GetSubscription", mock.Anything, int64(3)).Return(nil, model.ErrWebhookSubscriptionNotFound)
+	repo.On("MarkDeliveryFailed", mock.Anything, int64(5)).Return(nil)
+
+	sent, err := svc.ProcessPending(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+}
+
+func TestService_CreateSubscription(t *testing.T) {
+	tests := []struct {
+		name         string
+		subscription *model.WebhookSubscription
+		wantErr      error
+	}{
+		{
+			name:         "secret is generated when missing",
+			subscription: &model.WebhookSubscription{Owner: "partner", URL: "https://partner.example.com/hooks"},
+		},
+		{
+			name:         "given secret is kept",
+			subscription: &model.WebhookSubscription{Owner: "partner", URL: "http://partner.internal/hooks", Secret: "mine"},
+		},
+		{
+			name:         "missing owner",
+			subscription: &model.WebhookSubscription{URL: "https://partner.example.com/hooks"},
+			wantErr:      custom_errors.ErrInvalidInput,
+		},
+		{
+			name:         "non-http url",
+			subscription: &model.WebhookSubscription{Owner: "partner", URL: "ftp://partner.example.com"},
+			wantErr:      custom_errors.ErrInvalidInput,
+		},
+		{
+			name: "unknown event",
+			subscription: &model.WebhookSubscription{
+				Owner:  "partner",
+				URL:    "https://partner.example.com/hooks",
+				Events: []model.WebhookEvent{"notification.deleted"},
+			},
+			wantErr: custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _ := newWebhookService(t, webhook_service.Config{})
+			givenSecret := tt.subscription.Secret
+			if tt.wantErr == nil {
+				repo.On("CreateSubscription", mock.Anything, tt.subscription).
+					Run(func(args mock.Arguments) {
+						s := args.Get(1).(*model.WebhookSubscription)
+						s.ID = 1
+						s.Enabled = true
+						s.CreatedAt = time.Now()
+					}).
+					Return(int64(1), nil)
+			}
+
+			created, err := svc.CreateSubscription(context.Background(), tt.subscription)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), created.ID)
+			if givenSecret != "" {
+				assert.Equal(t, givenSecret, created.Secret)
+			} else {
+				assert.Len(t, created.Secret, 64)
+			}
+		})
+	}
+}
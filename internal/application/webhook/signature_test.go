@@ -0,0 +1,21 @@
+package webhook_service_test
+
+import (
+	webhook_service "pinstack-notification-service/internal/application/webhook"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"notification.created"}`)
+	signature := webhook_service.Sign("secret", 1700000000, body)
+
+	assert.True(t, strings.HasPrefix(signature, "sha256="))
+	assert.Len(t, signature, len("sha256=")+64)
+	assert.True(t, webhook_service.Verify("secret", 1700000000, body, signature))
+	assert.False(t, webhook_service.Verify("other", 1700000000, body, signature))
+	assert.False(t, webhook_service.Verify("secret", 1700000001, body, signature))
+	assert.False(t, webhook_service.Verify("secret", 1700000000, []byte(`{}`), signature))
+}
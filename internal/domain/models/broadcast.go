@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+var ErrBroadcastNotFound = errors.New("broadcast not found")
+
+// ErrBroadcastDuplicate is returned instead of an ID when the recipient
+// already has the notification of this broadcast, from an earlier attempt
+// at the same chunk.
+var ErrBroadcastDuplicate = errors.New("broadcast already sent to user")
+
+type BroadcastStatus string
+
+const (
+	BroadcastStatusPending   BroadcastStatus = "pending"
+	BroadcastStatusRunning   BroadcastStatus = "running"
+	BroadcastStatusCompleted BroadcastStatus = "completed"
+	BroadcastStatusFailed    BroadcastStatus = "failed"
+)
+
+// Done reports whether the broadcast will not be worked on again.
+func (s BroadcastStatus) Done() bool {
+	return s == BroadcastStatusCompleted || s == BroadcastStatusFailed
+}
+
+// Broadcast sends one notification to many users. The audience is either
+// UserIDs or the followers of FollowersOf, never both.
+//
+// Cursor is where fan-out resumes: the number of UserIDs already handled,
+// or the number of follower pages already fetched. Total is the audience
+// size; for followers it is the size last reported by the relation service.
+// Processed counts recipients handled so far and Sent the notifications
+// actually stored, so Processed - Sent recipients already had this
+// broadcast from an earlier, interrupted chunk.
+type Broadcast struct {
+	ID          int64                `json:"id" db:"id"`
+	Type        events.EventType     `json:"type" db:"type"`
+	Payload     json.RawMessage      `json:"payload,omitempty" db:"payload"`
+	Priority    NotificationPriority `json:"priority" db:"priority"`
+	UserIDs     []int64              `json:"user_ids,omitempty" db:"user_ids"`
+	FollowersOf int64                `json:"followers_of,omitempty" db:"followers_of"`
+	Status      BroadcastStatus      `json:"status" db:"status"`
+	Cursor      int                  `json:"cursor" db:"cursor"`
+	Total       int                  `json:"total" db:"total"`
+	Processed   int                  `json:"processed" db:"processed"`
+	Sent        int                  `json:"sent" db:"sent"`
+	LastError   string               `json:"last_error,omitempty" db:"last_error"`
+	NextRunAt   time.Time            `json:"next_run_at" db:"next_run_at"`
+	CreatedAt   time.Time            `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at" db:"updated_at"`
+	CompletedAt *time.Time           `json:"completed_at,omitempty" db:"completed_at"`
+}
+
+// Notification is the notification the broadcast sends to userID. An
+// empty Priority leaves it to the default for the type.
+func (b *Broadcast) Notification(userID int64, createdAt time.Time) *Notification {
+	return &Notification{
+		UserID:      userID,
+		Type:        b.Type,
+		Payload:     b.Payload,
+		Priority:    b.Priority,
+		BroadcastID: b.ID,
+		CreatedAt:   createdAt,
+	}
+}
+
+// BroadcastChunk is one fan-out step taken at cursor From: the
+// notifications to send and the progress to record once they are. Sent is
+// how many of them were stored. Done finishes the broadcast.
+type BroadcastChunk struct {
+	From          int
+	Cursor        int
+	Total         int
+	Sent          int
+	Done          bool
+	Notifications []*Notification
+}
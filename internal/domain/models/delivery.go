@@ -0,0 +1,85 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// Channel is an out-of-app delivery channel. In-app delivery is the stored
+// notification itself plus the real-time delivered event.
+//
+// ChannelDigest is the periodic digest email. It has no per-notification
+// adapter; it exists so digest preferences are kept apart from email ones.
+type Channel string
+
+const (
+	ChannelEmail  Channel = "email"
+	ChannelPush   Channel = "push"
+	ChannelDigest Channel = "digest"
+)
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelEmail, ChannelPush, ChannelDigest:
+		return true
+	default:
+		return false
+	}
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending DeliveryStatus = "pending"
+	DeliveryStatusSending DeliveryStatus = "sending"
+	DeliveryStatusSent    DeliveryStatus = "sent"
+	DeliveryStatusFailed  DeliveryStatus = "failed"
+)
+
+// ErrPermanentDeliveryFailure marks channel errors that retrying cannot fix,
+// such as an invalid address. Channels wrap it so workers stop retrying.
+var ErrPermanentDeliveryFailure = errors.New("permanent delivery failure")
+
+// Delivery is a queued send of one notification through one channel.
+type Delivery struct {
+	ID             int64          `json:"id" db:"id"`
+	NotificationID int64          `json:"notification_id" db:"notification_id"`
+	UserID         int64          `json:"user_id" db:"user_id"`
+	Channel        Channel        `json:"channel" db:"channel"`
+	Status         DeliveryStatus `json:"status" db:"status"`
+	Attempts       int            `json:"attempts" db:"attempts"`
+	NextAttemptAt  time.Time      `json:"next_attempt_at" db:"next_attempt_at"`
+	LastError      string         `json:"last_error,omitempty" db:"last_error"`
+	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
+	SentAt         *time.Time     `json:"sent_at,omitempty" db:"sent_at"`
+}
+
+// ChannelPreference turns a channel on or off for a user. An empty Type
+// applies to every notification type; a type-specific preference wins.
+type ChannelPreference struct {
+	UserID    int64            `json:"user_id" db:"user_id"`
+	Type      events.EventType `json:"type" db:"type"`
+	Channel   Channel          `json:"channel" db:"channel"`
+	Enabled   bool             `json:"enabled" db:"enabled"`
+	UpdatedAt time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// ChannelEnabled applies the most specific preference: one for the type,
+// then one for all types. Channels are on unless the user turned them off.
+func ChannelEnabled(preferences []*ChannelPreference, notificationType events.EventType, channel Channel) bool {
+	enabled := true
+	for _, p := range preferences {
+		if p.Channel != channel {
+			continue
+		}
+		if p.Type == notificationType {
+			return p.Enabled
+		}
+		if p.Type == "" {
+			enabled = p.Enabled
+		}
+	}
+	return enabled
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// DevicePlatform decides which push provider a device token belongs to.
+type DevicePlatform string
+
+const (
+	DevicePlatformAndroid DevicePlatform = "android"
+	DevicePlatformIOS     DevicePlatform = "ios"
+)
+
+func (p DevicePlatform) IsValid() bool {
+	switch p {
+	case DevicePlatformAndroid, DevicePlatformIOS:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeviceToken is a push token registered by a user's app install. A token
+// belongs to one user at a time: registering it again moves it.
+type DeviceToken struct {
+	ID        int64          `json:"id" db:"id"`
+	UserID    int64          `json:"user_id" db:"user_id"`
+	Token     string         `json:"token" db:"token"`
+	Platform  DevicePlatform `json:"platform" db:"platform"`
+	Locale    string         `json:"locale" db:"locale"`
+	CreatedAt time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// PushMessage is what a push provider shows on the device.
+type PushMessage struct {
+	Title    string
+	Body     string
+	DeepLink string
+	Data     map[string]string
+}
+
+// ErrInvalidDeviceToken is returned by push providers when the provider
+// reports a token as unregistered or malformed; such tokens are pruned.
+var ErrInvalidDeviceToken = errors.New("invalid device token")
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+type DigestFrequency string
+
+const (
+	DigestFrequencyOff    DigestFrequency = "off"
+	DigestFrequencyDaily  DigestFrequency = "daily"
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+func (f DigestFrequency) IsValid() bool {
+	switch f {
+	case DigestFrequencyOff, DigestFrequencyDaily, DigestFrequencyWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+// DigestSubscription is a user's choice of digest frequency and the digest
+// watermark. CoveredUntil is the upper bound of the last digest window:
+// notifications delivered at or before it are never summarized again. It
+// starts at the moment the user subscribes; a nil watermark makes the next
+// digest cover everything still unread.
+type DigestSubscription struct {
+	UserID       int64           `json:"user_id" db:"user_id"`
+	Frequency    DigestFrequency `json:"frequency" db:"frequency"`
+	NextRunAt    time.Time       `json:"next_run_at" db:"next_run_at"`
+	LastSentAt   *time.Time      `json:"last_sent_at,omitempty" db:"last_sent_at"`
+	CoveredUntil *time.Time      `json:"covered_until,omitempty" db:"covered_until"`
+	UpdatedAt    time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// Digest summarizes the unread notifications delivered in (Since, Until],
+// grouped by type. Groups are ordered by size, largest first, and hold at
+// most the newest MaxItems of them; Total counts them all.
+type Digest struct {
+	UserID    int64
+	Frequency DigestFrequency
+	Since     *time.Time
+	Until     time.Time
+	Groups    []*DigestGroup
+	Total     int
+}
+
+type DigestGroup struct {
+	Type          events.EventType
+	Notifications []*Notification
+}
+
+// DigestTypes is which notification types a recipient's digest summarizes:
+// every type but Types with Exclude, only Types without it.
+type DigestTypes struct {
+	Types   []events.EventType
+	Exclude bool
+}
+
+// DigestTypesFor reads the recipient's digest channel preferences the way
+// ChannelEnabled does: a preference for a type wins over the one for all
+// types.
+func DigestTypesFor(preferences []*ChannelPreference) *DigestTypes {
+	enabled := true
+	byType := make(map[events.EventType]bool)
+	order := make([]events.EventType, 0)
+	for _, p := range preferences {
+		if p.Channel != ChannelDigest {
+			continue
+		}
+		if p.Type == "" {
+			enabled = p.Enabled
+			continue
+		}
+		if _, ok := byType[p.Type]; !ok {
+			byType[p.Type] = p.Enabled
+			order = append(order, p.Type)
+		}
+	}
+
+	types := &DigestTypes{Types: make([]events.EventType, 0, len(order)), Exclude: enabled}
+	for _, t := range order {
+		if byType[t] != enabled {
+			types.Types = append(types.Types, t)
+		}
+	}
+	return types
+}
+
+func (t *DigestTypes) TypeStrings() []string {
+	types := make([]string, 0, len(t.Types))
+	for _, notificationType := range t.Types {
+		types = append(types, string(notificationType))
+	}
+	return types
+}
@@ -7,11 +7,152 @@ import (
 	"github.com/soloda1/pinstack-proto-definitions/events"
 )
 
+type NotificationState string
+
+const (
+	NotificationStateUnread   NotificationState = "unread"
+	NotificationStateRead     NotificationState = "read"
+	NotificationStateArchived NotificationState = "archived"
+)
+
+func (s NotificationState) IsValid() bool {
+	switch s {
+	case NotificationStateUnread, NotificationStateRead, NotificationStateArchived:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationPriority orders notifications for the feed and for delivery.
+// High and critical notifications are urgent: they are sent on every
+// channel right away instead of waiting for the digest.
+type NotificationPriority string
+
+const (
+	NotificationPriorityLow      NotificationPriority = "low"
+	NotificationPriorityNormal   NotificationPriority = "normal"
+	NotificationPriorityHigh     NotificationPriority = "high"
+	NotificationPriorityCritical NotificationPriority = "critical"
+)
+
+func (p NotificationPriority) IsValid() bool {
+	switch p {
+	case NotificationPriorityLow, NotificationPriorityNormal, NotificationPriorityHigh, NotificationPriorityCritical:
+		return true
+	default:
+		return false
+	}
+}
+
+// Notification.IsRead mirrors State for clients of the notification.v1 API,
+// which only knows about the read flag: anything but unread counts as read.
+//
+// DeliverAt schedules a notification for later. Until it is due the
+// notification is hidden from feeds and counts; its CreatedAt is the
+// scheduled time, so it lands in the feed where the user expects it.
+//
+// ExpiresAt hides a time-sensitive notification from feeds, details and
+// unread counts once it passes; expired rows are purged by the retention job.
+//
+// Text is the title and body rendered for the reader's locale. It is not
+// stored; it is filled in on reads that ask for a locale.
+//
+// Priority is set by the sender or defaults by type; see
+// NotificationPriority.
+//
+// Actor is the profile of the user named by the payload (see ActorID),
+// looked up on feed and details reads. It stays nil when the user is gone
+// or the user service could not be reached.
 type Notification struct {
-	ID        int64            `json:"id" db:"id"`
-	UserID    int64            `json:"user_id" db:"user_id"`
-	Type      events.EventType `json:"type" db:"type"`
-	IsRead    bool             `json:"is_read" db:"is_read"`
-	CreatedAt time.Time        `json:"created_at" db:"created_at"`
-	Payload   json.RawMessage  `json:"payload,omitempty" db:"payload"`
+	ID          int64                `json:"id" db:"id"`
+	UserID      int64                `json:"user_id" db:"user_id"`
+	Type        events.EventType     `json:"type" db:"type"`
+	IsRead      bool                 `json:"is_read" db:"-"`
+	State       NotificationState    `json:"state" db:"state"`
+	PinnedAt    *time.Time           `json:"pinned_at,omitempty" db:"pinned_at"`
+	DeliverAt   *time.Time           `json:"deliver_at,omitempty" db:"deliver_at"`
+	ExpiresAt   *time.Time           `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedAt   time.Time            `json:"created_at" db:"created_at"`
+	Payload     json.RawMessage      `json:"payload,omitempty" db:"payload"`
+	Priority    NotificationPriority `json:"priority" db:"priority"`
+	BroadcastID int64                `json:"broadcast_id,omitempty" db:"broadcast_id"`
+	Text        *RenderedText        `json:"text,omitempty" db:"-"`
+	Actor       *Actor               `json:"actor,omitempty" db:"-"`
+}
+
+// actorPayloadKeys are the payload fields that name the user who caused a
+// notification, in order of preference.
+var actorPayloadKeys = []string{"actor_id", "follower_id"}
+
+// ActorID returns the user who caused the notification as named by its
+// payload, or 0 if the payload names none.
+func (n *Notification) ActorID() int64 {
+	if len(n.Payload) == 0 {
+		return 0
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(n.Payload, &fields); err != nil {
+		return 0
+	}
+	for _, key := range actorPayloadKeys {
+		raw, ok := fields[key]
+		if !ok {
+			continue
+		}
+		var id int64
+		if err := json.Unmarshal(raw, &id); err == nil && id > 0 {
+			return id
+		}
+	}
+	return 0
+}
+
+// RenderedText is a notification title and body in Locale, the locale whose
+// templates were actually used after falling back.
+type RenderedText struct {
+	Locale string `json:"locale"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+type ReadWatermark struct {
+	ReadBefore *time.Time
+	MaxID      *int64
+	Types      []events.EventType
+}
+
+func (w *ReadWatermark) HasBound() bool {
+	return w != nil && (w.ReadBefore != nil || w.MaxID != nil)
+}
+
+func (w *ReadWatermark) TypeStrings() []string {
+	if w == nil {
+		return nil
+	}
+	types := make([]string, 0, len(w.Types))
+	for _, t := range w.Types {
+		types = append(types, string(t))
+	}
+	return types
+}
+
+// FeedFilter narrows a user's feed by state. An empty filter is the main
+// feed: unread and read notifications, archived ones excluded.
+// PriorityFirst sorts urgent notifications before the rest, after pinned
+// ones.
+type FeedFilter struct {
+	States        []NotificationState
+	PriorityFirst bool
+}
+
+func (f *FeedFilter) StateStrings() []string {
+	if f == nil || len(f.States) == 0 {
+		return []string{string(NotificationStateUnread), string(NotificationStateRead)}
+	}
+	states := make([]string, 0, len(f.States))
+	for _, s := range f.States {
+		states = append(states, string(s))
+	}
+	return states
 }
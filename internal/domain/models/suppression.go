@@ -0,0 +1,36 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// ErrNotificationSuppressed is returned instead of an ID when a
+// notification was dropped by the spam protection policy.
+var ErrNotificationSuppressed = errors.New("notification suppressed")
+
+// ActorCap limits how many notifications of one type a recipient gets from
+// the same actor: at most Max within Window.
+type ActorCap struct {
+	Max    int
+	Window time.Duration
+}
+
+type SuppressionReason string
+
+const (
+	SuppressionReasonActorCap SuppressionReason = "actor_cap"
+)
+
+// NotificationSuppression records a notification that was not stored, for
+// audit.
+type NotificationSuppression struct {
+	ID        int64             `json:"id" db:"id"`
+	UserID    int64             `json:"user_id" db:"user_id"`
+	ActorID   int64             `json:"actor_id" db:"actor_id"`
+	Type      events.EventType  `json:"type" db:"type"`
+	Reason    SuppressionReason `json:"reason" db:"reason"`
+	CreatedAt time.Time         `json:"created_at" db:"created_at"`
+}
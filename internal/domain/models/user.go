@@ -32,3 +32,20 @@ func UserFromProto(u *pb.User) *User {
 		UpdatedAt: u.UpdatedAt.AsTime(),
 	}
 }
+
+// Actor is the public profile of the user who caused a notification.
+type Actor struct {
+	ID        int64   `json:"id"`
+	Username  string  `json:"username"`
+	FullName  *string `json:"full_name,omitempty"`
+	AvatarURL *string `json:"avatar_url,omitempty"`
+}
+
+func ActorFromUser(u *User) *Actor {
+	return &Actor{
+		ID:        u.ID,
+		Username:  u.Username,
+		FullName:  u.FullName,
+		AvatarURL: u.AvatarURL,
+	}
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// EventTypeUserDeleted is published by user-service once an account is
+// deleted. The shared event contract does not define it yet.
+const EventTypeUserDeleted events.EventType = "user_deleted"
+
+type UserDeletedPayload struct {
+	UserID    int64     `json:"user_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// UserPurgeReport counts what was removed for a deleted user.
+// ActorNotifications are notifications in other users' feeds that named the
+// deleted user as their actor. Suppressions are spam protection records with
+// the user as recipient or actor.
+type UserPurgeReport struct {
+	UserID               int64 `json:"user_id"`
+	Notifications        int64 `json:"notifications"`
+	ActorNotifications   int64 `json:"actor_notifications"`
+	ChannelPreferences   int64 `json:"channel_preferences"`
+	DeviceTokens         int64 `json:"device_tokens"`
+	WebhookSubscriptions int64 `json:"webhook_subscriptions"`
+	DigestSubscriptions  int64 `json:"digest_subscriptions"`
+	Suppressions         int64 `json:"suppressions"`
+}
+
+// ExportRecordKind is the kind of a record in a user data export.
+type ExportRecordKind string
+
+const (
+	ExportRecordChannelPreference  ExportRecordKind = "channel_preference"
+	ExportRecordDigestSubscription ExportRecordKind = "digest_subscription"
+	ExportRecordDeviceToken        ExportRecordKind = "device_token"
+	ExportRecordNotification       ExportRecordKind = "notification"
+	ExportRecordDelivery           ExportRecordKind = "delivery"
+)
+
+// ExportKinds is the order in which an export goes through the kinds.
+var ExportKinds = []ExportRecordKind{
+	ExportRecordChannelPreference,
+	ExportRecordDigestSubscription,
+	ExportRecordDeviceToken,
+	ExportRecordNotification,
+	ExportRecordDelivery,
+}
+
+// ExportRecord is one stored row, as stored. Key orders records within
+// their kind and is what an ExportCursor points past.
+type ExportRecord struct {
+	Kind ExportRecordKind `json:"kind"`
+	Key  int64            `json:"-"`
+	Data json.RawMessage  `json:"data"`
+}
+
+// ExportCursor is where a paged export continues: with the records of Kind
+// after AfterKey, then the kinds that follow it.
+type ExportCursor struct {
+	Kind     ExportRecordKind
+	AfterKey int64
+}
+
+// ErrInvalidExportCursor is returned for a cursor that was not produced by
+// Encode.
+var ErrInvalidExportCursor = errors.New("invalid export cursor")
+
+// Encode returns the cursor as an opaque token for clients.
+func (c *ExportCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(string(c.Kind) + ":" + strconv.FormatInt(c.AfterKey, 10)))
+}
+
+// ParseExportCursor decodes a token from Encode. An empty token is the start
+// of the export.
+func ParseExportCursor(token string) (*ExportCursor, error) {
+	if token == "" {
+		return &ExportCursor{Kind: ExportKinds[0]}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidExportCursor
+	}
+	kind, after, ok := strings.Cut(string(raw), ":")
+	if !ok || !slices.Contains(ExportKinds, ExportRecordKind(kind)) {
+		return nil, ErrInvalidExportCursor
+	}
+	afterKey, err := strconv.ParseInt(after, 10, 64)
+	if err != nil || afterKey < 0 {
+		return nil, ErrInvalidExportCursor
+	}
+	return &ExportCursor{Kind: ExportRecordKind(kind), AfterKey: afterKey}, nil
+}
+
+// ExportFormat is how export records are written out.
+type ExportFormat string
+
+const (
+	// ExportFormatNDJSON writes one record per line.
+	ExportFormatNDJSON ExportFormat = "ndjson"
+	// ExportFormatJSON writes a single document with a records array.
+	ExportFormatJSON ExportFormat = "json"
+)
+
+func (f ExportFormat) IsValid() bool {
+	return f == ExportFormatNDJSON || f == ExportFormatJSON
+}
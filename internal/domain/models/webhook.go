@@ -0,0 +1,97 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+var ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+
+// WebhookEvent is a notification lifecycle event that can be sent to webhooks.
+type WebhookEvent string
+
+const (
+	WebhookEventNotificationCreated WebhookEvent = "notification.created"
+	WebhookEventNotificationRead    WebhookEvent = "notification.read"
+	// WebhookEventNotificationsReadAll is sent for bulk reads, which do not
+	// report individual notifications.
+	WebhookEventNotificationsReadAll WebhookEvent = "notifications.read_all"
+)
+
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventNotificationCreated, WebhookEventNotificationRead, WebhookEventNotificationsReadAll:
+		return true
+	default:
+		return false
+	}
+}
+
+// UserWebhookOwner is the owner of subscriptions a user registered for their
+// own account. They are removed together with the account.
+func UserWebhookOwner(userID int64) string {
+	return "user:" + strconv.FormatInt(userID, 10)
+}
+
+// WebhookSubscription is an HTTP endpoint that receives signed callbacks.
+// Empty Events or NotificationTypes match everything. A subscription is
+// disabled after too many consecutive failed attempts and stays disabled
+// until its owner re-enables it.
+type WebhookSubscription struct {
+	ID                  int64              `json:"id" db:"id"`
+	Owner               string             `json:"owner" db:"owner"`
+	URL                 string             `json:"url" db:"url"`
+	Secret              string             `json:"-" db:"secret"`
+	Events              []WebhookEvent     `json:"events" db:"events"`
+	NotificationTypes   []events.EventType `json:"notification_types" db:"notification_types"`
+	Enabled             bool               `json:"enabled" db:"enabled"`
+	ConsecutiveFailures int                `json:"consecutive_failures" db:"consecutive_failures"`
+	DisabledAt          *time.Time         `json:"disabled_at,omitempty" db:"disabled_at"`
+	CreatedAt           time.Time          `json:"created_at" db:"created_at"`
+}
+
+// Matches reports whether the subscription wants the event. Notification
+// types are not checked when notificationType is empty (bulk reads).
+func (s *WebhookSubscription) Matches(event WebhookEvent, notificationType events.EventType) bool {
+	if len(s.Events) > 0 && !slices.Contains(s.Events, event) {
+		return false
+	}
+	if notificationType != "" && len(s.NotificationTypes) > 0 && !slices.Contains(s.NotificationTypes, notificationType) {
+		return false
+	}
+	return true
+}
+
+// WebhookDelivery is a queued callback. IdempotencyKey is fixed when the
+// delivery is queued, so receivers can drop retries they already handled.
+type WebhookDelivery struct {
+	ID             int64           `json:"id" db:"id"`
+	SubscriptionID int64           `json:"subscription_id" db:"subscription_id"`
+	Event          WebhookEvent    `json:"event" db:"event"`
+	IdempotencyKey string          `json:"idempotency_key" db:"idempotency_key"`
+	Payload        json.RawMessage `json:"payload" db:"payload"`
+	Status         DeliveryStatus  `json:"status" db:"status"`
+	Attempts       int             `json:"attempts" db:"attempts"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at" db:"next_attempt_at"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+}
+
+// WebhookAttempt is one HTTP call made for a delivery. ResponseStatus is 0
+// when no response was received.
+type WebhookAttempt struct {
+	ID             int64         `json:"id" db:"id"`
+	DeliveryID     int64         `json:"delivery_id" db:"delivery_id"`
+	SubscriptionID int64         `json:"subscription_id" db:"subscription_id"`
+	Event          WebhookEvent  `json:"event" db:"event"`
+	IdempotencyKey string        `json:"idempotency_key" db:"idempotency_key"`
+	Attempt        int           `json:"attempt" db:"attempt"`
+	ResponseStatus int           `json:"response_status" db:"response_status"`
+	Error          string        `json:"error,omitempty" db:"error"`
+	Duration       time.Duration `json:"duration" db:"duration_ms"`
+	AttemptedAt    time.Time     `json:"attempted_at" db:"attempted_at"`
+}
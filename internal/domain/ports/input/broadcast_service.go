@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=BroadcastService --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type BroadcastService interface {
+	CreateBroadcast(ctx context.Context, broadcast *models.Broadcast) (int64, error)
+	GetBroadcast(ctx context.Context, id int64) (*models.Broadcast, error)
+	ProcessDue(ctx context.Context, batchSize int) (int, error)
+}
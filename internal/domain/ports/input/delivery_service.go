@@ -0,0 +1,16 @@
+package input
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=DeliveryService --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type DeliveryService interface {
+	Dispatch(ctx context.Context, notification *models.Notification) (int, error)
+	ProcessPending(ctx context.Context, batchSize int) (int, error)
+	SetChannelPreference(ctx context.Context, preference *models.ChannelPreference) error
+	ListChannelPreferences(ctx context.Context, userID int64) ([]*models.ChannelPreference, error)
+	Unsubscribe(ctx context.Context, token string) error
+	ListNotificationDeliveries(ctx context.Context, notificationID int64) ([]*models.Delivery, error)
+}
@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=DeviceService --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type DeviceService interface {
+	RegisterDeviceToken(ctx context.Context, token *models.DeviceToken) error
+	UnregisterDeviceToken(ctx context.Context, userID int64, token string) error
+	ListDeviceTokens(ctx context.Context, userID int64) ([]*models.DeviceToken, error)
+}
@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=DigestService --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type DigestService interface {
+	SetDigestFrequency(ctx context.Context, userID int64, frequency models.DigestFrequency) error
+	GetDigestSettings(ctx context.Context, userID int64) (*models.DigestSubscription, error)
+	ProcessDue(ctx context.Context, batchSize int) (int, error)
+}
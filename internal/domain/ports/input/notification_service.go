@@ -3,15 +3,26 @@ package input
 import (
 	"context"
 	"pinstack-notification-service/internal/domain/models"
+	"time"
 )
 
 //go:generate mockery --name=NotificationService --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
 type NotificationService interface {
 	SaveNotification(ctx context.Context, notification *models.Notification) (int64, error)
+	SaveNotifications(ctx context.Context, notifications []*models.Notification) ([]error, error)
 	GetNotificationDetails(ctx context.Context, id int64) (*models.Notification, error)
-	GetUserNotificationFeed(ctx context.Context, userID int64, limit, page int) ([]*models.Notification, int32, error)
+	GetUserNotificationFeed(ctx context.Context, userID int64, filter *models.FeedFilter, limit, page int) ([]*models.Notification, int32, error)
+	LocalizeNotifications(notifications []*models.Notification, locale string)
 	ReadNotification(ctx context.Context, id int64) error
 	ReadAllUserNotifications(ctx context.Context, userID int64) error
+	ReadUserNotificationsUpTo(ctx context.Context, userID int64, watermark *models.ReadWatermark) (int64, error)
+	UpdateNotificationState(ctx context.Context, id int64, state models.NotificationState) error
+	SetNotificationPinned(ctx context.Context, id int64, pinned bool) error
 	RemoveNotification(ctx context.Context, id int64) error
+	RestoreNotification(ctx context.Context, id int64) error
+	PurgeDeletedNotifications(ctx context.Context, olderThan time.Duration, batchSize int) (int64, error)
+	PurgeExpiredNotifications(ctx context.Context, batchSize int) (int64, error)
 	GetUnreadCount(ctx context.Context, userID int64) (int, error)
+	DeliverDueNotifications(ctx context.Context, batchSize int) (int, error)
+	CancelScheduledNotification(ctx context.Context, id int64) error
 }
@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+	"io"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=UserDataService --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type UserDataService interface {
+	// PurgeUser removes everything stored for a deleted user and the
+	// notifications that name them as actor. It is safe to repeat.
+	PurgeUser(ctx context.Context, userID int64) (*models.UserPurgeReport, error)
+	// ExportUserData writes the user's notifications, preferences, device
+	// tokens and delivery history to w. With pageSize > 0 only one page is
+	// written and the cursor of the next one is returned.
+	ExportUserData(ctx context.Context, w io.Writer, userID int64, format models.ExportFormat, cursor string, pageSize int) (string, error)
+}
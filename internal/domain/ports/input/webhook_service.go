@@ -0,0 +1,20 @@
+package input
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=WebhookService --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type WebhookService interface {
+	NotificationCreated(ctx context.Context, notification *models.Notification) error
+	NotificationRead(ctx context.Context, notification *models.Notification) error
+	NotificationsReadAll(ctx context.Context, userID int64, count int64) error
+	ProcessPending(ctx context.Context, batchSize int) (int, error)
+
+	CreateSubscription(ctx context.Context, subscription *models.WebhookSubscription) (*models.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, owner string) ([]*models.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	EnableSubscription(ctx context.Context, id int64) error
+	ListAttempts(ctx context.Context, subscriptionID int64, limit int) ([]*models.WebhookAttempt, error)
+}
@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=BroadcastRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type BroadcastRepository interface {
+	Create(ctx context.Context, broadcast *models.Broadcast) (int64, error)
+	Get(ctx context.Context, id int64) (*models.Broadcast, error)
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Broadcast, error)
+	Advance(ctx context.Context, broadcastID int64, chunk *models.BroadcastChunk) error
+	RecordFailure(ctx context.Context, broadcastID int64, lastError string, retryAt *time.Time) error
+}
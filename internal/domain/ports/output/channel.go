@@ -0,0 +1,15 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+// Channel sends a notification to its recipient outside the app. Errors
+// wrapping models.ErrPermanentDeliveryFailure are not retried.
+//
+//go:generate mockery --name=Channel --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type Channel interface {
+	Name() models.Channel
+	Send(ctx context.Context, notification *models.Notification, recipient *models.User) error
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=DeliveryRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type DeliveryRepository interface {
+	Enqueue(ctx context.Context, deliveries []*models.Delivery) error
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int, channels []models.Channel) ([]*models.Delivery, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	MarkFailed(ctx context.Context, id int64, lastError string) error
+	ListByNotification(ctx context.Context, notificationID int64) ([]*models.Delivery, error)
+}
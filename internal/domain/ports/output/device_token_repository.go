@@ -0,0 +1,18 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=DeviceTokenRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type DeviceTokenRepository interface {
+	// Register stores the token for the user, taking it over from any
+	// previous owner, and refreshes its platform and locale.
+	Register(ctx context.Context, token *models.DeviceToken) error
+	Unregister(ctx context.Context, userID int64, token string) error
+	ListByUser(ctx context.Context, userID int64) ([]*models.DeviceToken, error)
+	// DeleteTokens removes tokens regardless of owner and returns how many were removed.
+	DeleteTokens(ctx context.Context, tokens []string) (int64, error)
+	DeleteByUser(ctx context.Context, userID int64) (int64, error)
+}
@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=DigestRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type DigestRepository interface {
+	Upsert(ctx context.Context, subscription *models.DigestSubscription) error
+	Get(ctx context.Context, userID int64) (*models.DigestSubscription, error)
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.DigestSubscription, error)
+	Advance(ctx context.Context, userID int64, coveredUntil time.Time, sentAt *time.Time, nextRunAt time.Time) error
+	Delete(ctx context.Context, userID int64) (int64, error)
+}
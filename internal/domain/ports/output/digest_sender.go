@@ -0,0 +1,14 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+// DigestSender delivers a digest to its recipient. Errors wrapping
+// models.ErrPermanentDeliveryFailure are not retried.
+//
+//go:generate mockery --name=DigestSender --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type DigestSender interface {
+	SendDigest(ctx context.Context, recipient *models.User, digest *models.Digest) error
+}
@@ -1,10 +1,18 @@
 package output
 
-// Logger is a minimal abstraction used across application layer and adapters
+import "context"
+
+// Logger is a minimal abstraction used across application layer and adapters.
+// The Context variants also log what the context carries, such as the trace
+// of the request being served.
 type Logger interface {
 	Debug(msg string, args ...any)
 	Info(msg string, args ...any)
 	Warn(msg string, args ...any)
 	Error(msg string, args ...any)
+	DebugContext(ctx context.Context, msg string, args ...any)
+	InfoContext(ctx context.Context, msg string, args ...any)
+	WarnContext(ctx context.Context, msg string, args ...any)
+	ErrorContext(ctx context.Context, msg string, args ...any)
 	With(args ...any) Logger
 }
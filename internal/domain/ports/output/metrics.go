@@ -5,14 +5,23 @@ import "time"
 type MetricsProvider interface {
 	IncrementGRPCRequests(method, status string)
 	RecordGRPCRequestDuration(method, status string, duration time.Duration)
+	IncrementRateLimitDecisions(method, result string)
 
 	IncrementDatabaseQueries(queryType string, success bool)
 	RecordDatabaseQueryDuration(queryType string, duration time.Duration)
 
 	IncrementNotificationOperations(operation string, success bool)
+	IncrementSuppressedNotifications(notificationType, reason string)
+	AddBroadcastNotifications(notificationType string, count int)
 	IncrementKafkaMessages(topic, operation string, success bool)
 	RecordKafkaMessageDuration(topic, operation string, duration time.Duration)
 	SetActiveConnections(count int)
 
+	IncrementChannelDeliveries(channel, status string)
+	RecordChannelDeliveryDuration(channel string, duration time.Duration)
+
+	SetCircuitBreakerState(name, state string)
+	IncrementCacheLookups(cache, result string)
+
 	SetServiceHealth(healthy bool)
 }
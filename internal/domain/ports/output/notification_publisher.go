@@ -0,0 +1,14 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+// NotificationPublisher announces notifications that became visible to the
+// recipient, so real-time consumers (websocket gateway, push) can react.
+//
+//go:generate mockery --name=NotificationPublisher --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type NotificationPublisher interface {
+	PublishDelivered(ctx context.Context, notification *models.Notification) error
+}
@@ -0,0 +1,13 @@
+package output
+
+import (
+	"pinstack-notification-service/internal/domain/models"
+)
+
+// NotificationRenderer renders a notification's title and body for a
+// locale, falling back to a default locale.
+//
+//go:generate mockery --name=NotificationRenderer --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type NotificationRenderer interface {
+	Render(notification *models.Notification, locale string) (*models.RenderedText, error)
+}
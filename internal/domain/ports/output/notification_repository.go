@@ -3,15 +3,33 @@ package output
 import (
 	"context"
 	"pinstack-notification-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
 )
 
 //go:generate mockery --name=NotificationRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
 type NotificationRepository interface {
 	Create(ctx context.Context, notif *models.Notification) (int64, error)
+	CreateMany(ctx context.Context, notifs []*models.Notification) ([]int64, error)
 	GetByID(ctx context.Context, id int64) (*models.Notification, error)
-	ListByUser(ctx context.Context, userID int64, limit int, offset int) ([]*models.Notification, int32, error)
+	ListByUser(ctx context.Context, userID int64, filter *models.FeedFilter, limit int, offset int) ([]*models.Notification, int32, error)
 	MarkAsRead(ctx context.Context, id int64) error
-	MarkAllAsRead(ctx context.Context, userID int64) error
+	MarkAllAsRead(ctx context.Context, userID int64) (int64, error)
+	MarkAllAsReadUpTo(ctx context.Context, userID int64, watermark *models.ReadWatermark) (int64, error)
+	UpdateState(ctx context.Context, id int64, state models.NotificationState) error
+	SetPinned(ctx context.Context, id int64, pinned bool) error
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64, deletedAfter time.Time) error
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (int64, error)
+	PurgeExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error)
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*models.Notification, error)
+	CancelScheduled(ctx context.Context, id int64) error
 	CountUnread(ctx context.Context, userID int64) (int, error)
+	ListUnreadDelivered(ctx context.Context, userID int64, after *time.Time, until time.Time, types *models.DigestTypes, limit int) ([]*models.Notification, int, error)
+	DeleteByUser(ctx context.Context, userID int64, limit int) (int64, error)
+	DeleteByActor(ctx context.Context, actorID int64, limit int) (int64, error)
+	CountFromActorSince(ctx context.Context, userID, actorID int64, notificationType events.EventType, since time.Time) (int, error)
+	CreateSuppression(ctx context.Context, suppression *models.NotificationSuppression) (int64, error)
+	DeleteSuppressionsByUser(ctx context.Context, userID int64, limit int) (int64, error)
 }
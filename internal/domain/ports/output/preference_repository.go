@@ -0,0 +1,13 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=PreferenceRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type PreferenceRepository interface {
+	ListChannelPreferences(ctx context.Context, userID int64) ([]*models.ChannelPreference, error)
+	UpsertChannelPreference(ctx context.Context, preference *models.ChannelPreference) error
+	DeleteChannelPreferences(ctx context.Context, userID int64) (int64, error)
+}
@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+// PushProvider delivers a push message to one device. Errors wrapping
+// models.ErrInvalidDeviceToken make the push channel prune the token;
+// errors wrapping models.ErrPermanentDeliveryFailure are not retried.
+//
+//go:generate mockery --name=PushProvider --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type PushProvider interface {
+	Name() string
+	Send(ctx context.Context, device *models.DeviceToken, message *models.PushMessage) error
+}
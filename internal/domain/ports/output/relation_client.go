@@ -0,0 +1,12 @@
+package output
+
+import (
+	"context"
+)
+
+//go:generate mockery --name=RelationClient --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type RelationClient interface {
+	// GetFollowers returns one page (1-based) of the ids of userID's
+	// followers and the follower count.
+	GetFollowers(ctx context.Context, userID int64, limit, page int) ([]int64, int, error)
+}
@@ -0,0 +1,15 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+)
+
+//go:generate mockery --name=UserDataExportRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type UserDataExportRepository interface {
+	// ExportUserData calls fn for every stored record of the user from the
+	// cursor on, in models.ExportKinds order, reading from one snapshot.
+	// With limit > 0 it stops after limit records and returns the cursor to
+	// continue from; it returns nil once nothing is left.
+	ExportUserData(ctx context.Context, userID int64, from *models.ExportCursor, limit int, fn func(*models.ExportRecord) error) (*models.ExportCursor, error)
+}
@@ -0,0 +1,29 @@
+package output
+
+import (
+	"context"
+	"pinstack-notification-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=WebhookRepository --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *models.WebhookSubscription) (int64, error)
+	GetSubscription(ctx context.Context, id int64) (*models.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, owner string) ([]*models.WebhookSubscription, error)
+	ListEnabledSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	DeleteSubscriptionsByOwner(ctx context.Context, owner string) (int64, error)
+	EnableSubscription(ctx context.Context, id int64) error
+	// RecordSubscriptionResult resets the failure streak on success, or
+	// extends it and disables the subscription once it reaches disableAfter.
+	RecordSubscriptionResult(ctx context.Context, id int64, success bool, disableAfter int) (disabled bool, err error)
+
+	EnqueueDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error
+	ClaimPendingDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.WebhookDelivery, error)
+	MarkDeliverySent(ctx context.Context, id int64) error
+	MarkDeliveryRetry(ctx context.Context, id int64, nextAttemptAt time.Time) error
+	MarkDeliveryFailed(ctx context.Context, id int64) error
+	RecordAttempt(ctx context.Context, attempt *models.WebhookAttempt) error
+	ListAttempts(ctx context.Context, subscriptionID int64, limit int) ([]*models.WebhookAttempt, error)
+}
@@ -0,0 +1,13 @@
+package output
+
+import (
+	"context"
+	"net/http"
+)
+
+// WebhookSender posts a signed webhook body and returns the response status.
+//
+//go:generate mockery --name=WebhookSender --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type WebhookSender interface {
+	Post(ctx context.Context, url string, header http.Header, body []byte) (status int, err error)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -19,12 +20,16 @@ type KafkaConfig struct {
 	BatchSize             int    `yaml:"batch_size"`
 	LingerMs              int    `yaml:"linger_ms"`
 	RelationTopic         string `yaml:"relation_topic"`
+	UserTopic             string `yaml:"user_topic"`
+	NotificationTopic     string `yaml:"notification_topic"`
 	ConsumerGroupID       string `yaml:"consumer_group_id"`
 	AutoOffsetReset       string `yaml:"auto_offset_reset"`
 	EnableAutoCommit      bool   `yaml:"enable_auto_commit"`
 	AutoCommitIntervalMs  int    `yaml:"auto_commit_interval_ms"`
 	SessionTimeoutMs      int    `yaml:"session_timeout_ms"`
 	MaxPollIntervalMs     int    `yaml:"max_poll_interval_ms"`
+	PurgeMaxRetries       int    `yaml:"purge_max_retries"`
+	PurgeRetryBackoffMs   int    `yaml:"purge_retry_backoff_ms"`
 }
 
 type GrpcServerConfig struct {
@@ -35,6 +40,7 @@ type GrpcServerConfig struct {
 type EventTypesConfig struct {
 	FollowCreated string `yaml:"follow_created"`
 	FollowDeleted string `yaml:"follow_deleted"`
+	UserDeleted   string `yaml:"user_deleted"`
 }
 
 type PrometheusConfig struct {
@@ -42,19 +48,284 @@ type PrometheusConfig struct {
 	Port    int    `yaml:"port"`
 }
 
+// NotificationsConfig.ActorLookupConcurrency bounds parallel user service
+// calls when feeds are enriched with actor profiles; ActorLookupTimeout caps
+// how long a read waits for them. PriorityByType is the priority given to
+// notifications of a type when the sender sets none; others are normal.
+type NotificationsConfig struct {
+	RestoreGracePeriod     time.Duration     `yaml:"restore_grace_period"`
+	ActorLookupConcurrency int               `yaml:"actor_lookup_concurrency"`
+	ActorLookupTimeout     time.Duration     `yaml:"actor_lookup_timeout"`
+	PriorityByType         map[string]string `yaml:"priority_by_type"`
+}
+
+type CompactionConfig struct {
+	Enabled   bool          `yaml:"enabled"`
+	Interval  time.Duration `yaml:"interval"`
+	Retention time.Duration `yaml:"retention"`
+	BatchSize int           `yaml:"batch_size"`
+}
+
+type SchedulerConfig struct {
+	Enabled   bool          `yaml:"enabled"`
+	Interval  time.Duration `yaml:"interval"`
+	BatchSize int           `yaml:"batch_size"`
+}
+
+// EmailConfig configures the SMTP email channel. TLS is "none" (e.g. a local
+// MailHog), "starttls" or "tls" for implicit TLS; auth is skipped when
+// Username is empty.
+type EmailConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	Host         string        `yaml:"host"`
+	Port         int           `yaml:"port"`
+	Username     string        `yaml:"username"`
+	Password     string        `yaml:"password"`
+	From         string        `yaml:"from"`
+	FromName     string        `yaml:"from_name"`
+	TLS          string        `yaml:"tls"`
+	Timeout      time.Duration `yaml:"timeout"`
+	TemplatesDir string        `yaml:"templates_dir"`
+}
+
+// FCMConfig authenticates to the FCM HTTP v1 API with a service account
+// key file. ProjectID defaults to the one in the key file.
+type FCMConfig struct {
+	ProjectID       string `yaml:"project_id"`
+	CredentialsFile string `yaml:"credentials_file"`
+	Endpoint        string `yaml:"endpoint"`
+}
+
+// APNsConfig authenticates to APNs with a token signing key (.p8 file).
+// Topic is the app bundle ID; Production selects the production gateway.
+type APNsConfig struct {
+	TeamID     string `yaml:"team_id"`
+	KeyID      string `yaml:"key_id"`
+	KeyFile    string `yaml:"key_file"`
+	Topic      string `yaml:"topic"`
+	Production bool   `yaml:"production"`
+	Endpoint   string `yaml:"endpoint"`
+}
+
+// PushConfig drives the push channel. Providers maps a device platform
+// (android, ios) to the provider that serves it: fcm, apns or fake.
+type PushConfig struct {
+	Enabled      bool              `yaml:"enabled"`
+	Providers    map[string]string `yaml:"providers"`
+	DeepLinkBase string            `yaml:"deep_link_base"`
+	TemplatesDir string            `yaml:"templates_dir"`
+	Timeout      time.Duration     `yaml:"timeout"`
+	FCM          FCMConfig         `yaml:"fcm"`
+	APNs         APNsConfig        `yaml:"apns"`
+}
+
+// DeliveryConfig drives out-of-app delivery. Channels lists the channels a
+// notification goes to by default; ChannelsByType overrides it per type.
+// FakeChannels registers in-memory channels that only log, for local runs,
+// for every channel without a real adapter enabled.
+type DeliveryConfig struct {
+	Enabled        bool                `yaml:"enabled"`
+	Workers        int                 `yaml:"workers"`
+	PollInterval   time.Duration       `yaml:"poll_interval"`
+	BatchSize      int                 `yaml:"batch_size"`
+	MaxAttempts    int                 `yaml:"max_attempts"`
+	BackoffBase    time.Duration       `yaml:"backoff_base"`
+	BackoffMax     time.Duration       `yaml:"backoff_max"`
+	Lease          time.Duration       `yaml:"lease"`
+	Channels       []string            `yaml:"channels"`
+	ChannelsByType map[string][]string `yaml:"channels_by_type"`
+	FakeChannels   bool                `yaml:"fake_channels"`
+	// Unsubscribe links point at UnsubscribeURL with a token signed by UnsubscribeSecret.
+	UnsubscribeURL    string      `yaml:"unsubscribe_url"`
+	UnsubscribeSecret string      `yaml:"unsubscribe_secret"`
+	Email             EmailConfig `yaml:"email"`
+	Push              PushConfig  `yaml:"push"`
+}
+
+// WebhooksConfig drives partner webhooks. A subscription is disabled after
+// DisableAfter failed attempts in a row; Timeout bounds a single request.
+type WebhooksConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	Workers      int           `yaml:"workers"`
+	PollInterval time.Duration `yaml:"poll_interval"`
+	BatchSize    int           `yaml:"batch_size"`
+	MaxAttempts  int           `yaml:"max_attempts"`
+	BackoffBase  time.Duration `yaml:"backoff_base"`
+	BackoffMax   time.Duration `yaml:"backoff_max"`
+	Lease        time.Duration `yaml:"lease"`
+	Timeout      time.Duration `yaml:"timeout"`
+	DisableAfter int           `yaml:"disable_after"`
+}
+
+// DigestConfig drives digest emails. Daily digests go out at SendHour UTC,
+// weekly ones at SendHour on WeeklyDay (monday..sunday). Digests are sent
+// through the email settings under delivery.email.
+type DigestConfig struct {
+	Enabled   bool          `yaml:"enabled"`
+	Interval  time.Duration `yaml:"interval"`
+	BatchSize int           `yaml:"batch_size"`
+	SendHour  int           `yaml:"send_hour"`
+	WeeklyDay string        `yaml:"weekly_day"`
+	MaxItems  int           `yaml:"max_items"`
+	Lease     time.Duration `yaml:"lease"`
+}
+
+// BroadcastConfig drives the broadcast fan-out job. Each run claims up to
+// BatchSize broadcasts and stores ChunkSize notifications per broadcast
+// and step; an explicit audience holds at most MaxRecipients users.
+type BroadcastConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	Interval      time.Duration `yaml:"interval"`
+	BatchSize     int           `yaml:"batch_size"`
+	ChunkSize     int           `yaml:"chunk_size"`
+	MaxRecipients int           `yaml:"max_recipients"`
+	Lease         time.Duration `yaml:"lease"`
+	RetryDelay    time.Duration `yaml:"retry_delay"`
+}
+
+// UserDataConfig.PurgeBatchSize bounds how many notifications one delete
+// statement removes while a deleted user's data is purged.
+type UserDataConfig struct {
+	PurgeBatchSize int `yaml:"purge_batch_size"`
+}
+
+// LocalizationConfig selects the locale used when a requested one has no
+// templates. TemplatesDir overrides the built-in templates file by file and
+// is re-read on change when HotReload is set.
+type LocalizationConfig struct {
+	DefaultLocale string `yaml:"default_locale"`
+	TemplatesDir  string `yaml:"templates_dir"`
+	HotReload     bool   `yaml:"hot_reload"`
+}
+
+// HealthConfig drives dependency probes. Interval is how often the gRPC
+// health status is refreshed; DrainDelay is how long the service reports
+// NOT_SERVING before it stops accepting calls on shutdown.
+type HealthConfig struct {
+	ProbeTimeout time.Duration `yaml:"probe_timeout"`
+	Interval     time.Duration `yaml:"interval"`
+	DrainDelay   time.Duration `yaml:"drain_delay"`
+}
+
+// TracingConfig drives OpenTelemetry tracing. Spans are exported over
+// OTLP/gRPC to OTLPEndpoint, or written to stdout when no endpoint is set.
+// SampleRatio is the share of new traces recorded; calls that arrive with a
+// sampled parent are always recorded.
+type TracingConfig struct {
+	Enabled      bool    `yaml:"enabled"`
+	ServiceName  string  `yaml:"service_name"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint"`
+	Insecure     bool    `yaml:"insecure"`
+	SampleRatio  float64 `yaml:"sample_ratio"`
+}
+
+// LoggingConfig lists the attribute keys whose values are replaced with
+// [REDACTED] and the length long string values are cut to. Level overrides
+// the default level for the environment; it can also be changed at runtime
+// through /admin/log-level on the metrics server, which is only served when
+// AdminToken is set and requires it as a bearer token.
+type LoggingConfig struct {
+	Level          string            `yaml:"level"`
+	AdminToken     string            `yaml:"admin_token"`
+	RedactKeys     []string          `yaml:"redact_keys"`
+	MaxValueLength int               `yaml:"max_value_length"`
+	Sampling       LogSamplingConfig `yaml:"sampling"`
+}
+
+// LogSamplingConfig thins out the per-message consumer logs and the
+// per-request gRPC logs: in every Tick the first Initial lines are written,
+// then every Thereafter-th. Initial 0 disables sampling.
+type LogSamplingConfig struct {
+	Initial    int           `yaml:"initial"`
+	Thereafter int           `yaml:"thereafter"`
+	Tick       time.Duration `yaml:"tick"`
+}
+
+// RateLimitConfig limits the gRPC methods listed in Methods, keyed by
+// method name ("GetUnreadCount"). Backend is "memory", which limits each
+// replica on its own, or "redis", which shares the buckets across replicas.
+type RateLimitConfig struct {
+	Enabled bool                     `yaml:"enabled"`
+	Backend string                   `yaml:"backend"`
+	Redis   RedisConfig              `yaml:"redis"`
+	Methods map[string]RateLimitRule `yaml:"methods"`
+}
+
+// RateLimitRule allows Burst calls at once, refilled at Rate per second,
+// per user ("user") or per calling service ("caller").
+type RateLimitRule struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+	By    string  `yaml:"by"`
+}
+
+type RedisConfig struct {
+	Address   string `yaml:"address"`
+	Password  string `yaml:"password"`
+	DB        int    `yaml:"db"`
+	KeyPrefix string `yaml:"key_prefix"`
+}
+
+// SpamProtectionConfig caps, per notification type, how many notifications
+// a recipient gets from the same actor within Window. Notifications over
+// the cap are recorded in notification_suppressions instead of stored.
+type SpamProtectionConfig struct {
+	ActorCaps map[string]ActorCapConfig `yaml:"actor_caps"`
+}
+
+type ActorCapConfig struct {
+	Max    int           `yaml:"max"`
+	Window time.Duration `yaml:"window"`
+}
+
 type Config struct {
-	Env         string           `yaml:"env"`
-	GrpcServer  GrpcServerConfig `yaml:"grpc_server"`
-	Kafka       KafkaConfig      `yaml:"kafka"`
-	Database    Database         `yaml:"database"`
-	EventTypes  EventTypesConfig `yaml:"event_types"`
-	Prometheus  PrometheusConfig `yaml:"prometheus"`
-	UserService UserService      `yaml:"user_service"`
+	Env             string               `yaml:"env"`
+	GrpcServer      GrpcServerConfig     `yaml:"grpc_server"`
+	Kafka           KafkaConfig          `yaml:"kafka"`
+	Database        Database             `yaml:"database"`
+	EventTypes      EventTypesConfig     `yaml:"event_types"`
+	Prometheus      PrometheusConfig     `yaml:"prometheus"`
+	UserService     UserService          `yaml:"user_service"`
+	RelationService RelationService      `yaml:"relation_service"`
+	Notifications   NotificationsConfig  `yaml:"notifications"`
+	Compaction      CompactionConfig     `yaml:"compaction"`
+	Scheduler       SchedulerConfig      `yaml:"scheduler"`
+	Delivery        DeliveryConfig       `yaml:"delivery"`
+	Webhooks        WebhooksConfig       `yaml:"webhooks"`
+	Digest          DigestConfig         `yaml:"digest"`
+	Broadcast       BroadcastConfig      `yaml:"broadcast"`
+	Localization    LocalizationConfig   `yaml:"localization"`
+	UserData        UserDataConfig       `yaml:"user_data"`
+	Health          HealthConfig         `yaml:"health"`
+	Tracing         TracingConfig        `yaml:"tracing"`
+	Logging         LoggingConfig        `yaml:"logging"`
+	RateLimit       RateLimitConfig      `yaml:"rate_limit"`
+	SpamProtection  SpamProtectionConfig `yaml:"spam_protection"`
 }
 
+// UserService.Timeout is the deadline of a single call; failed calls with
+// Unavailable or DeadlineExceeded are retried MaxRetries times. Found users
+// are cached for CacheTTL, missing ones for NegativeCacheTTL. The circuit
+// breaker opens after BreakerFailures consecutive failures for BreakerOpenFor.
 type UserService struct {
+	Address          string
+	Port             int
+	Timeout          time.Duration `yaml:"timeout"`
+	MaxRetries       int           `yaml:"max_retries"`
+	RetryBackoff     time.Duration `yaml:"retry_backoff"`
+	CacheTTL         time.Duration `yaml:"cache_ttl"`
+	NegativeCacheTTL time.Duration `yaml:"negative_cache_ttl"`
+	CacheSize        int           `yaml:"cache_size"`
+	BreakerFailures  int           `yaml:"breaker_failures"`
+	BreakerOpenFor   time.Duration `yaml:"breaker_open_for"`
+}
+
+// RelationService resolves followers for broadcasts. Timeout is the
+// deadline of a single call.
+type RelationService struct {
 	Address string
 	Port    int
+	Timeout time.Duration `yaml:"timeout"`
 }
 
 type Database struct {
@@ -89,6 +360,8 @@ func MustLoad() *Config {
 	viper.SetDefault("kafka.batch_size", 16384)
 	viper.SetDefault("kafka.linger_ms", 5)
 	viper.SetDefault("kafka.relation_topic", "relation-events")
+	viper.SetDefault("kafka.user_topic", "user-events")
+	viper.SetDefault("kafka.notification_topic", "notification-events")
 
 	// Kafka consumer defaults
 	viper.SetDefault("kafka.consumer_group_id", "notification-service")
@@ -97,10 +370,13 @@ func MustLoad() *Config {
 	viper.SetDefault("kafka.auto_commit_interval_ms", 5000)
 	viper.SetDefault("kafka.session_timeout_ms", 10000)
 	viper.SetDefault("kafka.max_poll_interval_ms", 300000)
+	viper.SetDefault("kafka.purge_max_retries", 5)
+	viper.SetDefault("kafka.purge_retry_backoff_ms", 1000)
 
 	// Event Types defaults
 	viper.SetDefault("event_types.follow_created", "follow_created")
 	viper.SetDefault("event_types.follow_deleted", "follow_deleted")
+	viper.SetDefault("event_types.user_deleted", "user_deleted")
 
 	// Database defaults
 	viper.SetDefault("database.username", "postgres")
@@ -113,11 +389,138 @@ func MustLoad() *Config {
 	// User service defaults
 	viper.SetDefault("user_service.address", "user-service")
 	viper.SetDefault("user_service.port", 50051)
+	viper.SetDefault("user_service.timeout", "2s")
+	viper.SetDefault("user_service.max_retries", 2)
+	viper.SetDefault("user_service.retry_backoff", "100ms")
+	viper.SetDefault("user_service.cache_ttl", "5m")
+	viper.SetDefault("user_service.negative_cache_ttl", "1m")
+	viper.SetDefault("user_service.cache_size", 10000)
+	viper.SetDefault("user_service.breaker_failures", 5)
+	viper.SetDefault("user_service.breaker_open_for", "30s")
+
+	viper.SetDefault("relation_service.address", "relation-service")
+	viper.SetDefault("relation_service.port", 50052)
+	viper.SetDefault("relation_service.timeout", "5s")
 
 	// Prometheus defaults
 	viper.SetDefault("prometheus.address", "0.0.0.0")
 	viper.SetDefault("prometheus.port", 9105)
 
+	// Notifications defaults
+	viper.SetDefault("notifications.restore_grace_period", "24h")
+	viper.SetDefault("notifications.actor_lookup_concurrency", 8)
+	viper.SetDefault("notifications.actor_lookup_timeout", "2s")
+
+	// Compaction defaults
+	viper.SetDefault("compaction.enabled", true)
+	viper.SetDefault("compaction.interval", "1h")
+	viper.SetDefault("compaction.retention", "720h")
+	viper.SetDefault("compaction.batch_size", 1000)
+
+	// Scheduler defaults
+	viper.SetDefault("scheduler.enabled", true)
+	viper.SetDefault("scheduler.interval", "5s")
+	viper.SetDefault("scheduler.batch_size", 100)
+
+	// Delivery defaults
+	viper.SetDefault("delivery.enabled", true)
+	viper.SetDefault("delivery.workers", 2)
+	viper.SetDefault("delivery.poll_interval", "2s")
+	viper.SetDefault("delivery.batch_size", 50)
+	viper.SetDefault("delivery.max_attempts", 5)
+	viper.SetDefault("delivery.backoff_base", "30s")
+	viper.SetDefault("delivery.backoff_max", "1h")
+	viper.SetDefault("delivery.lease", "5m")
+	viper.SetDefault("delivery.channels", []string{})
+	viper.SetDefault("delivery.fake_channels", false)
+	viper.SetDefault("delivery.unsubscribe_url", "http://localhost:8080/notifications/unsubscribe")
+	viper.SetDefault("delivery.email.enabled", false)
+	viper.SetDefault("delivery.email.host", "mailhog")
+	viper.SetDefault("delivery.email.port", 1025)
+	viper.SetDefault("delivery.email.from", "no-reply@pinstack.local")
+	viper.SetDefault("delivery.email.from_name", "Pinstack")
+	viper.SetDefault("delivery.email.tls", "none")
+	viper.SetDefault("delivery.email.timeout", "10s")
+	viper.SetDefault("delivery.push.enabled", false)
+	viper.SetDefault("delivery.push.providers", map[string]string{"android": "fcm", "ios": "apns"})
+	viper.SetDefault("delivery.push.deep_link_base", "pinstack://app")
+	viper.SetDefault("delivery.push.timeout", "10s")
+	viper.SetDefault("delivery.push.fcm.endpoint", "https://fcm.googleapis.com")
+
+	// Webhooks defaults
+	viper.SetDefault("webhooks.enabled", false)
+	viper.SetDefault("webhooks.workers", 1)
+	viper.SetDefault("webhooks.poll_interval", "2s")
+	viper.SetDefault("webhooks.batch_size", 50)
+	viper.SetDefault("webhooks.max_attempts", 8)
+	viper.SetDefault("webhooks.backoff_base", "30s")
+	viper.SetDefault("webhooks.backoff_max", "6h")
+	viper.SetDefault("webhooks.lease", "5m")
+	viper.SetDefault("webhooks.timeout", "10s")
+	viper.SetDefault("webhooks.disable_after", 10)
+
+	// Digest defaults
+	viper.SetDefault("digest.enabled", false)
+	viper.SetDefault("digest.interval", "1m")
+	viper.SetDefault("digest.batch_size", 100)
+	viper.SetDefault("digest.send_hour", 8)
+	viper.SetDefault("digest.weekly_day", "monday")
+	viper.SetDefault("digest.max_items", 50)
+	viper.SetDefault("digest.lease", "5m")
+
+	// Broadcast defaults
+	viper.SetDefault("broadcast.enabled", true)
+	viper.SetDefault("broadcast.interval", "5s")
+	viper.SetDefault("broadcast.batch_size", 10)
+	viper.SetDefault("broadcast.chunk_size", 500)
+	viper.SetDefault("broadcast.max_recipients", 100000)
+	viper.SetDefault("broadcast.lease", "5m")
+	viper.SetDefault("broadcast.retry_delay", "1m")
+
+	// Localization defaults
+	viper.SetDefault("localization.default_locale", "en")
+	viper.SetDefault("localization.templates_dir", "")
+	viper.SetDefault("localization.hot_reload", true)
+
+	// User data defaults
+	viper.SetDefault("user_data.purge_batch_size", 1000)
+
+	// Health defaults
+	viper.SetDefault("health.probe_timeout", "2s")
+	viper.SetDefault("health.interval", "10s")
+	viper.SetDefault("health.drain_delay", "5s")
+
+	// Tracing defaults
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.service_name", "notification-service")
+	viper.SetDefault("tracing.otlp_endpoint", "")
+	viper.SetDefault("tracing.insecure", true)
+	viper.SetDefault("tracing.sample_ratio", 0.1)
+
+	// Logging defaults
+	viper.SetDefault("logging.redact_keys", []string{"email", "payload", "password", "secret", "token", "authorization"})
+	viper.SetDefault("logging.max_value_length", 2048)
+	viper.SetDefault("logging.level", "")
+	viper.SetDefault("logging.admin_token", "")
+	viper.SetDefault("logging.sampling.initial", 100)
+	viper.SetDefault("logging.sampling.thereafter", 100)
+	viper.SetDefault("logging.sampling.tick", "1s")
+
+	viper.SetDefault("rate_limit.enabled", true)
+	viper.SetDefault("rate_limit.backend", "memory")
+	viper.SetDefault("rate_limit.redis.address", "redis:6379")
+	viper.SetDefault("rate_limit.redis.password", "")
+	viper.SetDefault("rate_limit.redis.db", 0)
+	viper.SetDefault("rate_limit.redis.key_prefix", "notification:ratelimit:")
+	viper.SetDefault("rate_limit.methods", map[string]any{
+		"SendNotification": map[string]any{"rate": 20, "burst": 50, "by": "user"},
+		"GetUnreadCount":   map[string]any{"rate": 10, "burst": 20, "by": "user"},
+	})
+
+	viper.SetDefault("spam_protection.actor_caps", map[string]any{
+		"follow_created": map[string]any{"max": 1, "window": "24h"},
+	})
+
 	if err := viper.ReadInConfig(); err != nil {
 		log.Printf("Error reading config file: %s", err)
 		os.Exit(1)
@@ -141,6 +544,8 @@ func MustLoad() *Config {
 			BatchSize:             viper.GetInt("kafka.batch_size"),
 			LingerMs:              viper.GetInt("kafka.linger_ms"),
 			RelationTopic:         viper.GetString("kafka.relation_topic"),
+			UserTopic:             viper.GetString("kafka.user_topic"),
+			NotificationTopic:     viper.GetString("kafka.notification_topic"),
 
 			ConsumerGroupID:      viper.GetString("kafka.consumer_group_id"),
 			AutoOffsetReset:      viper.GetString("kafka.auto_offset_reset"),
@@ -148,6 +553,8 @@ func MustLoad() *Config {
 			AutoCommitIntervalMs: viper.GetInt("kafka.auto_commit_interval_ms"),
 			SessionTimeoutMs:     viper.GetInt("kafka.session_timeout_ms"),
 			MaxPollIntervalMs:    viper.GetInt("kafka.max_poll_interval_ms"),
+			PurgeMaxRetries:      viper.GetInt("kafka.purge_max_retries"),
+			PurgeRetryBackoffMs:  viper.GetInt("kafka.purge_retry_backoff_ms"),
 		},
 		Database: Database{
 			Username:       viper.GetString("database.username"),
@@ -158,18 +565,190 @@ func MustLoad() *Config {
 			MigrationsPath: viper.GetString("database.migrations_path"),
 		},
 		UserService: UserService{
-			Address: viper.GetString("user_service.address"),
-			Port:    viper.GetInt("user_service.port"),
+			Address:          viper.GetString("user_service.address"),
+			Port:             viper.GetInt("user_service.port"),
+			Timeout:          viper.GetDuration("user_service.timeout"),
+			MaxRetries:       viper.GetInt("user_service.max_retries"),
+			RetryBackoff:     viper.GetDuration("user_service.retry_backoff"),
+			CacheTTL:         viper.GetDuration("user_service.cache_ttl"),
+			NegativeCacheTTL: viper.GetDuration("user_service.negative_cache_ttl"),
+			CacheSize:        viper.GetInt("user_service.cache_size"),
+			BreakerFailures:  viper.GetInt("user_service.breaker_failures"),
+			BreakerOpenFor:   viper.GetDuration("user_service.breaker_open_for"),
+		},
+		RelationService: RelationService{
+			Address: viper.GetString("relation_service.address"),
+			Port:    viper.GetInt("relation_service.port"),
+			Timeout: viper.GetDuration("relation_service.timeout"),
 		},
 		EventTypes: EventTypesConfig{
 			FollowCreated: viper.GetString("event_types.follow_created"),
 			FollowDeleted: viper.GetString("event_types.follow_deleted"),
+			UserDeleted:   viper.GetString("event_types.user_deleted"),
 		},
 		Prometheus: PrometheusConfig{
 			Address: viper.GetString("prometheus.address"),
 			Port:    viper.GetInt("prometheus.port"),
 		},
+		Notifications: NotificationsConfig{
+			RestoreGracePeriod:     viper.GetDuration("notifications.restore_grace_period"),
+			ActorLookupConcurrency: viper.GetInt("notifications.actor_lookup_concurrency"),
+			ActorLookupTimeout:     viper.GetDuration("notifications.actor_lookup_timeout"),
+			PriorityByType:         viper.GetStringMapString("notifications.priority_by_type"),
+		},
+		Compaction: CompactionConfig{
+			Enabled:   viper.GetBool("compaction.enabled"),
+			Interval:  viper.GetDuration("compaction.interval"),
+			Retention: viper.GetDuration("compaction.retention"),
+			BatchSize: viper.GetInt("compaction.batch_size"),
+		},
+		Scheduler: SchedulerConfig{
+			Enabled:   viper.GetBool("scheduler.enabled"),
+			Interval:  viper.GetDuration("scheduler.interval"),
+			BatchSize: viper.GetInt("scheduler.batch_size"),
+		},
+		Delivery: DeliveryConfig{
+			Enabled:        viper.GetBool("delivery.enabled"),
+			Workers:        viper.GetInt("delivery.workers"),
+			PollInterval:   viper.GetDuration("delivery.poll_interval"),
+			BatchSize:      viper.GetInt("delivery.batch_size"),
+			MaxAttempts:    viper.GetInt("delivery.max_attempts"),
+			BackoffBase:    viper.GetDuration("delivery.backoff_base"),
+			BackoffMax:     viper.GetDuration("delivery.backoff_max"),
+			Lease:          viper.GetDuration("delivery.lease"),
+			Channels:       viper.GetStringSlice("delivery.channels"),
+			ChannelsByType: viper.GetStringMapStringSlice("delivery.channels_by_type"),
+			FakeChannels:   viper.GetBool("delivery.fake_channels"),
+
+			UnsubscribeURL:    viper.GetString("delivery.unsubscribe_url"),
+			UnsubscribeSecret: viper.GetString("delivery.unsubscribe_secret"),
+			Email: EmailConfig{
+				Enabled:      viper.GetBool("delivery.email.enabled"),
+				Host:         viper.GetString("delivery.email.host"),
+				Port:         viper.GetInt("delivery.email.port"),
+				Username:     viper.GetString("delivery.email.username"),
+				Password:     viper.GetString("delivery.email.password"),
+				From:         viper.GetString("delivery.email.from"),
+				FromName:     viper.GetString("delivery.email.from_name"),
+				TLS:          viper.GetString("delivery.email.tls"),
+				Timeout:      viper.GetDuration("delivery.email.timeout"),
+				TemplatesDir: viper.GetString("delivery.email.templates_dir"),
+			},
+			Push: PushConfig{
+				Enabled:      viper.GetBool("delivery.push.enabled"),
+				Providers:    viper.GetStringMapString("delivery.push.providers"),
+				DeepLinkBase: viper.GetString("delivery.push.deep_link_base"),
+				TemplatesDir: viper.GetString("delivery.push.templates_dir"),
+				Timeout:      viper.GetDuration("delivery.push.timeout"),
+				FCM: FCMConfig{
+					ProjectID:       viper.GetString("delivery.push.fcm.project_id"),
+					CredentialsFile: viper.GetString("delivery.push.fcm.credentials_file"),
+					Endpoint:        viper.GetString("delivery.push.fcm.endpoint"),
+				},
+				APNs: APNsConfig{
+					TeamID:     viper.GetString("delivery.push.apns.team_id"),
+					KeyID:      viper.GetString("delivery.push.apns.key_id"),
+					KeyFile:    viper.GetString("delivery.push.apns.key_file"),
+					Topic:      viper.GetString("delivery.push.apns.topic"),
+					Production: viper.GetBool("delivery.push.apns.production"),
+					Endpoint:   viper.GetString("delivery.push.apns.endpoint"),
+				},
+			},
+		},
+		Webhooks: WebhooksConfig{
+			Enabled:      viper.GetBool("webhooks.enabled"),
+			Workers:      viper.GetInt("webhooks.workers"),
+			PollInterval: viper.GetDuration("webhooks.poll_interval"),
+			BatchSize:    viper.GetInt("webhooks.batch_size"),
+			MaxAttempts:  viper.GetInt("webhooks.max_attempts"),
+			BackoffBase:  viper.GetDuration("webhooks.backoff_base"),
+			BackoffMax:   viper.GetDuration("webhooks.backoff_max"),
+			Lease:        viper.GetDuration("webhooks.lease"),
+			Timeout:      viper.GetDuration("webhooks.timeout"),
+			DisableAfter: viper.GetInt("webhooks.disable_after"),
+		},
+		Digest: DigestConfig{
+			Enabled:   viper.GetBool("digest.enabled"),
+			Interval:  viper.GetDuration("digest.interval"),
+			BatchSize: viper.GetInt("digest.batch_size"),
+			SendHour:  viper.GetInt("digest.send_hour"),
+			WeeklyDay: viper.GetString("digest.weekly_day"),
+			MaxItems:  viper.GetInt("digest.max_items"),
+			Lease:     viper.GetDuration("digest.lease"),
+		},
+		Broadcast: BroadcastConfig{
+			Enabled:       viper.GetBool("broadcast.enabled"),
+			Interval:      viper.GetDuration("broadcast.interval"),
+			BatchSize:     viper.GetInt("broadcast.batch_size"),
+			ChunkSize:     viper.GetInt("broadcast.chunk_size"),
+			MaxRecipients: viper.GetInt("broadcast.max_recipients"),
+			Lease:         viper.GetDuration("broadcast.lease"),
+			RetryDelay:    viper.GetDuration("broadcast.retry_delay"),
+		},
+		Localization: LocalizationConfig{
+			DefaultLocale: viper.GetString("localization.default_locale"),
+			TemplatesDir:  viper.GetString("localization.templates_dir"),
+			HotReload:     viper.GetBool("localization.hot_reload"),
+		},
+		UserData: UserDataConfig{
+			PurgeBatchSize: viper.GetInt("user_data.purge_batch_size"),
+		},
+		Health: HealthConfig{
+			ProbeTimeout: viper.GetDuration("health.probe_timeout"),
+			Interval:     viper.GetDuration("health.interval"),
+			DrainDelay:   viper.GetDuration("health.drain_delay"),
+		},
+		Tracing: TracingConfig{
+			Enabled:      viper.GetBool("tracing.enabled"),
+			ServiceName:  viper.GetString("tracing.service_name"),
+			OTLPEndpoint: viper.GetString("tracing.otlp_endpoint"),
+			Insecure:     viper.GetBool("tracing.insecure"),
+			SampleRatio:  viper.GetFloat64("tracing.sample_ratio"),
+		},
+		Logging: LoggingConfig{
+			Level:          viper.GetString("logging.level"),
+			AdminToken:     viper.GetString("logging.admin_token"),
+			RedactKeys:     viper.GetStringSlice("logging.redact_keys"),
+			MaxValueLength: viper.GetInt("logging.max_value_length"),
+			Sampling: LogSamplingConfig{
+				Initial:    viper.GetInt("logging.sampling.initial"),
+				Thereafter: viper.GetInt("logging.sampling.thereafter"),
+				Tick:       viper.GetDuration("logging.sampling.tick"),
+			},
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: viper.GetBool("rate_limit.enabled"),
+			Backend: viper.GetString("rate_limit.backend"),
+			Redis: RedisConfig{
+				Address:   viper.GetString("rate_limit.redis.address"),
+				Password:  viper.GetString("rate_limit.redis.password"),
+				DB:        viper.GetInt("rate_limit.redis.db"),
+				KeyPrefix: viper.GetString("rate_limit.redis.key_prefix"),
+			},
+			Methods: rateLimitRules(),
+		},
+		SpamProtection: SpamProtectionConfig{
+			ActorCaps: actorCaps(),
+		},
 	}
 
 	return config
 }
+
+func rateLimitRules() map[string]RateLimitRule {
+	var rules map[string]RateLimitRule
+	if err := viper.UnmarshalKey("rate_limit.methods", &rules); err != nil {
+		log.Printf("Error reading rate_limit.methods: %s", err)
+		os.Exit(1)
+	}
+	return rules
+}
+
+func actorCaps() map[string]ActorCapConfig {
+	var caps map[string]ActorCapConfig
+	if err := viper.UnmarshalKey("spam_protection.actor_caps", &caps); err != nil {
+		log.Printf("Error reading spam_protection.actor_caps: %s", err)
+		os.Exit(1)
+	}
+	return caps
+}
@@ -0,0 +1,54 @@
+package correlation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+const (
+	// MetadataKey carries the correlation ID in gRPC metadata, both ways.
+	MetadataKey = "x-correlation-id"
+	// RequestIDMetadataKey is accepted from callers that only send a
+	// request ID.
+	RequestIDMetadataKey = "x-request-id"
+	// HeaderKey carries the correlation ID in Kafka message headers.
+	HeaderKey = "correlation_id"
+
+	// maxLength bounds IDs taken from callers so they cannot flood logs.
+	maxLength = 128
+)
+
+type contextKey struct{}
+
+// NewContext returns ctx carrying id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the correlation ID in ctx, or "" if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// NewID generates a random correlation ID.
+func NewID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Ensure returns id when it is usable as a correlation ID and a new one
+// otherwise.
+func Ensure(id string) string {
+	if id == "" || len(id) > maxLength {
+		return NewID()
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return NewID()
+		}
+	}
+	return id
+}
@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/logger"
+)
+
+// LevelsResponse is the body of every /admin/log-level response.
+type LevelsResponse struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components"`
+}
+
+// LogLevelHandler shows and changes the log levels at runtime:
+//
+//	GET    /admin/log-level                                  current levels
+//	PUT    /admin/log-level?level=debug                      global level
+//	PUT    /admin/log-level?level=debug&component=grpc       one component
+//	DELETE /admin/log-level?component=grpc                   drop the override
+//
+// Every request must carry "Authorization: Bearer <token>"; the metrics
+// port it is served on is reachable by anything that can scrape it.
+func LogLevelHandler(levels *logger.Levels, token string, log ports.Logger) http.Handler {
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			log.WarnContext(r.Context(), "Unauthorized log level request",
+				slog.String("method", r.Method),
+				slog.String("remote_addr", r.RemoteAddr))
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		component := r.URL.Query().Get("component")
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			level, err := logger.ParseLevel(r.URL.Query().Get("level"))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "level must be one of debug, info, warn, error")
+				return
+			}
+			levels.Set(component, level)
+			log.WarnContext(r.Context(), "Log level changed",
+				slog.String("component", component),
+				slog.String("level", level.String()))
+		case http.MethodDelete:
+			if component == "" {
+				writeError(w, http.StatusBadRequest, "component is required")
+				return
+			}
+			levels.Reset(component)
+			log.WarnContext(r.Context(), "Log level override removed", slog.String("component", component))
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, levelsResponse(levels))
+	})
+}
+
+func levelsResponse(levels *logger.Levels) LevelsResponse {
+	components := make(map[string]string)
+	for name, level := range levels.Components() {
+		components[name] = level.String()
+	}
+	return LevelsResponse{Level: levels.Global().String(), Components: components}
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, map[string]string{"error": msg})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,120 @@
+package admin
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLevelHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(levels *logger.Levels)
+		method     string
+		query      string
+		auth       string // Authorization header, a valid token when empty
+		anonymous  bool   // send no Authorization header
+		wantCode   int
+		wantLevels LevelsResponse
+	}{
+		{
+			name:       "get returns current levels",
+			setup:      func(levels *logger.Levels) { levels.Set("grpc", slog.LevelWarn) },
+			method:     http.MethodGet,
+			wantCode:   http.StatusOK,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{"grpc": "WARN"}},
+		},
+		{
+			name:       "put changes global level",
+			method:     http.MethodPut,
+			query:      "level=debug",
+			wantCode:   http.StatusOK,
+			wantLevels: LevelsResponse{Level: "DEBUG", Components: map[string]string{}},
+		},
+		{
+			name:       "put changes component level",
+			method:     http.MethodPut,
+			query:      "level=debug&component=kafka_consumer",
+			wantCode:   http.StatusOK,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{"kafka_consumer": "DEBUG"}},
+		},
+		{
+			name:       "put with unknown level",
+			method:     http.MethodPut,
+			query:      "level=verbose",
+			wantCode:   http.StatusBadRequest,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{}},
+		},
+		{
+			name:       "delete removes component override",
+			setup:      func(levels *logger.Levels) { levels.Set("grpc", slog.LevelWarn) },
+			method:     http.MethodDelete,
+			query:      "component=grpc",
+			wantCode:   http.StatusOK,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{}},
+		},
+		{
+			name:       "delete without component",
+			method:     http.MethodDelete,
+			wantCode:   http.StatusBadRequest,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{}},
+		},
+		{
+			name:       "request without token is rejected",
+			method:     http.MethodPut,
+			query:      "level=debug",
+			anonymous:  true,
+			wantCode:   http.StatusUnauthorized,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{}},
+		},
+		{
+			name:       "request with wrong token is rejected",
+			method:     http.MethodGet,
+			auth:       "Bearer wrong",
+			wantCode:   http.StatusUnauthorized,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{}},
+		},
+		{
+			name:       "other methods are rejected",
+			method:     http.MethodPost,
+			query:      "level=debug",
+			wantCode:   http.StatusMethodNotAllowed,
+			wantLevels: LevelsResponse{Level: "INFO", Components: map[string]string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			levels := logger.NewLevels(slog.LevelInfo)
+			if tt.setup != nil {
+				tt.setup(levels)
+			}
+			handler := LogLevelHandler(levels, "secret", logger.New("test"))
+
+			req := httptest.NewRequest(tt.method, "/admin/log-level?"+tt.query, nil)
+			if !tt.anonymous {
+				auth := tt.auth
+				if auth == "" {
+					auth = "Bearer secret"
+				}
+				req.Header.Set("Authorization", auth)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.wantLevels, levelsResponse(levels))
+			if tt.wantCode == http.StatusOK {
+				var body LevelsResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, tt.wantLevels, body)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package notification_grpc
 
 import (
 	"context"
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
 	notification_service "pinstack-notification-service/internal/domain/ports/input"
 	ports "pinstack-notification-service/internal/domain/ports/output"
 
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/notification/v1"
@@ -15,18 +17,45 @@ var validate = validator.New()
 
 type NotificationGRPCService struct {
 	pb.UnimplementedNotificationServiceServer
-	notificationService             notification_service.NotificationService
-	log                             ports.Logger
-	sendNotificationHandler         *SendNotificationHandler
-	getNotificationDetailsHandler   *GetNotificationDetailsHandler
-	getUserNotificationFeedHandler  *GetUserNotificationFeedHandler
-	readNotificationHandler         *ReadNotificationHandler
-	readAllUserNotificationsHandler *ReadAllUserNotificationsHandler
-	removeNotificationHandler       *RemoveNotificationHandler
-	getUnreadCountHandler           *GetUnreadCountHandler
-}
-
-func NewNotificationGRPCService(notificationService notification_service.NotificationService, log ports.Logger) *NotificationGRPCService {
+	extpb.UnimplementedNotificationExtServiceServer
+	notificationService                notification_service.NotificationService
+	log                                ports.Logger
+	sendNotificationHandler            *SendNotificationHandler
+	getNotificationDetailsHandler      *GetNotificationDetailsHandler
+	getUserNotificationFeedHandler     *GetUserNotificationFeedHandler
+	readNotificationHandler            *ReadNotificationHandler
+	readAllUserNotificationsHandler    *ReadAllUserNotificationsHandler
+	removeNotificationHandler          *RemoveNotificationHandler
+	getUnreadCountHandler              *GetUnreadCountHandler
+	readUserNotificationsUpToHandler   *ReadUserNotificationsUpToHandler
+	updateNotificationStateHandler     *UpdateNotificationStateHandler
+	setNotificationPinnedHandler       *SetNotificationPinnedHandler
+	listUserNotificationsHandler       *ListUserNotificationsHandler
+	restoreNotificationHandler         *RestoreNotificationHandler
+	createNotificationHandler          *CreateNotificationHandler
+	sendNotificationsHandler           *SendNotificationsHandler
+	cancelScheduledNotificationHandler *CancelScheduledNotificationHandler
+	setChannelPreferenceHandler        *SetChannelPreferenceHandler
+	listChannelPreferencesHandler      *ListChannelPreferencesHandler
+	unsubscribeHandler                 *UnsubscribeHandler
+	listNotificationDeliveriesHandler  *ListNotificationDeliveriesHandler
+	createWebhookSubscriptionHandler   *CreateWebhookSubscriptionHandler
+	listWebhookSubscriptionsHandler    *ListWebhookSubscriptionsHandler
+	deleteWebhookSubscriptionHandler   *DeleteWebhookSubscriptionHandler
+	enableWebhookSubscriptionHandler   *EnableWebhookSubscriptionHandler
+	listWebhookAttemptsHandler         *ListWebhookAttemptsHandler
+	registerDeviceTokenHandler         *RegisterDeviceTokenHandler
+	unregisterDeviceTokenHandler       *UnregisterDeviceTokenHandler
+	listDeviceTokensHandler            *ListDeviceTokensHandler
+	setDigestFrequencyHandler          *SetDigestFrequencyHandler
+	getDigestSettingsHandler           *GetDigestSettingsHandler
+	getNotificationHandler             *GetNotificationHandler
+	exportUserDataHandler              *ExportUserDataHandler
+	createBroadcastHandler             *CreateBroadcastHandler
+	getBroadcastHandler                *GetBroadcastHandler
+}
+
+func NewNotificationGRPCService(notificationService notification_service.NotificationService, deliveryService notification_service.DeliveryService, webhookService notification_service.WebhookService, deviceService notification_service.DeviceService, digestService notification_service.DigestService, userDataService notification_service.UserDataService, broadcastService notification_service.BroadcastService, log ports.Logger) *NotificationGRPCService {
 	service := &NotificationGRPCService{
 		notificationService: notificationService,
 		log:                 log,
@@ -39,6 +68,32 @@ func NewNotificationGRPCService(notificationService notification_service.Notific
 	service.readAllUserNotificationsHandler = NewReadAllUserNotificationsHandler(notificationService, log)
 	service.removeNotificationHandler = NewRemoveNotificationHandler(notificationService, log)
 	service.getUnreadCountHandler = NewGetUnreadCountHandler(notificationService, log)
+	service.readUserNotificationsUpToHandler = NewReadUserNotificationsUpToHandler(notificationService, log)
+	service.updateNotificationStateHandler = NewUpdateNotificationStateHandler(notificationService, log)
+	service.setNotificationPinnedHandler = NewSetNotificationPinnedHandler(notificationService, log)
+	service.listUserNotificationsHandler = NewListUserNotificationsHandler(notificationService, log)
+	service.restoreNotificationHandler = NewRestoreNotificationHandler(notificationService, log)
+	service.createNotificationHandler = NewCreateNotificationHandler(notificationService, log)
+	service.sendNotificationsHandler = NewSendNotificationsHandler(notificationService, log)
+	service.cancelScheduledNotificationHandler = NewCancelScheduledNotificationHandler(notificationService, log)
+	service.setChannelPreferenceHandler = NewSetChannelPreferenceHandler(deliveryService, log)
+	service.listChannelPreferencesHandler = NewListChannelPreferencesHandler(deliveryService, log)
+	service.unsubscribeHandler = NewUnsubscribeHandler(deliveryService, log)
+	service.listNotificationDeliveriesHandler = NewListNotificationDeliveriesHandler(deliveryService, log)
+	service.createWebhookSubscriptionHandler = NewCreateWebhookSubscriptionHandler(webhookService, log)
+	service.listWebhookSubscriptionsHandler = NewListWebhookSubscriptionsHandler(webhookService, log)
+	service.deleteWebhookSubscriptionHandler = NewDeleteWebhookSubscriptionHandler(webhookService, log)
+	service.enableWebhookSubscriptionHandler = NewEnableWebhookSubscriptionHandler(webhookService, log)
+	service.listWebhookAttemptsHandler = NewListWebhookAttemptsHandler(webhookService, log)
+	service.registerDeviceTokenHandler = NewRegisterDeviceTokenHandler(deviceService, log)
+	service.unregisterDeviceTokenHandler = NewUnregisterDeviceTokenHandler(deviceService, log)
+	service.listDeviceTokensHandler = NewListDeviceTokensHandler(deviceService, log)
+	service.setDigestFrequencyHandler = NewSetDigestFrequencyHandler(digestService, log)
+	service.getDigestSettingsHandler = NewGetDigestSettingsHandler(digestService, log)
+	service.getNotificationHandler = NewGetNotificationHandler(notificationService, log)
+	service.exportUserDataHandler = NewExportUserDataHandler(userDataService, log)
+	service.createBroadcastHandler = NewCreateBroadcastHandler(broadcastService, log)
+	service.getBroadcastHandler = NewGetBroadcastHandler(broadcastService, log)
 
 	return service
 }
@@ -70,3 +125,107 @@ func (s *NotificationGRPCService) RemoveNotification(ctx context.Context, req *p
 func (s *NotificationGRPCService) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
 	return s.getUnreadCountHandler.Handle(ctx, req)
 }
+
+func (s *NotificationGRPCService) ReadUserNotificationsUpTo(ctx context.Context, req *extpb.ReadUserNotificationsUpToRequest) (*extpb.ReadUserNotificationsUpToResponse, error) {
+	return s.readUserNotificationsUpToHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) UpdateNotificationState(ctx context.Context, req *extpb.UpdateNotificationStateRequest) (*emptypb.Empty, error) {
+	return s.updateNotificationStateHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) SetNotificationPinned(ctx context.Context, req *extpb.SetNotificationPinnedRequest) (*emptypb.Empty, error) {
+	return s.setNotificationPinnedHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) ListUserNotifications(ctx context.Context, req *extpb.ListUserNotificationsRequest) (*extpb.ListUserNotificationsResponse, error) {
+	return s.listUserNotificationsHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) RestoreNotification(ctx context.Context, req *extpb.RestoreNotificationRequest) (*emptypb.Empty, error) {
+	return s.restoreNotificationHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) CreateNotification(ctx context.Context, req *extpb.CreateNotificationRequest) (*extpb.CreateNotificationResponse, error) {
+	return s.createNotificationHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) SendNotifications(ctx context.Context, req *extpb.SendNotificationsRequest) (*extpb.SendNotificationsResponse, error) {
+	return s.sendNotificationsHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) CancelScheduledNotification(ctx context.Context, req *extpb.CancelScheduledNotificationRequest) (*emptypb.Empty, error) {
+	return s.cancelScheduledNotificationHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) SetChannelPreference(ctx context.Context, req *extpb.SetChannelPreferenceRequest) (*emptypb.Empty, error) {
+	return s.setChannelPreferenceHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) ListChannelPreferences(ctx context.Context, req *extpb.ListChannelPreferencesRequest) (*extpb.ListChannelPreferencesResponse, error) {
+	return s.listChannelPreferencesHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) Unsubscribe(ctx context.Context, req *extpb.UnsubscribeRequest) (*emptypb.Empty, error) {
+	return s.unsubscribeHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) ListNotificationDeliveries(ctx context.Context, req *extpb.ListNotificationDeliveriesRequest) (*extpb.ListNotificationDeliveriesResponse, error) {
+	return s.listNotificationDeliveriesHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) CreateWebhookSubscription(ctx context.Context, req *extpb.CreateWebhookSubscriptionRequest) (*extpb.CreateWebhookSubscriptionResponse, error) {
+	return s.createWebhookSubscriptionHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) ListWebhookSubscriptions(ctx context.Context, req *extpb.ListWebhookSubscriptionsRequest) (*extpb.ListWebhookSubscriptionsResponse, error) {
+	return s.listWebhookSubscriptionsHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) DeleteWebhookSubscription(ctx context.Context, req *extpb.DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	return s.deleteWebhookSubscriptionHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) EnableWebhookSubscription(ctx context.Context, req *extpb.EnableWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	return s.enableWebhookSubscriptionHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) ListWebhookAttempts(ctx context.Context, req *extpb.ListWebhookAttemptsRequest) (*extpb.ListWebhookAttemptsResponse, error) {
+	return s.listWebhookAttemptsHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) RegisterDeviceToken(ctx context.Context, req *extpb.RegisterDeviceTokenRequest) (*emptypb.Empty, error) {
+	return s.registerDeviceTokenHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) UnregisterDeviceToken(ctx context.Context, req *extpb.UnregisterDeviceTokenRequest) (*emptypb.Empty, error) {
+	return s.unregisterDeviceTokenHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) ListDeviceTokens(ctx context.Context, req *extpb.ListDeviceTokensRequest) (*extpb.ListDeviceTokensResponse, error) {
+	return s.listDeviceTokensHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) SetDigestFrequency(ctx context.Context, req *extpb.SetDigestFrequencyRequest) (*emptypb.Empty, error) {
+	return s.setDigestFrequencyHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) GetDigestSettings(ctx context.Context, req *extpb.GetDigestSettingsRequest) (*extpb.DigestSettings, error) {
+	return s.getDigestSettingsHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) GetNotification(ctx context.Context, req *extpb.GetNotificationRequest) (*extpb.Notification, error) {
+	return s.getNotificationHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) ExportUserData(req *extpb.ExportUserDataRequest, stream grpc.ServerStreamingServer[extpb.ExportUserDataChunk]) error {
+	return s.exportUserDataHandler.Handle(req, stream)
+}
+
+func (s *NotificationGRPCService) CreateBroadcast(ctx context.Context, req *extpb.CreateBroadcastRequest) (*extpb.CreateBroadcastResponse, error) {
+	return s.createBroadcastHandler.Handle(ctx, req)
+}
+
+func (s *NotificationGRPCService) GetBroadcast(ctx context.Context, req *extpb.GetBroadcastRequest) (*extpb.Broadcast, error) {
+	return s.getBroadcastHandler.Handle(ctx, req)
+}
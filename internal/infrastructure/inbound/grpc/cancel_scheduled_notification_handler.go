@@ -0,0 +1,85 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type ScheduledNotificationCanceller interface {
+	CancelScheduledNotification(ctx context.Context, id int64) error
+}
+
+type CancelScheduledNotificationHandler struct {
+	notificationService ScheduledNotificationCanceller
+	log                 ports.Logger
+}
+
+func NewCancelScheduledNotificationHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *CancelScheduledNotificationHandler {
+	return &CancelScheduledNotificationHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type CancelScheduledNotificationRequestInternal struct {
+	NotificationID int64 `validate:"required,gt=0"`
+}
+
+func (h *CancelScheduledNotificationHandler) Handle(ctx context.Context, req *extpb.CancelScheduledNotificationRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing cancel scheduled notification request", slog.Int64("notification_id", req.GetNotificationId()))
+
+	validationReq := &CancelScheduledNotificationRequestInternal{
+		NotificationID: req.GetNotificationId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for cancel scheduled notification request",
+			slog.Int64("notification_id", req.GetNotificationId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.notificationService.CancelScheduledNotification(ctx, req.GetNotificationId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for cancel scheduled notification",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrNotificationNotFound):
+			h.log.ErrorContext(ctx, "Notification not found for cancel request",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
+		case errors.Is(err, custom_errors.ErrOperationNotAllowed):
+			h.log.ErrorContext(ctx, "Notification already delivered, cannot cancel",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.FailedPrecondition, custom_errors.ErrOperationNotAllowed.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while cancelling scheduled notification",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully cancelled scheduled notification", slog.Int64("notification_id", req.GetNotificationId()))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,99 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	broadcast_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type BroadcastCreator interface {
+	CreateBroadcast(ctx context.Context, broadcast *model.Broadcast) (int64, error)
+}
+
+type CreateBroadcastHandler struct {
+	broadcastService BroadcastCreator
+	log              ports.Logger
+}
+
+func NewCreateBroadcastHandler(
+	broadcastService broadcast_service.BroadcastService,
+	log ports.Logger,
+) *CreateBroadcastHandler {
+	return &CreateBroadcastHandler{
+		broadcastService: broadcastService,
+		log:              log,
+	}
+}
+
+type CreateBroadcastRequestInternal struct {
+	Type        string  `validate:"required"`
+	Payload     []byte  `validate:"required"`
+	Priority    string  `validate:"omitempty,oneof=low normal high critical"`
+	UserIDs     []int64 `validate:"required_without=FollowersOf,excluded_with=FollowersOf,dive,gt=0"`
+	FollowersOf int64   `validate:"required_without=UserIDs,gte=0"`
+}
+
+func (h *CreateBroadcastHandler) Handle(ctx context.Context, req *extpb.CreateBroadcastRequest) (*extpb.CreateBroadcastResponse, error) {
+	h.log.InfoContext(ctx, "Processing create broadcast request",
+		slog.String("type", req.GetType()),
+		slog.Int("user_ids", len(req.GetUserIds())),
+		slog.Int64("followers_of", req.GetFollowersOf()),
+		slog.Int("payload_size", len(req.GetPayload())))
+
+	validationReq := &CreateBroadcastRequestInternal{
+		Type:        req.GetType(),
+		Payload:     req.GetPayload(),
+		Priority:    req.GetPriority(),
+		UserIDs:     req.GetUserIds(),
+		FollowersOf: req.GetFollowersOf(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for create broadcast request",
+			slog.String("type", req.GetType()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	broadcast := &model.Broadcast{
+		Type:        events.EventType(req.GetType()),
+		Payload:     req.GetPayload(),
+		Priority:    model.NotificationPriority(req.GetPriority()),
+		UserIDs:     req.GetUserIds(),
+		FollowersOf: req.GetFollowersOf(),
+	}
+
+	broadcastID, err := h.broadcastService.CreateBroadcast(ctx, broadcast)
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for create broadcast",
+				slog.String("type", req.GetType()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while creating broadcast",
+				slog.String("type", req.GetType()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully created broadcast",
+		slog.Int64("broadcast_id", broadcastID),
+		slog.String("type", req.GetType()))
+
+	return &extpb.CreateBroadcastResponse{BroadcastId: broadcastID}, nil
+}
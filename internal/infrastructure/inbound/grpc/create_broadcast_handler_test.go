@@ -0,0 +1,122 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateBroadcastHandler_Handle(t *testing.T) {
+	payload := []byte(`{"title":"Maintenance tonight"}`)
+
+	tests := []struct {
+		name           string
+		req            *extpb.CreateBroadcastRequest
+		mockSetup      func(*mocks.BroadcastService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "explicit users",
+			req:  &extpb.CreateBroadcastRequest{Type: "announcement", Payload: payload, UserIds: []int64{1, 2}, Priority: "high"},
+			mockSetup: func(mockService *mocks.BroadcastService) {
+				mockService.On("CreateBroadcast", mock.Anything, mock.MatchedBy(func(b *model.Broadcast) bool {
+					return b.Type == "announcement" && len(b.UserIDs) == 2 && b.Priority == model.NotificationPriorityHigh
+				})).Return(int64(7), nil)
+			},
+		},
+		{
+			name: "followers of a user",
+			req:  &extpb.CreateBroadcastRequest{Type: "new_pin", Payload: payload, FollowersOf: 42},
+			mockSetup: func(mockService *mocks.BroadcastService) {
+				mockService.On("CreateBroadcast", mock.Anything, mock.MatchedBy(func(b *model.Broadcast) bool {
+					return b.FollowersOf == 42 && len(b.UserIDs) == 0
+				})).Return(int64(7), nil)
+			},
+		},
+		{
+			name:           "validation error - no audience",
+			req:            &extpb.CreateBroadcastRequest{Type: "announcement", Payload: payload},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - both audiences",
+			req:            &extpb.CreateBroadcastRequest{Type: "announcement", Payload: payload, UserIds: []int64{1}, FollowersOf: 42},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - unknown priority",
+			req:            &extpb.CreateBroadcastRequest{Type: "announcement", Payload: payload, UserIds: []int64{1}, Priority: "urgent"},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "service rejects input",
+			req:  &extpb.CreateBroadcastRequest{Type: "announcement", Payload: payload, UserIds: []int64{1}},
+			mockSetup: func(mockService *mocks.BroadcastService) {
+				mockService.On("CreateBroadcast", mock.Anything, mock.Anything).Return(int64(0), custom_errors.ErrInvalidInput)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrInvalidInput.Error(),
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.CreateBroadcastRequest{Type: "announcement", Payload: payload, UserIds: []int64{1}},
+			mockSetup: func(mockService *mocks.BroadcastService) {
+				mockService.On("CreateBroadcast", mock.Anything, mock.Anything).Return(int64(0), errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewBroadcastService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewCreateBroadcastHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int64(7), resp.GetBroadcastId())
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
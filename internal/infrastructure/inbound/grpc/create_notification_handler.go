@@ -0,0 +1,138 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type CreateNotificationHandler struct {
+	notificationService NotificationSender
+	log                 ports.Logger
+}
+
+func NewCreateNotificationHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *CreateNotificationHandler {
+	return &CreateNotificationHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type CreateNotificationRequestInternal struct {
+	UserID   int64  `validate:"required,gt=0"`
+	Type     string `validate:"required"`
+	Payload  []byte `validate:"required"`
+	Priority string `validate:"omitempty,oneof=low normal high critical"`
+}
+
+func (h *CreateNotificationHandler) Handle(ctx context.Context, req *extpb.CreateNotificationRequest) (*extpb.CreateNotificationResponse, error) {
+	h.log.InfoContext(ctx, "Processing create notification request",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.String("type", req.GetType()),
+		slog.String("priority", req.GetPriority()),
+		slog.Int("payload_size", len(req.GetPayload())),
+		slog.Bool("has_deliver_at", req.GetDeliverAt() != nil),
+		slog.Bool("has_expires_at", req.GetExpiresAt() != nil))
+
+	validationReq := &CreateNotificationRequestInternal{
+		UserID:   req.GetUserId(),
+		Type:     req.GetType(),
+		Payload:  req.GetPayload(),
+		Priority: req.GetPriority(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for create notification request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("type", req.GetType()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	notification := &model.Notification{
+		UserID:   req.GetUserId(),
+		Type:     events.EventType(req.GetType()),
+		Payload:  req.GetPayload(),
+		Priority: model.NotificationPriority(req.GetPriority()),
+	}
+
+	if req.GetDeliverAt() != nil {
+		if err := req.GetDeliverAt().CheckValid(); err != nil {
+			h.log.ErrorContext(ctx, "Invalid deliver_at in create notification request",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+		}
+		deliverAt := req.GetDeliverAt().AsTime()
+		notification.DeliverAt = &deliverAt
+	}
+
+	if req.GetExpiresAt() != nil {
+		if err := req.GetExpiresAt().CheckValid(); err != nil {
+			h.log.ErrorContext(ctx, "Invalid expires_at in create notification request",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+		}
+		expiresAt := req.GetExpiresAt().AsTime()
+		notification.ExpiresAt = &expiresAt
+	}
+
+	notificationID, err := h.notificationService.SaveNotification(ctx, notification)
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for create notification",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("type", req.GetType()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrUserNotFound):
+			h.log.ErrorContext(ctx, "User not found when creating notification",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("type", req.GetType()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
+		case errors.Is(err, model.ErrNotificationSuppressed):
+			h.log.InfoContext(ctx, "Notification suppressed by spam protection",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("type", req.GetType()))
+			return nil, status.Error(codes.ResourceExhausted, model.ErrNotificationSuppressed.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while creating notification",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("type", req.GetType()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	scheduled := notification.DeliverAt != nil
+	h.log.InfoContext(ctx, "Successfully created notification",
+		slog.Int64("notification_id", notificationID),
+		slog.Int64("user_id", notification.UserID),
+		slog.String("type", string(notification.Type)),
+		slog.String("priority", string(notification.Priority)),
+		slog.Bool("scheduled", scheduled))
+
+	return &extpb.CreateNotificationResponse{
+		NotificationId: notificationID,
+		Scheduled:      scheduled,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateNotificationHandler_Handle(t *testing.T) {
+	payload, _ := json.Marshal(map[string]interface{}{"key": "value"})
+	deliverAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		req               *extpb.CreateNotificationRequest
+		mockSetup         func(*mocks.NotificationService)
+		wantErr           bool
+		expectedCode      codes.Code
+		expectedErrMsg    string
+		expectedID        int64
+		expectedScheduled bool
+	}{
+		{
+			name: "immediate notification",
+			req: &extpb.CreateNotificationRequest{
+				UserId:  1,
+				Type:    "test_notification",
+				Payload: payload,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.UserID == 1 && n.DeliverAt == nil
+				})).Return(int64(100), nil)
+			},
+			wantErr:           false,
+			expectedID:        100,
+			expectedScheduled: false,
+		},
+		{
+			name: "scheduled notification",
+			req: &extpb.CreateNotificationRequest{
+				UserId:    1,
+				Type:      "test_notification",
+				Payload:   payload,
+				DeliverAt: timestamppb.New(deliverAt),
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.DeliverAt != nil && n.DeliverAt.Equal(deliverAt)
+				})).Return(int64(101), nil)
+			},
+			wantErr:           false,
+			expectedID:        101,
+			expectedScheduled: true,
+		},
+		{
+			name: "notification with expiry",
+			req: &extpb.CreateNotificationRequest{
+				UserId:    1,
+				Type:      "live_now",
+				Payload:   payload,
+				ExpiresAt: timestamppb.New(deliverAt),
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.ExpiresAt != nil && n.ExpiresAt.Equal(deliverAt) && n.DeliverAt == nil
+				})).Return(int64(102), nil)
+			},
+			wantErr:           false,
+			expectedID:        102,
+			expectedScheduled: false,
+		},
+		{
+			name: "service rejects expiry before delivery",
+			req: &extpb.CreateNotificationRequest{
+				UserId:    1,
+				Type:      "live_now",
+				Payload:   payload,
+				DeliverAt: timestamppb.New(deliverAt),
+				ExpiresAt: timestamppb.New(deliverAt.Add(-time.Hour)),
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.Anything).Return(int64(0), custom_errors.ErrInvalidInput)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrInvalidInput.Error(),
+		},
+		{
+			name: "notification with priority",
+			req: &extpb.CreateNotificationRequest{
+				UserId:   1,
+				Type:     "security_alert",
+				Payload:  payload,
+				Priority: "critical",
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.Priority == model.NotificationPriorityCritical
+				})).Return(int64(103), nil)
+			},
+			wantErr:           false,
+			expectedID:        103,
+			expectedScheduled: false,
+		},
+		{
+			name: "validation error - unknown priority",
+			req: &extpb.CreateNotificationRequest{
+				UserId:   1,
+				Type:     "test_notification",
+				Payload:  payload,
+				Priority: "urgent",
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - empty type",
+			req: &extpb.CreateNotificationRequest{
+				UserId:  1,
+				Payload: payload,
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - invalid deliver_at",
+			req: &extpb.CreateNotificationRequest{
+				UserId:    1,
+				Type:      "test_notification",
+				Payload:   payload,
+				DeliverAt: &timestamppb.Timestamp{Seconds: 1, Nanos: -1},
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "user not found",
+			req: &extpb.CreateNotificationRequest{
+				UserId:  404,
+				Type:    "test_notification",
+				Payload: payload,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.Anything).Return(int64(0), custom_errors.ErrUserNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrUserNotFound.Error(),
+		},
+		{
+			name: "internal service error",
+			req: &extpb.CreateNotificationRequest{
+				UserId:  1,
+				Type:    "test_notification",
+				Payload: payload,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.Anything).Return(int64(0), errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewNotificationService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewCreateNotificationHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				assert.Equal(t, tt.expectedID, resp.NotificationId)
+				assert.Equal(t, tt.expectedScheduled, resp.Scheduled)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
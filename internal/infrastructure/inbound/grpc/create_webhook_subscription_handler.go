@@ -0,0 +1,99 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	model "pinstack-notification-service/internal/domain/models"
+	webhook_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type WebhookSubscriptionCreator interface {
+	CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) (*model.WebhookSubscription, error)
+}
+
+type CreateWebhookSubscriptionHandler struct {
+	webhookService WebhookSubscriptionCreator
+	log            ports.Logger
+}
+
+func NewCreateWebhookSubscriptionHandler(
+	webhookService webhook_service.WebhookService,
+	log ports.Logger,
+) *CreateWebhookSubscriptionHandler {
+	return &CreateWebhookSubscriptionHandler{
+		webhookService: webhookService,
+		log:            log,
+	}
+}
+
+type CreateWebhookSubscriptionRequestInternal struct {
+	Owner  string   `validate:"required"`
+	URL    string   `validate:"required,url"`
+	Events []string `validate:"dive,oneof=notification.created notification.read notifications.read_all"`
+}
+
+func (h *CreateWebhookSubscriptionHandler) Handle(ctx context.Context, req *extpb.CreateWebhookSubscriptionRequest) (*extpb.CreateWebhookSubscriptionResponse, error) {
+	h.log.InfoContext(ctx, "Processing create webhook subscription request", slog.String("owner", req.GetOwner()))
+
+	validationReq := &CreateWebhookSubscriptionRequestInternal{
+		Owner:  req.GetOwner(),
+		URL:    req.GetUrl(),
+		Events: req.GetEvents(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for create webhook subscription request",
+			slog.String("owner", req.GetOwner()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	subscription := &model.WebhookSubscription{
+		Owner:             req.GetOwner(),
+		URL:               req.GetUrl(),
+		Secret:            req.GetSecret(),
+		Events:            make([]model.WebhookEvent, 0, len(req.GetEvents())),
+		NotificationTypes: make([]events.EventType, 0, len(req.GetNotificationTypes())),
+	}
+	for _, e := range req.GetEvents() {
+		subscription.Events = append(subscription.Events, model.WebhookEvent(e))
+	}
+	for _, t := range req.GetNotificationTypes() {
+		subscription.NotificationTypes = append(subscription.NotificationTypes, events.EventType(t))
+	}
+
+	created, err := h.webhookService.CreateSubscription(ctx, subscription)
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for create webhook subscription",
+				slog.String("owner", req.GetOwner()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while creating webhook subscription",
+				slog.String("owner", req.GetOwner()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	resp := webhookSubscriptionToExtProto(created)
+	resp.Secret = created.Secret
+
+	h.log.InfoContext(ctx, "Successfully created webhook subscription",
+		slog.Int64("subscription_id", created.ID),
+		slog.String("owner", created.Owner))
+	return &extpb.CreateWebhookSubscriptionResponse{Subscription: resp}, nil
+}
@@ -0,0 +1,150 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateWebhookSubscriptionHandler_Handle(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	validReq := &extpb.CreateWebhookSubscriptionRequest{
+		Owner:             "partner-crm",
+		Url:               "https://partner.example.com/hooks",
+		Events:            []string{"notification.created"},
+		NotificationTypes: []string{"follow_created"},
+	}
+
+	tests := []struct {
+		name           string
+		req            *extpb.CreateWebhookSubscriptionRequest
+		mockSetup      func(*mocks.WebhookService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		checkResp      func(*testing.T, *extpb.CreateWebhookSubscriptionResponse)
+	}{
+		{
+			name: "successful create returns the secret once",
+			req:  validReq,
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("CreateSubscription", mock.Anything, mock.MatchedBy(func(s *model.WebhookSubscription) bool {
+					return s.Owner == "partner-crm" &&
+						s.URL == "https://partner.example.com/hooks" &&
+						len(s.Events) == 1 && s.Events[0] == model.WebhookEventNotificationCreated &&
+						len(s.NotificationTypes) == 1 && s.NotificationTypes[0] == events.EventTypeFollowCreated
+				})).Return(&model.WebhookSubscription{
+					ID:                7,
+					Owner:             "partner-crm",
+					URL:               "https://partner.example.com/hooks",
+					Secret:            "generated-secret",
+					Events:            []model.WebhookEvent{model.WebhookEventNotificationCreated},
+					NotificationTypes: []events.EventType{events.EventTypeFollowCreated},
+					Enabled:           true,
+					CreatedAt:         createdAt,
+				}, nil)
+			},
+			checkResp: func(t *testing.T, resp *extpb.CreateWebhookSubscriptionResponse) {
+				assert.Equal(t, int64(7), resp.GetSubscription().GetId())
+				assert.Equal(t, "generated-secret", resp.GetSubscription().GetSecret())
+				assert.True(t, resp.GetSubscription().GetEnabled())
+				assert.Equal(t, []string{"notification.created"}, resp.GetSubscription().GetEvents())
+			},
+		},
+		{
+			name:           "validation error - missing owner",
+			req:            &extpb.CreateWebhookSubscriptionRequest{Url: "https://partner.example.com/hooks"},
+			mockSetup:      func(mockService *mocks.WebhookService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - bad url",
+			req:            &extpb.CreateWebhookSubscriptionRequest{Owner: "partner-crm", Url: "not a url"},
+			mockSetup:      func(mockService *mocks.WebhookService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - unknown event",
+			req: &extpb.CreateWebhookSubscriptionRequest{
+				Owner:  "partner-crm",
+				Url:    "https://partner.example.com/hooks",
+				Events: []string{"notification.deleted"},
+			},
+			mockSetup:      func(mockService *mocks.WebhookService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "invalid input from service",
+			req:  &extpb.CreateWebhookSubscriptionRequest{Owner: "partner-crm", Url: "ftp://partner.example.com"},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("CreateSubscription", mock.Anything, mock.Anything).Return(nil, custom_errors.ErrInvalidInput)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "invalid input",
+		},
+		{
+			name: "internal service error",
+			req:  validReq,
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("CreateSubscription", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewWebhookService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewCreateWebhookSubscriptionHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				if tt.checkResp != nil {
+					tt.checkResp(t, resp)
+				}
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	webhook_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type WebhookSubscriptionDeleter interface {
+	DeleteSubscription(ctx context.Context, id int64) error
+}
+
+type DeleteWebhookSubscriptionHandler struct {
+	webhookService WebhookSubscriptionDeleter
+	log            ports.Logger
+}
+
+func NewDeleteWebhookSubscriptionHandler(
+	webhookService webhook_service.WebhookService,
+	log ports.Logger,
+) *DeleteWebhookSubscriptionHandler {
+	return &DeleteWebhookSubscriptionHandler{
+		webhookService: webhookService,
+		log:            log,
+	}
+}
+
+type DeleteWebhookSubscriptionRequestInternal struct {
+	SubscriptionID int64 `validate:"required,gt=0"`
+}
+
+func (h *DeleteWebhookSubscriptionHandler) Handle(ctx context.Context, req *extpb.DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing delete webhook subscription request", slog.Int64("subscription_id", req.GetSubscriptionId()))
+
+	validationReq := &DeleteWebhookSubscriptionRequestInternal{
+		SubscriptionID: req.GetSubscriptionId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for delete webhook subscription request",
+			slog.Int64("subscription_id", req.GetSubscriptionId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.webhookService.DeleteSubscription(ctx, req.GetSubscriptionId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for delete webhook subscription",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, model.ErrWebhookSubscriptionNotFound):
+			h.log.ErrorContext(ctx, "Webhook subscription not found",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, model.ErrWebhookSubscriptionNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while deleting webhook subscription",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully deleted webhook subscription", slog.Int64("subscription_id", req.GetSubscriptionId()))
+	return &emptypb.Empty{}, nil
+}
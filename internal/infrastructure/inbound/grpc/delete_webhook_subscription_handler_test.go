@@ -0,0 +1,97 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteWebhookSubscriptionHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.DeleteWebhookSubscriptionRequest
+		mockSetup      func(*mocks.WebhookService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful delete",
+			req:  &extpb.DeleteWebhookSubscriptionRequest{SubscriptionId: 7},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("DeleteSubscription", mock.Anything, int64(7)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:           "validation error - zero subscription ID",
+			req:            &extpb.DeleteWebhookSubscriptionRequest{SubscriptionId: 0},
+			mockSetup:      func(mockService *mocks.WebhookService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "subscription not found",
+			req:  &extpb.DeleteWebhookSubscriptionRequest{SubscriptionId: 404},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("DeleteSubscription", mock.Anything, int64(404)).Return(model.ErrWebhookSubscriptionNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: model.ErrWebhookSubscriptionNotFound.Error(),
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.DeleteWebhookSubscriptionRequest{SubscriptionId: 7},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("DeleteSubscription", mock.Anything, int64(7)).Return(errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewWebhookService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewDeleteWebhookSubscriptionHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
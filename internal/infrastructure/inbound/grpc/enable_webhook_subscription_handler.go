@@ -0,0 +1,81 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	webhook_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type WebhookSubscriptionEnabler interface {
+	EnableSubscription(ctx context.Context, id int64) error
+}
+
+type EnableWebhookSubscriptionHandler struct {
+	webhookService WebhookSubscriptionEnabler
+	log            ports.Logger
+}
+
+func NewEnableWebhookSubscriptionHandler(
+	webhookService webhook_service.WebhookService,
+	log ports.Logger,
+) *EnableWebhookSubscriptionHandler {
+	return &EnableWebhookSubscriptionHandler{
+		webhookService: webhookService,
+		log:            log,
+	}
+}
+
+type EnableWebhookSubscriptionRequestInternal struct {
+	SubscriptionID int64 `validate:"required,gt=0"`
+}
+
+func (h *EnableWebhookSubscriptionHandler) Handle(ctx context.Context, req *extpb.EnableWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing enable webhook subscription request", slog.Int64("subscription_id", req.GetSubscriptionId()))
+
+	validationReq := &EnableWebhookSubscriptionRequestInternal{
+		SubscriptionID: req.GetSubscriptionId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for enable webhook subscription request",
+			slog.Int64("subscription_id", req.GetSubscriptionId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.webhookService.EnableSubscription(ctx, req.GetSubscriptionId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for enable webhook subscription",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, model.ErrWebhookSubscriptionNotFound):
+			h.log.ErrorContext(ctx, "Webhook subscription not found",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, model.ErrWebhookSubscriptionNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while enabling webhook subscription",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully enabled webhook subscription", slog.Int64("subscription_id", req.GetSubscriptionId()))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,136 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	model "pinstack-notification-service/internal/domain/models"
+	userdata_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+// exportChunkSize is the size of the data sent in one ExportUserDataChunk.
+const exportChunkSize = 64 << 10
+
+type UserDataExporter interface {
+	ExportUserData(ctx context.Context, w io.Writer, userID int64, format model.ExportFormat, cursor string, pageSize int) (string, error)
+}
+
+type ExportUserDataHandler struct {
+	userDataService UserDataExporter
+	log             ports.Logger
+}
+
+func NewExportUserDataHandler(
+	userDataService userdata_service.UserDataService,
+	log ports.Logger,
+) *ExportUserDataHandler {
+	return &ExportUserDataHandler{
+		userDataService: userDataService,
+		log:             log,
+	}
+}
+
+type ExportUserDataRequestInternal struct {
+	UserID   int64  `validate:"required,gt=0"`
+	Format   string `validate:"omitempty,oneof=ndjson json"`
+	PageSize int32  `validate:"gte=0,lte=10000"`
+}
+
+func (h *ExportUserDataHandler) Handle(req *extpb.ExportUserDataRequest, stream grpc.ServerStreamingServer[extpb.ExportUserDataChunk]) error {
+	ctx := stream.Context()
+
+	h.log.InfoContext(ctx, "Processing export user data request",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.String("format", req.GetFormat()),
+		slog.Int("page_size", int(req.GetPageSize())))
+
+	validationReq := &ExportUserDataRequestInternal{
+		UserID:   req.GetUserId(),
+		Format:   req.GetFormat(),
+		PageSize: req.GetPageSize(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for export user data request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	w := &exportChunkWriter{stream: stream}
+	next, err := h.userDataService.ExportUserData(ctx, w, req.GetUserId(),
+		model.ExportFormat(req.GetFormat()), req.GetCursor(), int(req.GetPageSize()))
+	if err == nil {
+		err = w.finish(next)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for export user data",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, context.Canceled):
+			h.log.WarnContext(ctx, "Export user data cancelled by client", slog.Int64("user_id", req.GetUserId()))
+			return status.Error(codes.Canceled, err.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while exporting user data",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully exported user data",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int("chunks", w.sent),
+		slog.String("next_cursor", next))
+	return nil
+}
+
+// exportChunkWriter cuts the export document into chunks of
+// exportChunkSize. The last chunk is held back until finish so that it can
+// carry the next cursor.
+type exportChunkWriter struct {
+	stream grpc.ServerStreamingServer[extpb.ExportUserDataChunk]
+	buf    []byte
+	sent   int
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) > exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize], ""); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+func (w *exportChunkWriter) finish(nextCursor string) error {
+	return w.send(w.buf, nextCursor)
+}
+
+func (w *exportChunkWriter) send(data []byte, nextCursor string) error {
+	chunk := &extpb.ExportUserDataChunk{
+		Data:       append([]byte(nil), data...),
+		NextCursor: nextCursor,
+	}
+	if err := w.stream.Send(chunk); err != nil {
+		return err
+	}
+	w.sent++
+	return nil
+}
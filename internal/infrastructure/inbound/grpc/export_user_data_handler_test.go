@@ -0,0 +1,152 @@
+package notification_grpc_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportStream struct {
+	grpc.ServerStream
+	chunks []*extpb.ExportUserDataChunk
+}
+
+func (s *exportStream) Context() context.Context { return context.Background() }
+
+func (s *exportStream) Send(chunk *extpb.ExportUserDataChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestExportUserDataHandler_Handle(t *testing.T) {
+	large := bytes.Repeat([]byte("x"), 100<<10)
+
+	tests := []struct {
+		name           string
+		req            *extpb.ExportUserDataRequest
+		mockSetup      func(*mocks.UserDataService)
+		wantData       []byte
+		wantChunks     int
+		wantNextCursor string
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "large export is split into chunks",
+			req:  &extpb.ExportUserDataRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.UserDataService) {
+				mockService.On("ExportUserData", mock.Anything, mock.Anything, int64(1), model.ExportFormat(""), "", 0).
+					Run(func(args mock.Arguments) {
+						_, err := args.Get(1).(io.Writer).Write(large)
+						require.NoError(t, err)
+					}).
+					Return("", nil)
+			},
+			wantData:   large,
+			wantChunks: 2,
+		},
+		{
+			name: "page carries next cursor on the last chunk",
+			req:  &extpb.ExportUserDataRequest{UserId: 1, Format: "json", PageSize: 10, Cursor: "abc"},
+			mockSetup: func(mockService *mocks.UserDataService) {
+				mockService.On("ExportUserData", mock.Anything, mock.Anything, int64(1), model.ExportFormatJSON, "abc", 10).
+					Run(func(args mock.Arguments) {
+						_, err := args.Get(1).(io.Writer).Write([]byte(`{"records":[]}`))
+						require.NoError(t, err)
+					}).
+					Return("next", nil)
+			},
+			wantData:       []byte(`{"records":[]}`),
+			wantChunks:     1,
+			wantNextCursor: "next",
+		},
+		{
+			name:           "validation error - zero user ID",
+			req:            &extpb.ExportUserDataRequest{UserId: 0},
+			mockSetup:      func(mockService *mocks.UserDataService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - unknown format",
+			req:            &extpb.ExportUserDataRequest{UserId: 1, Format: "csv"},
+			mockSetup:      func(mockService *mocks.UserDataService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "invalid cursor",
+			req:  &extpb.ExportUserDataRequest{UserId: 1, Cursor: "bad"},
+			mockSetup: func(mockService *mocks.UserDataService) {
+				mockService.On("ExportUserData", mock.Anything, mock.Anything, int64(1), model.ExportFormat(""), "bad", 0).
+					Return("", custom_errors.ErrInvalidInput)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrInvalidInput.Error(),
+		},
+		{
+			name: "internal error",
+			req:  &extpb.ExportUserDataRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.UserDataService) {
+				mockService.On("ExportUserData", mock.Anything, mock.Anything, int64(1), model.ExportFormat(""), "", 0).
+					Return("", errors.New("db down"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewUserDataService(t)
+			tt.mockSetup(mockService)
+
+			handler := notification_grpc.NewExportUserDataHandler(mockService, logger.New("dev"))
+			stream := &exportStream{}
+			err := handler.Handle(tt.req, stream)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, st.Code())
+				assert.Equal(t, tt.expectedErrMsg, st.Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, stream.chunks, tt.wantChunks)
+			var data []byte
+			for i, chunk := range stream.chunks {
+				data = append(data, chunk.GetData()...)
+				if i < len(stream.chunks)-1 {
+					assert.Empty(t, chunk.GetNextCursor())
+				}
+			}
+			assert.Equal(t, tt.wantData, data)
+			assert.Equal(t, tt.wantNextCursor, stream.chunks[len(stream.chunks)-1].GetNextCursor())
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	broadcast_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type BroadcastGetter interface {
+	GetBroadcast(ctx context.Context, id int64) (*model.Broadcast, error)
+}
+
+type GetBroadcastHandler struct {
+	broadcastService BroadcastGetter
+	log              ports.Logger
+}
+
+func NewGetBroadcastHandler(
+	broadcastService broadcast_service.BroadcastService,
+	log ports.Logger,
+) *GetBroadcastHandler {
+	return &GetBroadcastHandler{
+		broadcastService: broadcastService,
+		log:              log,
+	}
+}
+
+type GetBroadcastRequestInternal struct {
+	BroadcastID int64 `validate:"required,gt=0"`
+}
+
+func (h *GetBroadcastHandler) Handle(ctx context.Context, req *extpb.GetBroadcastRequest) (*extpb.Broadcast, error) {
+	h.log.DebugContext(ctx, "Processing get broadcast request", slog.Int64("broadcast_id", req.GetBroadcastId()))
+
+	validationReq := &GetBroadcastRequestInternal{
+		BroadcastID: req.GetBroadcastId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for get broadcast request",
+			slog.Int64("broadcast_id", req.GetBroadcastId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	broadcast, err := h.broadcastService.GetBroadcast(ctx, req.GetBroadcastId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for get broadcast",
+				slog.Int64("broadcast_id", req.GetBroadcastId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, model.ErrBroadcastNotFound):
+			h.log.DebugContext(ctx, "Broadcast not found", slog.Int64("broadcast_id", req.GetBroadcastId()))
+			return nil, status.Error(codes.NotFound, model.ErrBroadcastNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while getting broadcast",
+				slog.Int64("broadcast_id", req.GetBroadcastId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	return broadcastToExtProto(broadcast), nil
+}
@@ -0,0 +1,115 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetBroadcastHandler_Handle(t *testing.T) {
+	createdAt := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	completedAt := createdAt.Add(time.Minute)
+
+	tests := []struct {
+		name           string
+		req            *extpb.GetBroadcastRequest
+		mockSetup      func(*mocks.BroadcastService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "completed broadcast",
+			req:  &extpb.GetBroadcastRequest{BroadcastId: 7},
+			mockSetup: func(mockService *mocks.BroadcastService) {
+				mockService.On("GetBroadcast", mock.Anything, int64(7)).Return(&model.Broadcast{
+					ID:          7,
+					Type:        "new_pin",
+					Priority:    model.NotificationPriorityNormal,
+					FollowersOf: 42,
+					Status:      model.BroadcastStatusCompleted,
+					Total:       3,
+					Processed:   3,
+					Sent:        2,
+					CreatedAt:   createdAt,
+					CompletedAt: &completedAt,
+				}, nil)
+			},
+		},
+		{
+			name:           "validation error - zero broadcast ID",
+			req:            &extpb.GetBroadcastRequest{},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "broadcast not found",
+			req:  &extpb.GetBroadcastRequest{BroadcastId: 8},
+			mockSetup: func(mockService *mocks.BroadcastService) {
+				mockService.On("GetBroadcast", mock.Anything, int64(8)).Return(nil, model.ErrBroadcastNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: model.ErrBroadcastNotFound.Error(),
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.GetBroadcastRequest{BroadcastId: 7},
+			mockSetup: func(mockService *mocks.BroadcastService) {
+				mockService.On("GetBroadcast", mock.Anything, int64(7)).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewBroadcastService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewGetBroadcastHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int64(7), resp.GetId())
+				assert.Equal(t, "completed", resp.GetStatus())
+				assert.Equal(t, int64(42), resp.GetFollowersOf())
+				assert.Equal(t, int32(3), resp.GetProcessed())
+				assert.Equal(t, int32(2), resp.GetSent())
+				assert.Equal(t, completedAt, resp.GetCompletedAt().AsTime())
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
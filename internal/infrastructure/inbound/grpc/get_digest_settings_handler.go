@@ -0,0 +1,91 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	model "pinstack-notification-service/internal/domain/models"
+	digest_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type DigestSettingsGetter interface {
+	GetDigestSettings(ctx context.Context, userID int64) (*model.DigestSubscription, error)
+}
+
+type GetDigestSettingsHandler struct {
+	digestService DigestSettingsGetter
+	log           ports.Logger
+}
+
+func NewGetDigestSettingsHandler(
+	digestService digest_service.DigestService,
+	log ports.Logger,
+) *GetDigestSettingsHandler {
+	return &GetDigestSettingsHandler{
+		digestService: digestService,
+		log:           log,
+	}
+}
+
+type GetDigestSettingsRequestInternal struct {
+	UserID int64 `validate:"required,gt=0"`
+}
+
+func (h *GetDigestSettingsHandler) Handle(ctx context.Context, req *extpb.GetDigestSettingsRequest) (*extpb.DigestSettings, error) {
+	h.log.InfoContext(ctx, "Processing get digest settings request", slog.Int64("user_id", req.GetUserId()))
+
+	validationReq := &GetDigestSettingsRequestInternal{
+		UserID: req.GetUserId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for get digest settings request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	subscription, err := h.digestService.GetDigestSettings(ctx, req.GetUserId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for get digest settings",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while getting digest settings",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	resp := &extpb.DigestSettings{
+		Frequency: string(subscription.Frequency),
+	}
+	if subscription.Frequency != model.DigestFrequencyOff {
+		resp.NextRunAt = timestamppb.New(subscription.NextRunAt)
+	}
+	if subscription.LastSentAt != nil {
+		resp.LastSentAt = timestamppb.New(*subscription.LastSentAt)
+	}
+	if subscription.CoveredUntil != nil {
+		resp.CoveredUntil = timestamppb.New(*subscription.CoveredUntil)
+	}
+
+	h.log.InfoContext(ctx, "Successfully got digest settings",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.String("frequency", resp.GetFrequency()))
+	return resp, nil
+}
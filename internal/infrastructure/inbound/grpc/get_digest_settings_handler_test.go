@@ -0,0 +1,114 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetDigestSettingsHandler_Handle(t *testing.T) {
+	nextRunAt := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	lastSentAt := time.Date(2025, 5, 26, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.GetDigestSettingsRequest
+		mockSetup      func(*mocks.DigestService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "subscribed user",
+			req:  &extpb.GetDigestSettingsRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.DigestService) {
+				mockService.On("GetDigestSettings", mock.Anything, int64(1)).Return(&model.DigestSubscription{
+					UserID:       1,
+					Frequency:    model.DigestFrequencyWeekly,
+					NextRunAt:    nextRunAt,
+					LastSentAt:   &lastSentAt,
+					CoveredUntil: &lastSentAt,
+				}, nil)
+			},
+		},
+		{
+			name: "never subscribed",
+			req:  &extpb.GetDigestSettingsRequest{UserId: 2},
+			mockSetup: func(mockService *mocks.DigestService) {
+				mockService.On("GetDigestSettings", mock.Anything, int64(2)).Return(&model.DigestSubscription{
+					UserID:    2,
+					Frequency: model.DigestFrequencyOff,
+				}, nil)
+			},
+		},
+		{
+			name:           "validation error - zero user ID",
+			req:            &extpb.GetDigestSettingsRequest{},
+			mockSetup:      func(mockService *mocks.DigestService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.GetDigestSettingsRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.DigestService) {
+				mockService.On("GetDigestSettings", mock.Anything, int64(1)).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDigestService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewGetDigestSettingsHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				if resp.GetFrequency() == "off" {
+					assert.Nil(t, resp.GetNextRunAt())
+					assert.Nil(t, resp.GetLastSentAt())
+				} else {
+					assert.Equal(t, "weekly", resp.GetFrequency())
+					assert.Equal(t, nextRunAt, resp.GetNextRunAt().AsTime())
+					assert.Equal(t, lastSentAt, resp.GetLastSentAt().AsTime())
+				}
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -41,14 +41,14 @@ type NotificationDetailsRequestInternal struct {
 }
 
 func (h *GetNotificationDetailsHandler) Handle(ctx context.Context, req *pb.GetNotificationDetailsRequest) (*pb.NotificationResponse, error) {
-	h.log.Info("Processing get notification details request", slog.Int64("notification_id", req.GetNotificationId()))
+	h.log.InfoContext(ctx, "Processing get notification details request", slog.Int64("notification_id", req.GetNotificationId()))
 
 	validationReq := &NotificationDetailsRequestInternal{
 		NotificationID: req.GetNotificationId(),
 	}
 
 	if err := validate.Struct(validationReq); err != nil {
-		h.log.Error("Validation failed for get notification details request",
+		h.log.ErrorContext(ctx, "Validation failed for get notification details request",
 			slog.Int64("notification_id", req.GetNotificationId()),
 			slog.String("error", err.Error()))
 		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
@@ -58,24 +58,24 @@ func (h *GetNotificationDetailsHandler) Handle(ctx context.Context, req *pb.GetN
 	if err != nil {
 		switch {
 		case errors.Is(err, custom_errors.ErrInvalidInput):
-			h.log.Error("Invalid input for get notification details",
+			h.log.ErrorContext(ctx, "Invalid input for get notification details",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
 		case errors.Is(err, custom_errors.ErrNotificationNotFound):
-			h.log.Error("Notification not found",
+			h.log.ErrorContext(ctx, "Notification not found",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
 		default:
-			h.log.Error("Internal service error while getting notification details",
+			h.log.ErrorContext(ctx, "Internal service error while getting notification details",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
 		}
 	}
 
-	h.log.Info("Successfully retrieved notification details",
+	h.log.InfoContext(ctx, "Successfully retrieved notification details",
 		slog.Int64("notification_id", notification.ID),
 		slog.Int64("user_id", notification.UserID),
 		slog.String("notification_type", string(notification.Type)))
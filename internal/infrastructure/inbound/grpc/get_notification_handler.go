@@ -0,0 +1,93 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type LocalizedNotificationDetailsGetter interface {
+	NotificationDetailsGetter
+	NotificationLocalizer
+}
+
+type GetNotificationHandler struct {
+	notificationService LocalizedNotificationDetailsGetter
+	log                 ports.Logger
+}
+
+func NewGetNotificationHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *GetNotificationHandler {
+	return &GetNotificationHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type GetNotificationRequestInternal struct {
+	NotificationID int64  `validate:"required,gt=0"`
+	Locale         string `validate:"omitempty,bcp47_language_tag"`
+}
+
+func (h *GetNotificationHandler) Handle(ctx context.Context, req *extpb.GetNotificationRequest) (*extpb.Notification, error) {
+	h.log.InfoContext(ctx, "Processing get notification request",
+		slog.Int64("notification_id", req.GetNotificationId()),
+		slog.String("locale", req.GetLocale()))
+
+	validationReq := &GetNotificationRequestInternal{
+		NotificationID: req.GetNotificationId(),
+		Locale:         req.GetLocale(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for get notification request",
+			slog.Int64("notification_id", req.GetNotificationId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	notification, err := h.notificationService.GetNotificationDetails(ctx, req.GetNotificationId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for get notification",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrNotificationNotFound):
+			h.log.ErrorContext(ctx, "Notification not found",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while getting notification",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	if locale := requestLocale(ctx, req.GetLocale()); locale != "" {
+		h.notificationService.LocalizeNotifications([]*model.Notification{notification}, locale)
+	}
+
+	h.log.InfoContext(ctx, "Successfully retrieved notification",
+		slog.Int64("notification_id", notification.ID),
+		slog.Int64("user_id", notification.UserID),
+		slog.String("notification_type", string(notification.Type)))
+
+	return notificationToExtProto(notification), nil
+}
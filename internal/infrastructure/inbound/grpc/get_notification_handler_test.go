@@ -0,0 +1,140 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetNotificationHandler_Handle(t *testing.T) {
+	createdAt := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+	localize := func(locale, title string) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			args.Get(0).([]*model.Notification)[0].Text = &model.RenderedText{Locale: locale, Title: title, Body: title}
+		}
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		req            *extpb.GetNotificationRequest
+		mockSetup      func(*mocks.NotificationService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		wantLocale     string
+		wantTitle      string
+	}{
+		{
+			name: "locale from request",
+			ctx:  context.Background(),
+			req:  &extpb.GetNotificationRequest{NotificationId: 1, Locale: "ru"},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("GetNotificationDetails", mock.Anything, int64(1)).
+					Return(&model.Notification{ID: 1, UserID: 2, Type: "follow_created", CreatedAt: createdAt}, nil)
+				mockService.On("LocalizeNotifications", mock.Anything, "ru").Run(localize("ru", "Новый подписчик"))
+			},
+			wantLocale: "ru",
+			wantTitle:  "Новый подписчик",
+		},
+		{
+			name: "locale from accept-language metadata",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "pt-BR,pt;q=0.9,en;q=0.8")),
+			req:  &extpb.GetNotificationRequest{NotificationId: 1},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("GetNotificationDetails", mock.Anything, int64(1)).
+					Return(&model.Notification{ID: 1, UserID: 2, Type: "follow_created", CreatedAt: createdAt}, nil)
+				mockService.On("LocalizeNotifications", mock.Anything, "pt-BR").Run(localize("en", "New follower"))
+			},
+			wantLocale: "en",
+			wantTitle:  "New follower",
+		},
+		{
+			name: "no locale returns raw notification",
+			ctx:  context.Background(),
+			req:  &extpb.GetNotificationRequest{NotificationId: 1},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("GetNotificationDetails", mock.Anything, int64(1)).
+					Return(&model.Notification{ID: 1, UserID: 2, Type: "follow_created", CreatedAt: createdAt}, nil)
+			},
+		},
+		{
+			name:           "validation error - invalid notification id",
+			ctx:            context.Background(),
+			req:            &extpb.GetNotificationRequest{NotificationId: 0},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "notification not found",
+			ctx:  context.Background(),
+			req:  &extpb.GetNotificationRequest{NotificationId: 1, Locale: "en"},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("GetNotificationDetails", mock.Anything, int64(1)).Return(nil, custom_errors.ErrNotificationNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrNotificationNotFound.Error(),
+		},
+		{
+			name: "internal service error",
+			ctx:  context.Background(),
+			req:  &extpb.GetNotificationRequest{NotificationId: 1},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("GetNotificationDetails", mock.Anything, int64(1)).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewNotificationService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewGetNotificationHandler(mockService, log)
+			resp, err := handler.Handle(tt.ctx, tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				assert.Equal(t, tt.req.GetNotificationId(), resp.GetId())
+				assert.Equal(t, tt.wantLocale, resp.GetLocale())
+				assert.Equal(t, tt.wantTitle, resp.GetTitle())
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -39,14 +39,14 @@ type GetUnreadCountRequestInternal struct {
 }
 
 func (h *GetUnreadCountHandler) Handle(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
-	h.log.Info("Processing get unread count request", slog.Int64("user_id", req.GetUserId()))
+	h.log.InfoContext(ctx, "Processing get unread count request", slog.Int64("user_id", req.GetUserId()))
 
 	validationReq := &GetUnreadCountRequestInternal{
 		UserID: req.GetUserId(),
 	}
 
 	if err := validate.Struct(validationReq); err != nil {
-		h.log.Error("Validation failed for get unread count request",
+		h.log.ErrorContext(ctx, "Validation failed for get unread count request",
 			slog.Int64("user_id", req.GetUserId()),
 			slog.String("error", err.Error()))
 		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
@@ -56,24 +56,24 @@ func (h *GetUnreadCountHandler) Handle(ctx context.Context, req *pb.GetUnreadCou
 	if err != nil {
 		switch {
 		case errors.Is(err, custom_errors.ErrInvalidInput):
-			h.log.Error("Invalid input for get unread count",
+			h.log.ErrorContext(ctx, "Invalid input for get unread count",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			h.log.Error("User not found",
+			h.log.ErrorContext(ctx, "User not found",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
 		default:
-			h.log.Error("Internal service error while getting unread count",
+			h.log.ErrorContext(ctx, "Internal service error while getting unread count",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
 		}
 	}
 
-	h.log.Info("Successfully retrieved unread count",
+	h.log.InfoContext(ctx, "Successfully retrieved unread count",
 		slog.Int64("user_id", req.GetUserId()),
 		slog.Int("count", count))
 
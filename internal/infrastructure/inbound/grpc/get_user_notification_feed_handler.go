@@ -18,7 +18,7 @@ import (
 )
 
 type UserNotificationFeedGetter interface {
-	GetUserNotificationFeed(ctx context.Context, userID int64, limit, page int) ([]*model.Notification, int32, error)
+	GetUserNotificationFeed(ctx context.Context, userID int64, filter *model.FeedFilter, limit, page int) ([]*model.Notification, int32, error)
 }
 
 type GetUserNotificationFeedHandler struct {
@@ -43,7 +43,7 @@ type UserNotificationFeedRequestInternal struct {
 }
 
 func (h *GetUserNotificationFeedHandler) Handle(ctx context.Context, req *pb.GetUserNotificationFeedRequest) (*pb.GetUserNotificationFeedResponse, error) {
-	h.log.Info("Processing get user notification feed request",
+	h.log.InfoContext(ctx, "Processing get user notification feed request",
 		slog.Int64("user_id", req.GetUserId()),
 		slog.Int("limit", int(req.GetLimit())),
 		slog.Int("page", int(req.GetPage())))
@@ -55,7 +55,7 @@ func (h *GetUserNotificationFeedHandler) Handle(ctx context.Context, req *pb.Get
 	}
 
 	if err := validate.Struct(validationReq); err != nil {
-		h.log.Error("Validation failed for user notification feed request",
+		h.log.ErrorContext(ctx, "Validation failed for user notification feed request",
 			slog.Int64("user_id", req.GetUserId()),
 			slog.Int("limit", int(req.GetLimit())),
 			slog.Int("page", int(req.GetPage())),
@@ -63,23 +63,23 @@ func (h *GetUserNotificationFeedHandler) Handle(ctx context.Context, req *pb.Get
 		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
 	}
 
-	notifications, totalCount, err := h.notificationService.GetUserNotificationFeed(ctx, req.GetUserId(), int(req.GetLimit()), int(req.GetPage()))
+	notifications, totalCount, err := h.notificationService.GetUserNotificationFeed(ctx, req.GetUserId(), nil, int(req.GetLimit()), int(req.GetPage()))
 	if err != nil {
 		switch {
 		case errors.Is(err, custom_errors.ErrInvalidInput):
-			h.log.Error("Invalid input for get user notification feed",
+			h.log.ErrorContext(ctx, "Invalid input for get user notification feed",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.Int("limit", int(req.GetLimit())),
 				slog.Int("page", int(req.GetPage())),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			h.log.Error("User not found for notification feed request",
+			h.log.ErrorContext(ctx, "User not found for notification feed request",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
 		default:
-			h.log.Error("Internal service error while getting user notification feed",
+			h.log.ErrorContext(ctx, "Internal service error while getting user notification feed",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.Int("limit", int(req.GetLimit())),
 				slog.Int("page", int(req.GetPage())),
@@ -106,7 +106,7 @@ func (h *GetUserNotificationFeedHandler) Handle(ctx context.Context, req *pb.Get
 		})
 	}
 
-	h.log.Info("Successfully retrieved user notification feed",
+	h.log.InfoContext(ctx, "Successfully retrieved user notification feed",
 		slog.Int64("user_id", req.GetUserId()),
 		slog.Int("notifications_count", len(notifications)),
 		slog.Int("total_count", int(totalCount)))
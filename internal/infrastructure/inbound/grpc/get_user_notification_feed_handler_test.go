@@ -47,7 +47,7 @@ func TestGetUserNotificationFeed_Success(t *testing.T) {
 		},
 	}
 
-	mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), 10, 1).Return(notifications, int32(2), nil)
+	mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), (*model.FeedFilter)(nil), 10, 1).Return(notifications, int32(2), nil)
 
 	handler := notification_grpc.NewGetUserNotificationFeedHandler(mockService, log)
 	req := &pb.GetUserNotificationFeedRequest{
@@ -72,7 +72,7 @@ func TestGetUserNotificationFeed_EmptyList(t *testing.T) {
 	mockService := mocks.NewNotificationService(t)
 	log := logger.New("dev")
 
-	mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), 10, 1).Return([]*model.Notification{}, int32(0), nil)
+	mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), (*model.FeedFilter)(nil), 10, 1).Return([]*model.Notification{}, int32(0), nil)
 
 	handler := notification_grpc.NewGetUserNotificationFeedHandler(mockService, log)
 	req := &pb.GetUserNotificationFeedRequest{
@@ -95,7 +95,7 @@ func TestGetUserNotificationFeed_UserNotFound(t *testing.T) {
 	mockService := mocks.NewNotificationService(t)
 	log := logger.New("dev")
 
-	mockService.On("GetUserNotificationFeed", mock.Anything, int64(999), 10, 1).Return(nil, int32(0), custom_errors.ErrUserNotFound)
+	mockService.On("GetUserNotificationFeed", mock.Anything, int64(999), (*model.FeedFilter)(nil), 10, 1).Return(nil, int32(0), custom_errors.ErrUserNotFound)
 
 	handler := notification_grpc.NewGetUserNotificationFeedHandler(mockService, log)
 	req := &pb.GetUserNotificationFeedRequest{
@@ -120,7 +120,7 @@ func TestGetUserNotificationFeed_InternalError(t *testing.T) {
 	mockService := mocks.NewNotificationService(t)
 	log := logger.New("dev")
 
-	mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), 10, 1).Return(nil, int32(0), errors.New("database error"))
+	mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), (*model.FeedFilter)(nil), 10, 1).Return(nil, int32(0), errors.New("database error"))
 
 	handler := notification_grpc.NewGetUserNotificationFeedHandler(mockService, log)
 	req := &pb.GetUserNotificationFeedRequest{
@@ -0,0 +1,88 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	model "pinstack-notification-service/internal/domain/models"
+	delivery_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type ChannelPreferenceLister interface {
+	ListChannelPreferences(ctx context.Context, userID int64) ([]*model.ChannelPreference, error)
+}
+
+type ListChannelPreferencesHandler struct {
+	deliveryService ChannelPreferenceLister
+	log             ports.Logger
+}
+
+func NewListChannelPreferencesHandler(
+	deliveryService delivery_service.DeliveryService,
+	log ports.Logger,
+) *ListChannelPreferencesHandler {
+	return &ListChannelPreferencesHandler{
+		deliveryService: deliveryService,
+		log:             log,
+	}
+}
+
+type ListChannelPreferencesRequestInternal struct {
+	UserID int64 `validate:"required,gt=0"`
+}
+
+func (h *ListChannelPreferencesHandler) Handle(ctx context.Context, req *extpb.ListChannelPreferencesRequest) (*extpb.ListChannelPreferencesResponse, error) {
+	h.log.InfoContext(ctx, "Processing list channel preferences request", slog.Int64("user_id", req.GetUserId()))
+
+	validationReq := &ListChannelPreferencesRequestInternal{
+		UserID: req.GetUserId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for list channel preferences request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	preferences, err := h.deliveryService.ListChannelPreferences(ctx, req.GetUserId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for list channel preferences",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while listing channel preferences",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	resp := &extpb.ListChannelPreferencesResponse{
+		Preferences: make([]*extpb.ChannelPreference, 0, len(preferences)),
+	}
+	for _, p := range preferences {
+		resp.Preferences = append(resp.Preferences, &extpb.ChannelPreference{
+			Type:    string(p.Type),
+			Channel: string(p.Channel),
+			Enabled: p.Enabled,
+		})
+	}
+
+	h.log.InfoContext(ctx, "Successfully listed channel preferences",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int("count", len(preferences)))
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListChannelPreferencesHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.ListChannelPreferencesRequest
+		mockSetup      func(*mocks.DeliveryService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedCount  int
+	}{
+		{
+			name: "successful list channel preferences",
+			req:  &extpb.ListChannelPreferencesRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("ListChannelPreferences", mock.Anything, int64(1)).Return([]*model.ChannelPreference{
+					{UserID: 1, Channel: model.ChannelEmail, Enabled: false},
+					{UserID: 1, Type: "follow_created", Channel: model.ChannelPush, Enabled: true},
+				}, nil)
+			},
+			wantErr:       false,
+			expectedCount: 2,
+		},
+		{
+			name:           "validation error - user ID zero",
+			req:            &extpb.ListChannelPreferencesRequest{UserId: 0},
+			mockSetup:      func(mockService *mocks.DeliveryService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.ListChannelPreferencesRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("ListChannelPreferences", mock.Anything, int64(1)).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDeliveryService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewListChannelPreferencesHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				assert.Len(t, resp.GetPreferences(), tt.expectedCount)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
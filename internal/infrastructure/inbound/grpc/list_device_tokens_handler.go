@@ -0,0 +1,91 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	model "pinstack-notification-service/internal/domain/models"
+	device_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type DeviceTokensLister interface {
+	ListDeviceTokens(ctx context.Context, userID int64) ([]*model.DeviceToken, error)
+}
+
+type ListDeviceTokensHandler struct {
+	deviceService DeviceTokensLister
+	log           ports.Logger
+}
+
+func NewListDeviceTokensHandler(
+	deviceService device_service.DeviceService,
+	log ports.Logger,
+) *ListDeviceTokensHandler {
+	return &ListDeviceTokensHandler{
+		deviceService: deviceService,
+		log:           log,
+	}
+}
+
+type ListDeviceTokensRequestInternal struct {
+	UserID int64 `validate:"required,gt=0"`
+}
+
+func (h *ListDeviceTokensHandler) Handle(ctx context.Context, req *extpb.ListDeviceTokensRequest) (*extpb.ListDeviceTokensResponse, error) {
+	h.log.InfoContext(ctx, "Processing list device tokens request", slog.Int64("user_id", req.GetUserId()))
+
+	validationReq := &ListDeviceTokensRequestInternal{
+		UserID: req.GetUserId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for list device tokens request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	tokens, err := h.deviceService.ListDeviceTokens(ctx, req.GetUserId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for list device tokens",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while listing device tokens",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	resp := &extpb.ListDeviceTokensResponse{
+		Tokens: make([]*extpb.DeviceToken, 0, len(tokens)),
+	}
+	for _, t := range tokens {
+		resp.Tokens = append(resp.Tokens, &extpb.DeviceToken{
+			Token:     t.Token,
+			Platform:  string(t.Platform),
+			Locale:    t.Locale,
+			CreatedAt: timestamppb.New(t.CreatedAt),
+			UpdatedAt: timestamppb.New(t.UpdatedAt),
+		})
+	}
+
+	h.log.InfoContext(ctx, "Successfully listed device tokens",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int("count", len(tokens)))
+	return resp, nil
+}
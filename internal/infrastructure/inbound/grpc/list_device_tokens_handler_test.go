@@ -0,0 +1,96 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListDeviceTokensHandler_Handle(t *testing.T) {
+	registeredAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.ListDeviceTokensRequest
+		mockSetup      func(*mocks.DeviceService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedCount  int
+	}{
+		{
+			name: "successful list",
+			req:  &extpb.ListDeviceTokensRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.DeviceService) {
+				mockService.On("ListDeviceTokens", mock.Anything, int64(1)).Return([]*model.DeviceToken{
+					{UserID: 1, Token: "fcm-token", Platform: model.DevicePlatformAndroid, Locale: "en", CreatedAt: registeredAt, UpdatedAt: registeredAt},
+					{UserID: 1, Token: "apns-token", Platform: model.DevicePlatformIOS, CreatedAt: registeredAt, UpdatedAt: registeredAt},
+				}, nil)
+			},
+			expectedCount: 2,
+		},
+		{
+			name:           "validation error - zero user ID",
+			req:            &extpb.ListDeviceTokensRequest{},
+			mockSetup:      func(mockService *mocks.DeviceService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.ListDeviceTokensRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.DeviceService) {
+				mockService.On("ListDeviceTokens", mock.Anything, int64(1)).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDeviceService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewListDeviceTokensHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				require.Len(t, resp.GetTokens(), tt.expectedCount)
+				assert.Equal(t, "android", resp.GetTokens()[0].GetPlatform())
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
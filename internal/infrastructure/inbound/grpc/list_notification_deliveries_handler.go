@@ -0,0 +1,84 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	model "pinstack-notification-service/internal/domain/models"
+	delivery_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type NotificationDeliveriesLister interface {
+	ListNotificationDeliveries(ctx context.Context, notificationID int64) ([]*model.Delivery, error)
+}
+
+type ListNotificationDeliveriesHandler struct {
+	deliveryService NotificationDeliveriesLister
+	log             ports.Logger
+}
+
+func NewListNotificationDeliveriesHandler(
+	deliveryService delivery_service.DeliveryService,
+	log ports.Logger,
+) *ListNotificationDeliveriesHandler {
+	return &ListNotificationDeliveriesHandler{
+		deliveryService: deliveryService,
+		log:             log,
+	}
+}
+
+type ListNotificationDeliveriesRequestInternal struct {
+	NotificationID int64 `validate:"required,gt=0"`
+}
+
+func (h *ListNotificationDeliveriesHandler) Handle(ctx context.Context, req *extpb.ListNotificationDeliveriesRequest) (*extpb.ListNotificationDeliveriesResponse, error) {
+	h.log.InfoContext(ctx, "Processing list notification deliveries request", slog.Int64("notification_id", req.GetNotificationId()))
+
+	validationReq := &ListNotificationDeliveriesRequestInternal{
+		NotificationID: req.GetNotificationId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for list notification deliveries request",
+			slog.Int64("notification_id", req.GetNotificationId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	deliveries, err := h.deliveryService.ListNotificationDeliveries(ctx, req.GetNotificationId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for list notification deliveries",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while listing notification deliveries",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	resp := &extpb.ListNotificationDeliveriesResponse{
+		Deliveries: make([]*extpb.Delivery, 0, len(deliveries)),
+	}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, deliveryToExtProto(d))
+	}
+
+	h.log.InfoContext(ctx, "Successfully listed notification deliveries",
+		slog.Int64("notification_id", req.GetNotificationId()),
+		slog.Int("count", len(deliveries)))
+	return resp, nil
+}
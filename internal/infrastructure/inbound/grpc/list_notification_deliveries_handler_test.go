@@ -0,0 +1,102 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListNotificationDeliveriesHandler_Handle(t *testing.T) {
+	sentAt := time.Now()
+
+	tests := []struct {
+		name           string
+		req            *extpb.ListNotificationDeliveriesRequest
+		mockSetup      func(*mocks.DeliveryService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		check          func(*testing.T, *extpb.ListNotificationDeliveriesResponse)
+	}{
+		{
+			name: "successful list notification deliveries",
+			req:  &extpb.ListNotificationDeliveriesRequest{NotificationId: 10},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("ListNotificationDeliveries", mock.Anything, int64(10)).Return([]*model.Delivery{
+					{ID: 1, NotificationID: 10, Channel: model.ChannelEmail, Status: model.DeliveryStatusSent, Attempts: 1, SentAt: &sentAt},
+					{ID: 2, NotificationID: 10, Channel: model.ChannelPush, Status: model.DeliveryStatusFailed, Attempts: 5, LastError: "invalid token"},
+				}, nil)
+			},
+			wantErr: false,
+			check: func(t *testing.T, resp *extpb.ListNotificationDeliveriesResponse) {
+				require.Len(t, resp.GetDeliveries(), 2)
+				assert.Equal(t, "sent", resp.GetDeliveries()[0].GetStatus())
+				assert.NotNil(t, resp.GetDeliveries()[0].GetSentAt())
+				assert.Equal(t, "invalid token", resp.GetDeliveries()[1].GetLastError())
+				assert.Nil(t, resp.GetDeliveries()[1].GetSentAt())
+			},
+		},
+		{
+			name:           "validation error - notification ID zero",
+			req:            &extpb.ListNotificationDeliveriesRequest{NotificationId: 0},
+			mockSetup:      func(mockService *mocks.DeliveryService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.ListNotificationDeliveriesRequest{NotificationId: 10},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("ListNotificationDeliveries", mock.Anything, int64(10)).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDeliveryService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewListNotificationDeliveriesHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				tt.check(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type NotificationLocalizer interface {
+	LocalizeNotifications(notifications []*model.Notification, locale string)
+}
+
+type LocalizedNotificationFeedGetter interface {
+	UserNotificationFeedGetter
+	NotificationLocalizer
+}
+
+type ListUserNotificationsHandler struct {
+	notificationService LocalizedNotificationFeedGetter
+	log                 ports.Logger
+}
+
+func NewListUserNotificationsHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *ListUserNotificationsHandler {
+	return &ListUserNotificationsHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type ListUserNotificationsRequestInternal struct {
+	UserID int64    `validate:"required,gt=0"`
+	Limit  int      `validate:"required,gt=0,lte=100"`
+	Page   int      `validate:"required,gte=0"`
+	States []string `validate:"dive,oneof=unread read archived"`
+	Locale string   `validate:"omitempty,bcp47_language_tag"`
+}
+
+func (h *ListUserNotificationsHandler) Handle(ctx context.Context, req *extpb.ListUserNotificationsRequest) (*extpb.ListUserNotificationsResponse, error) {
+	h.log.InfoContext(ctx, "Processing list user notifications request",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int("limit", int(req.GetLimit())),
+		slog.Int("page", int(req.GetPage())),
+		slog.Int("states_count", len(req.GetStates())),
+		slog.Bool("priority_first", req.GetPriorityFirst()))
+
+	filter := &model.FeedFilter{PriorityFirst: req.GetPriorityFirst()}
+	states := make([]string, 0, len(req.GetStates()))
+	for _, s := range req.GetStates() {
+		state := stateFromProto[s]
+		filter.States = append(filter.States, state)
+		states = append(states, string(state))
+	}
+
+	validationReq := &ListUserNotificationsRequestInternal{
+		UserID: req.GetUserId(),
+		Limit:  int(req.GetLimit()),
+		Page:   int(req.GetPage()),
+		States: states,
+		Locale: req.GetLocale(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for list user notifications request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.Int("limit", int(req.GetLimit())),
+			slog.Int("page", int(req.GetPage())),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	notifications, totalCount, err := h.notificationService.GetUserNotificationFeed(ctx, req.GetUserId(), filter, int(req.GetLimit()), int(req.GetPage()))
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for list user notifications",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrUserNotFound):
+			h.log.ErrorContext(ctx, "User not found for list user notifications request",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while listing user notifications",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	if locale := requestLocale(ctx, req.GetLocale()); locale != "" {
+		h.notificationService.LocalizeNotifications(notifications, locale)
+	}
+
+	response := &extpb.ListUserNotificationsResponse{
+		Notifications: make([]*extpb.Notification, 0, len(notifications)),
+		Total:         totalCount,
+		Limit:         req.GetLimit(),
+		Page:          req.GetPage(),
+	}
+
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, notificationToExtProto(notification))
+	}
+
+	h.log.InfoContext(ctx, "Successfully listed user notifications",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int("notifications_count", len(notifications)),
+		slog.Int("total_count", int(totalCount)))
+
+	return response, nil
+}
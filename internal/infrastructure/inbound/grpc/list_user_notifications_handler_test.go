@@ -0,0 +1,212 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListUserNotificationsHandler_Handle(t *testing.T) {
+	createdAt := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+	pinnedAt := time.Date(2025, 6, 17, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.ListUserNotificationsRequest
+		mockSetup      func(*mocks.NotificationService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		wantCount      int
+	}{
+		{
+			name: "successful list with archived filter",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId: 1,
+				States: []extpb.NotificationState{extpb.NotificationState_NOTIFICATION_STATE_ARCHIVED},
+				Page:   1,
+				Limit:  10,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				filter := &model.FeedFilter{States: []model.NotificationState{model.NotificationStateArchived}}
+				notifications := []*model.Notification{
+					{
+						ID:        1,
+						UserID:    1,
+						Type:      "follow_created",
+						State:     model.NotificationStateArchived,
+						IsRead:    true,
+						CreatedAt: createdAt,
+						Payload:   json.RawMessage(`{"follower_id":42}`),
+					},
+				}
+				mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), filter, 10, 1).Return(notifications, int32(1), nil)
+			},
+			wantErr:   false,
+			wantCount: 1,
+		},
+		{
+			name: "successful list without filter returns pinned first",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId: 1,
+				Page:   1,
+				Limit:  10,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				notifications := []*model.Notification{
+					{ID: 2, UserID: 1, Type: "follow_created", State: model.NotificationStateRead, IsRead: true, PinnedAt: &pinnedAt, CreatedAt: createdAt},
+					{ID: 3, UserID: 1, Type: "follow_created", State: model.NotificationStateUnread, CreatedAt: createdAt},
+				}
+				mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), &model.FeedFilter{}, 10, 1).Return(notifications, int32(2), nil)
+			},
+			wantErr:   false,
+			wantCount: 2,
+		},
+		{
+			name: "successful list with urgent notifications first",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId:        1,
+				Page:          1,
+				Limit:         10,
+				PriorityFirst: true,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				notifications := []*model.Notification{
+					{ID: 4, UserID: 1, Type: "security_alert", State: model.NotificationStateUnread, Priority: model.NotificationPriorityCritical, CreatedAt: createdAt},
+					{ID: 5, UserID: 1, Type: "follow_created", State: model.NotificationStateUnread, Priority: model.NotificationPriorityNormal, CreatedAt: createdAt},
+				}
+				mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), &model.FeedFilter{PriorityFirst: true}, 10, 1).Return(notifications, int32(2), nil)
+			},
+			wantErr:   false,
+			wantCount: 2,
+		},
+		{
+			name: "successful list with locale renders text",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId: 1,
+				Page:   1,
+				Limit:  10,
+				Locale: "ru",
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				notifications := []*model.Notification{
+					{
+						ID: 3, UserID: 1, Type: "follow_created", State: model.NotificationStateUnread, CreatedAt: createdAt,
+						Actor: &model.Actor{ID: 42, Username: "jane"},
+					},
+				}
+				mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), &model.FeedFilter{}, 10, 1).Return(notifications, int32(1), nil)
+				mockService.On("LocalizeNotifications", notifications, "ru").Run(func(args mock.Arguments) {
+					args.Get(0).([]*model.Notification)[0].Text = &model.RenderedText{Locale: "ru", Title: "Новый подписчик"}
+				})
+			},
+			wantErr:   false,
+			wantCount: 1,
+		},
+		{
+			name: "validation error - invalid locale",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId: 1,
+				Page:   1,
+				Limit:  10,
+				Locale: "not a locale",
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - unspecified state",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId: 1,
+				States: []extpb.NotificationState{extpb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED},
+				Page:   1,
+				Limit:  10,
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - limit too large",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId: 1,
+				Page:   1,
+				Limit:  101,
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req: &extpb.ListUserNotificationsRequest{
+				UserId: 1,
+				Page:   1,
+				Limit:  10,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("GetUserNotificationFeed", mock.Anything, int64(1), mock.Anything, 10, 1).Return(nil, int32(0), errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewNotificationService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewListUserNotificationsHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				assert.Len(t, resp.Notifications, tt.wantCount)
+				for _, n := range resp.Notifications {
+					assert.NotEqual(t, extpb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED, n.State)
+				}
+				if tt.req.GetLocale() != "" {
+					assert.Equal(t, tt.req.GetLocale(), resp.Notifications[0].GetLocale())
+					assert.NotEmpty(t, resp.Notifications[0].GetTitle())
+					assert.Equal(t, "jane", resp.Notifications[0].GetActor().GetUsername())
+				}
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
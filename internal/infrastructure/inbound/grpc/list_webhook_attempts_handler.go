@@ -0,0 +1,88 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	model "pinstack-notification-service/internal/domain/models"
+	webhook_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type WebhookAttemptsLister interface {
+	ListAttempts(ctx context.Context, subscriptionID int64, limit int) ([]*model.WebhookAttempt, error)
+}
+
+type ListWebhookAttemptsHandler struct {
+	webhookService WebhookAttemptsLister
+	log            ports.Logger
+}
+
+func NewListWebhookAttemptsHandler(
+	webhookService webhook_service.WebhookService,
+	log ports.Logger,
+) *ListWebhookAttemptsHandler {
+	return &ListWebhookAttemptsHandler{
+		webhookService: webhookService,
+		log:            log,
+	}
+}
+
+type ListWebhookAttemptsRequestInternal struct {
+	SubscriptionID int64 `validate:"required,gt=0"`
+	Limit          int32 `validate:"gte=0,lte=500"`
+}
+
+func (h *ListWebhookAttemptsHandler) Handle(ctx context.Context, req *extpb.ListWebhookAttemptsRequest) (*extpb.ListWebhookAttemptsResponse, error) {
+	h.log.InfoContext(ctx, "Processing list webhook attempts request",
+		slog.Int64("subscription_id", req.GetSubscriptionId()),
+		slog.Int("limit", int(req.GetLimit())))
+
+	validationReq := &ListWebhookAttemptsRequestInternal{
+		SubscriptionID: req.GetSubscriptionId(),
+		Limit:          req.GetLimit(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for list webhook attempts request",
+			slog.Int64("subscription_id", req.GetSubscriptionId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	attempts, err := h.webhookService.ListAttempts(ctx, req.GetSubscriptionId(), int(req.GetLimit()))
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for list webhook attempts",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while listing webhook attempts",
+				slog.Int64("subscription_id", req.GetSubscriptionId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	resp := &extpb.ListWebhookAttemptsResponse{
+		Attempts: make([]*extpb.WebhookAttempt, 0, len(attempts)),
+	}
+	for _, a := range attempts {
+		resp.Attempts = append(resp.Attempts, webhookAttemptToExtProto(a))
+	}
+
+	h.log.InfoContext(ctx, "Successfully listed webhook attempts",
+		slog.Int64("subscription_id", req.GetSubscriptionId()),
+		slog.Int("count", len(attempts)))
+	return resp, nil
+}
@@ -0,0 +1,122 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListWebhookAttemptsHandler_Handle(t *testing.T) {
+	attemptedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.ListWebhookAttemptsRequest
+		mockSetup      func(*mocks.WebhookService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		checkResp      func(*testing.T, *extpb.ListWebhookAttemptsResponse)
+	}{
+		{
+			name: "successful list",
+			req:  &extpb.ListWebhookAttemptsRequest{SubscriptionId: 7, Limit: 20},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("ListAttempts", mock.Anything, int64(7), 20).Return([]*model.WebhookAttempt{
+					{
+						ID:             3,
+						DeliveryID:     11,
+						SubscriptionID: 7,
+						Event:          model.WebhookEventNotificationCreated,
+						IdempotencyKey: "5f0c6f0e-2b7c-4c59-9a47-0d1f2a3b4c5d",
+						Attempt:        2,
+						ResponseStatus: 503,
+						Error:          "unexpected response status 503",
+						Duration:       250 * time.Millisecond,
+						AttemptedAt:    attemptedAt,
+					},
+				}, nil)
+			},
+			checkResp: func(t *testing.T, resp *extpb.ListWebhookAttemptsResponse) {
+				require.Len(t, resp.GetAttempts(), 1)
+				a := resp.GetAttempts()[0]
+				assert.Equal(t, "notification.created", a.GetEvent())
+				assert.Equal(t, int32(503), a.GetResponseStatus())
+				assert.Equal(t, int64(250), a.GetDurationMs())
+				assert.Equal(t, int32(2), a.GetAttempt())
+			},
+		},
+		{
+			name:           "validation error - zero subscription ID",
+			req:            &extpb.ListWebhookAttemptsRequest{},
+			mockSetup:      func(mockService *mocks.WebhookService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - limit too large",
+			req:            &extpb.ListWebhookAttemptsRequest{SubscriptionId: 7, Limit: 1000},
+			mockSetup:      func(mockService *mocks.WebhookService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.ListWebhookAttemptsRequest{SubscriptionId: 7},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("ListAttempts", mock.Anything, int64(7), 0).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewWebhookService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewListWebhookAttemptsHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				if tt.checkResp != nil {
+					tt.checkResp(t, resp)
+				}
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
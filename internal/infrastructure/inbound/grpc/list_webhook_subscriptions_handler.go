@@ -0,0 +1,82 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	model "pinstack-notification-service/internal/domain/models"
+	webhook_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type WebhookSubscriptionsLister interface {
+	ListSubscriptions(ctx context.Context, owner string) ([]*model.WebhookSubscription, error)
+}
+
+type ListWebhookSubscriptionsHandler struct {
+	webhookService WebhookSubscriptionsLister
+	log            ports.Logger
+}
+
+func NewListWebhookSubscriptionsHandler(
+	webhookService webhook_service.WebhookService,
+	log ports.Logger,
+) *ListWebhookSubscriptionsHandler {
+	return &ListWebhookSubscriptionsHandler{
+		webhookService: webhookService,
+		log:            log,
+	}
+}
+
+type ListWebhookSubscriptionsRequestInternal struct {
+	Owner string `validate:"required"`
+}
+
+func (h *ListWebhookSubscriptionsHandler) Handle(ctx context.Context, req *extpb.ListWebhookSubscriptionsRequest) (*extpb.ListWebhookSubscriptionsResponse, error) {
+	h.log.InfoContext(ctx, "Processing list webhook subscriptions request", slog.String("owner", req.GetOwner()))
+
+	validationReq := &ListWebhookSubscriptionsRequestInternal{
+		Owner: req.GetOwner(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for list webhook subscriptions request", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	subscriptions, err := h.webhookService.ListSubscriptions(ctx, req.GetOwner())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for list webhook subscriptions",
+				slog.String("owner", req.GetOwner()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while listing webhook subscriptions",
+				slog.String("owner", req.GetOwner()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	resp := &extpb.ListWebhookSubscriptionsResponse{
+		Subscriptions: make([]*extpb.WebhookSubscription, 0, len(subscriptions)),
+	}
+	for _, s := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, webhookSubscriptionToExtProto(s))
+	}
+
+	h.log.InfoContext(ctx, "Successfully listed webhook subscriptions",
+		slog.String("owner", req.GetOwner()),
+		slog.Int("count", len(subscriptions)))
+	return resp, nil
+}
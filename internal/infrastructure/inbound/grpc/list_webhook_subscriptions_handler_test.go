@@ -0,0 +1,98 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListWebhookSubscriptionsHandler_Handle(t *testing.T) {
+	disabledAt := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.ListWebhookSubscriptionsRequest
+		mockSetup      func(*mocks.WebhookService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedCount  int
+	}{
+		{
+			name: "successful list hides secrets",
+			req:  &extpb.ListWebhookSubscriptionsRequest{Owner: "partner-crm"},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("ListSubscriptions", mock.Anything, "partner-crm").Return([]*model.WebhookSubscription{
+					{ID: 1, Owner: "partner-crm", URL: "https://a.example.com", Secret: "s1", Enabled: true},
+					{ID: 2, Owner: "partner-crm", URL: "https://b.example.com", Secret: "s2", ConsecutiveFailures: 10, DisabledAt: &disabledAt},
+				}, nil)
+			},
+			expectedCount: 2,
+		},
+		{
+			name:           "validation error - missing owner",
+			req:            &extpb.ListWebhookSubscriptionsRequest{},
+			mockSetup:      func(mockService *mocks.WebhookService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.ListWebhookSubscriptionsRequest{Owner: "partner-crm"},
+			mockSetup: func(mockService *mocks.WebhookService) {
+				mockService.On("ListSubscriptions", mock.Anything, "partner-crm").Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewWebhookService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewListWebhookSubscriptionsHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				require.Len(t, resp.GetSubscriptions(), tt.expectedCount)
+				for _, s := range resp.GetSubscriptions() {
+					assert.Empty(t, s.GetSecret())
+				}
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package notification_grpc
+
+import (
+	"context"
+	model "pinstack-notification-service/internal/domain/models"
+	"strings"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var stateToProto = map[model.NotificationState]extpb.NotificationState{
+	model.NotificationStateUnread:   extpb.NotificationState_NOTIFICATION_STATE_UNREAD,
+	model.NotificationStateRead:     extpb.NotificationState_NOTIFICATION_STATE_READ,
+	model.NotificationStateArchived: extpb.NotificationState_NOTIFICATION_STATE_ARCHIVED,
+}
+
+var stateFromProto = map[extpb.NotificationState]model.NotificationState{
+	extpb.NotificationState_NOTIFICATION_STATE_UNREAD:   model.NotificationStateUnread,
+	extpb.NotificationState_NOTIFICATION_STATE_READ:     model.NotificationStateRead,
+	extpb.NotificationState_NOTIFICATION_STATE_ARCHIVED: model.NotificationStateArchived,
+}
+
+func notificationToExtProto(notification *model.Notification) *extpb.Notification {
+	resp := &extpb.Notification{
+		Id:        notification.ID,
+		UserId:    notification.UserID,
+		Type:      string(notification.Type),
+		State:     stateToProto[notification.State],
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+		Payload:   notification.Payload,
+		Priority:  string(notification.Priority),
+	}
+	if notification.PinnedAt != nil {
+		resp.PinnedAt = timestamppb.New(*notification.PinnedAt)
+	}
+	if notification.Text != nil {
+		resp.Title = notification.Text.Title
+		resp.Body = notification.Text.Body
+		resp.Locale = notification.Text.Locale
+	}
+	if actor := notification.Actor; actor != nil {
+		resp.Actor = &extpb.Actor{
+			Id:        actor.ID,
+			Username:  actor.Username,
+			FullName:  deref(actor.FullName),
+			AvatarUrl: deref(actor.AvatarURL),
+		}
+	}
+	return resp
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// requestLocale prefers the locale named in the request and falls back to
+// the first language of accept-language metadata, as forwarded by the
+// gateway. An empty result means the caller did not ask for text.
+func requestLocale(ctx context.Context, requested string) string {
+	if requested != "" {
+		return requested
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, header := range md.Get("accept-language") {
+		first, _, _ := strings.Cut(header, ",")
+		tag, _, _ := strings.Cut(first, ";")
+		if tag = strings.TrimSpace(tag); tag != "" && tag != "*" {
+			return tag
+		}
+	}
+	return ""
+}
+
+func deliveryToExtProto(delivery *model.Delivery) *extpb.Delivery {
+	resp := &extpb.Delivery{
+		Id:            delivery.ID,
+		Channel:       string(delivery.Channel),
+		Status:        string(delivery.Status),
+		Attempts:      int32(delivery.Attempts),
+		LastError:     delivery.LastError,
+		CreatedAt:     timestamppb.New(delivery.CreatedAt),
+		NextAttemptAt: timestamppb.New(delivery.NextAttemptAt),
+	}
+	if delivery.SentAt != nil {
+		resp.SentAt = timestamppb.New(*delivery.SentAt)
+	}
+	return resp
+}
+
+// webhookSubscriptionToExtProto leaves the secret out; the create handler
+// sets it on the one response that may carry it.
+func webhookSubscriptionToExtProto(subscription *model.WebhookSubscription) *extpb.WebhookSubscription {
+	resp := &extpb.WebhookSubscription{
+		Id:                  subscription.ID,
+		Owner:               subscription.Owner,
+		Url:                 subscription.URL,
+		Events:              make([]string, 0, len(subscription.Events)),
+		NotificationTypes:   make([]string, 0, len(subscription.NotificationTypes)),
+		Enabled:             subscription.Enabled,
+		ConsecutiveFailures: int32(subscription.ConsecutiveFailures),
+		CreatedAt:           timestamppb.New(subscription.CreatedAt),
+	}
+	for _, e := range subscription.Events {
+		resp.Events = append(resp.Events, string(e))
+	}
+	for _, t := range subscription.NotificationTypes {
+		resp.NotificationTypes = append(resp.NotificationTypes, string(t))
+	}
+	if subscription.DisabledAt != nil {
+		resp.DisabledAt = timestamppb.New(*subscription.DisabledAt)
+	}
+	return resp
+}
+
+func webhookAttemptToExtProto(attempt *model.WebhookAttempt) *extpb.WebhookAttempt {
+	return &extpb.WebhookAttempt{
+		Id:             attempt.ID,
+		DeliveryId:     attempt.DeliveryID,
+		Event:          string(attempt.Event),
+		IdempotencyKey: attempt.IdempotencyKey,
+		Attempt:        int32(attempt.Attempt),
+		ResponseStatus: int32(attempt.ResponseStatus),
+		Error:          attempt.Error,
+		DurationMs:     attempt.Duration.Milliseconds(),
+		AttemptedAt:    timestamppb.New(attempt.AttemptedAt),
+	}
+}
+
+func broadcastToExtProto(broadcast *model.Broadcast) *extpb.Broadcast {
+	resp := &extpb.Broadcast{
+		Id:          broadcast.ID,
+		Type:        string(broadcast.Type),
+		Priority:    string(broadcast.Priority),
+		Status:      string(broadcast.Status),
+		Total:       int32(broadcast.Total),
+		Processed:   int32(broadcast.Processed),
+		Sent:        int32(broadcast.Sent),
+		LastError:   broadcast.LastError,
+		FollowersOf: broadcast.FollowersOf,
+		CreatedAt:   timestamppb.New(broadcast.CreatedAt),
+	}
+	if broadcast.CompletedAt != nil {
+		resp.CompletedAt = timestamppb.New(*broadcast.CompletedAt)
+	}
+	return resp
+}
@@ -40,14 +40,14 @@ type ReadAllUserNotificationsRequestInternal struct {
 }
 
 func (h *ReadAllUserNotificationsHandler) Handle(ctx context.Context, req *pb.ReadAllUserNotificationsRequest) (*emptypb.Empty, error) {
-	h.log.Info("Processing read all user notifications request", slog.Int64("user_id", req.GetUserId()))
+	h.log.InfoContext(ctx, "Processing read all user notifications request", slog.Int64("user_id", req.GetUserId()))
 
 	validationReq := &ReadAllUserNotificationsRequestInternal{
 		UserID: req.GetUserId(),
 	}
 
 	if err := validate.Struct(validationReq); err != nil {
-		h.log.Error("Validation failed for read all user notifications request",
+		h.log.ErrorContext(ctx, "Validation failed for read all user notifications request",
 			slog.Int64("user_id", req.GetUserId()),
 			slog.String("error", err.Error()))
 		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
@@ -57,23 +57,23 @@ func (h *ReadAllUserNotificationsHandler) Handle(ctx context.Context, req *pb.Re
 	if err != nil {
 		switch {
 		case errors.Is(err, custom_errors.ErrInvalidInput):
-			h.log.Error("Invalid input for read all user notifications",
+			h.log.ErrorContext(ctx, "Invalid input for read all user notifications",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			h.log.Error("User not found for read all notifications request",
+			h.log.ErrorContext(ctx, "User not found for read all notifications request",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
 		default:
-			h.log.Error("Internal service error while reading all user notifications",
+			h.log.ErrorContext(ctx, "Internal service error while reading all user notifications",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
 		}
 	}
 
-	h.log.Info("Successfully marked all notifications as read", slog.Int64("user_id", req.GetUserId()))
+	h.log.InfoContext(ctx, "Successfully marked all notifications as read", slog.Int64("user_id", req.GetUserId()))
 	return &emptypb.Empty{}, nil
 }
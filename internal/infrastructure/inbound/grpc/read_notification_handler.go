@@ -40,14 +40,14 @@ type ReadNotificationRequestInternal struct {
 }
 
 func (h *ReadNotificationHandler) Handle(ctx context.Context, req *pb.ReadNotificationRequest) (*emptypb.Empty, error) {
-	h.log.Info("Processing read notification request", slog.Int64("notification_id", req.GetNotificationId()))
+	h.log.InfoContext(ctx, "Processing read notification request", slog.Int64("notification_id", req.GetNotificationId()))
 
 	validationReq := &ReadNotificationRequestInternal{
 		NotificationID: req.GetNotificationId(),
 	}
 
 	if err := validate.Struct(validationReq); err != nil {
-		h.log.Error("Validation failed for read notification request",
+		h.log.ErrorContext(ctx, "Validation failed for read notification request",
 			slog.Int64("notification_id", req.GetNotificationId()),
 			slog.String("error", err.Error()))
 		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
@@ -57,23 +57,23 @@ func (h *ReadNotificationHandler) Handle(ctx context.Context, req *pb.ReadNotifi
 	if err != nil {
 		switch {
 		case errors.Is(err, custom_errors.ErrInvalidInput):
-			h.log.Error("Invalid input for read notification",
+			h.log.ErrorContext(ctx, "Invalid input for read notification",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
 		case errors.Is(err, custom_errors.ErrNotificationNotFound):
-			h.log.Error("Notification not found",
+			h.log.ErrorContext(ctx, "Notification not found",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
 		default:
-			h.log.Error("Internal service error while reading notification",
+			h.log.ErrorContext(ctx, "Internal service error while reading notification",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
 		}
 	}
 
-	h.log.Info("Successfully marked notification as read", slog.Int64("notification_id", req.GetNotificationId()))
+	h.log.InfoContext(ctx, "Successfully marked notification as read", slog.Int64("notification_id", req.GetNotificationId()))
 	return &emptypb.Empty{}, nil
 }
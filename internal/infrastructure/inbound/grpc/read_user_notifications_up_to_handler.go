@@ -0,0 +1,109 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type NotificationsUpToReader interface {
+	ReadUserNotificationsUpTo(ctx context.Context, userID int64, watermark *model.ReadWatermark) (int64, error)
+}
+
+type ReadUserNotificationsUpToHandler struct {
+	notificationService NotificationsUpToReader
+	log                 ports.Logger
+}
+
+func NewReadUserNotificationsUpToHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *ReadUserNotificationsUpToHandler {
+	return &ReadUserNotificationsUpToHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type ReadUserNotificationsUpToRequestInternal struct {
+	UserID            int64    `validate:"required,gt=0"`
+	HasReadBefore     bool     `validate:"required_without=MaxNotificationID"`
+	MaxNotificationID int64    `validate:"gte=0"`
+	Types             []string `validate:"dive,required"`
+}
+
+func (h *ReadUserNotificationsUpToHandler) Handle(ctx context.Context, req *extpb.ReadUserNotificationsUpToRequest) (*extpb.ReadUserNotificationsUpToResponse, error) {
+	h.log.InfoContext(ctx, "Processing read user notifications up to request",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int64("max_notification_id", req.GetMaxNotificationId()),
+		slog.Bool("has_read_before", req.GetReadBefore() != nil),
+		slog.Int("types_count", len(req.GetTypes())))
+
+	validationReq := &ReadUserNotificationsUpToRequestInternal{
+		UserID:            req.GetUserId(),
+		HasReadBefore:     req.GetReadBefore() != nil,
+		MaxNotificationID: req.GetMaxNotificationId(),
+		Types:             req.GetTypes(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for read user notifications up to request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	watermark := &model.ReadWatermark{}
+	if req.GetReadBefore() != nil {
+		readBefore := req.GetReadBefore().AsTime()
+		watermark.ReadBefore = &readBefore
+	}
+	if req.GetMaxNotificationId() > 0 {
+		maxID := req.GetMaxNotificationId()
+		watermark.MaxID = &maxID
+	}
+	for _, t := range req.GetTypes() {
+		watermark.Types = append(watermark.Types, events.EventType(t))
+	}
+
+	updated, err := h.notificationService.ReadUserNotificationsUpTo(ctx, req.GetUserId(), watermark)
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for read user notifications up to",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrUserNotFound):
+			h.log.ErrorContext(ctx, "User not found for read user notifications up to request",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while reading user notifications up to watermark",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully marked notifications as read up to watermark",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.Int64("updated_count", updated))
+
+	return &extpb.ReadUserNotificationsUpToResponse{
+		UpdatedCount: updated,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReadUserNotificationsUpToHandler_Handle(t *testing.T) {
+	readBefore := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.ReadUserNotificationsUpToRequest
+		mockSetup      func(*mocks.NotificationService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedCount  int64
+	}{
+		{
+			name: "successful read up to timestamp",
+			req: &extpb.ReadUserNotificationsUpToRequest{
+				UserId:     1,
+				ReadBefore: timestamppb.New(readBefore),
+				Types:      []string{"follow_created"},
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("ReadUserNotificationsUpTo", mock.Anything, int64(1), mock.MatchedBy(func(w *model.ReadWatermark) bool {
+					return w.ReadBefore != nil && w.ReadBefore.Equal(readBefore) &&
+						w.MaxID == nil &&
+						len(w.Types) == 1 && w.Types[0] == "follow_created"
+				})).Return(int64(3), nil)
+			},
+			wantErr:       false,
+			expectedCount: 3,
+		},
+		{
+			name: "successful read up to max notification ID",
+			req: &extpb.ReadUserNotificationsUpToRequest{
+				UserId:            1,
+				MaxNotificationId: 42,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("ReadUserNotificationsUpTo", mock.Anything, int64(1), mock.MatchedBy(func(w *model.ReadWatermark) bool {
+					return w.ReadBefore == nil && w.MaxID != nil && *w.MaxID == 42 && len(w.Types) == 0
+				})).Return(int64(0), nil)
+			},
+			wantErr:       false,
+			expectedCount: 0,
+		},
+		{
+			name: "validation error - no watermark",
+			req: &extpb.ReadUserNotificationsUpToRequest{
+				UserId: 1,
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - user ID zero",
+			req: &extpb.ReadUserNotificationsUpToRequest{
+				UserId:            0,
+				MaxNotificationId: 42,
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - empty type filter",
+			req: &extpb.ReadUserNotificationsUpToRequest{
+				UserId:            1,
+				MaxNotificationId: 42,
+				Types:             []string{""},
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "service returns invalid input",
+			req: &extpb.ReadUserNotificationsUpToRequest{
+				UserId:            5,
+				MaxNotificationId: 42,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("ReadUserNotificationsUpTo", mock.Anything, int64(5), mock.Anything).Return(int64(0), custom_errors.ErrInvalidInput)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "invalid input",
+		},
+		{
+			name: "internal service error",
+			req: &extpb.ReadUserNotificationsUpToRequest{
+				UserId:            1,
+				MaxNotificationId: 42,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("ReadUserNotificationsUpTo", mock.Anything, int64(1), mock.Anything).Return(int64(0), errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewNotificationService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewReadUserNotificationsUpToHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+				assert.Equal(t, tt.expectedCount, resp.GetUpdatedCount())
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
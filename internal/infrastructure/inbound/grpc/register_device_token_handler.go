@@ -0,0 +1,89 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	model "pinstack-notification-service/internal/domain/models"
+	device_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type DeviceTokenRegisterer interface {
+	RegisterDeviceToken(ctx context.Context, token *model.DeviceToken) error
+}
+
+type RegisterDeviceTokenHandler struct {
+	deviceService DeviceTokenRegisterer
+	log           ports.Logger
+}
+
+func NewRegisterDeviceTokenHandler(
+	deviceService device_service.DeviceService,
+	log ports.Logger,
+) *RegisterDeviceTokenHandler {
+	return &RegisterDeviceTokenHandler{
+		deviceService: deviceService,
+		log:           log,
+	}
+}
+
+type RegisterDeviceTokenRequestInternal struct {
+	UserID   int64  `validate:"required,gt=0"`
+	Token    string `validate:"required,max=4096"`
+	Platform string `validate:"required,oneof=android ios"`
+	Locale   string `validate:"omitempty,bcp47_language_tag"`
+}
+
+func (h *RegisterDeviceTokenHandler) Handle(ctx context.Context, req *extpb.RegisterDeviceTokenRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing register device token request",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.String("platform", req.GetPlatform()))
+
+	validationReq := &RegisterDeviceTokenRequestInternal{
+		UserID:   req.GetUserId(),
+		Token:    req.GetToken(),
+		Platform: req.GetPlatform(),
+		Locale:   req.GetLocale(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for register device token request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.deviceService.RegisterDeviceToken(ctx, &model.DeviceToken{
+		UserID:   req.GetUserId(),
+		Token:    req.GetToken(),
+		Platform: model.DevicePlatform(req.GetPlatform()),
+		Locale:   req.GetLocale(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for register device token",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while registering device token",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully registered device token", slog.Int64("user_id", req.GetUserId()))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,108 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegisterDeviceTokenHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.RegisterDeviceTokenRequest
+		mockSetup      func(*mocks.DeviceService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful register",
+			req:  &extpb.RegisterDeviceTokenRequest{UserId: 1, Token: "fcm-token", Platform: "android", Locale: "ru-RU"},
+			mockSetup: func(mockService *mocks.DeviceService) {
+				mockService.On("RegisterDeviceToken", mock.Anything, &model.DeviceToken{
+					UserID:   1,
+					Token:    "fcm-token",
+					Platform: model.DevicePlatformAndroid,
+					Locale:   "ru-RU",
+				}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:           "validation error - unknown platform",
+			req:            &extpb.RegisterDeviceTokenRequest{UserId: 1, Token: "token", Platform: "windows"},
+			mockSetup:      func(mockService *mocks.DeviceService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - bad locale",
+			req:            &extpb.RegisterDeviceTokenRequest{UserId: 1, Token: "token", Platform: "ios", Locale: "not a locale"},
+			mockSetup:      func(mockService *mocks.DeviceService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - missing token",
+			req:            &extpb.RegisterDeviceTokenRequest{UserId: 1, Platform: "ios"},
+			mockSetup:      func(mockService *mocks.DeviceService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.RegisterDeviceTokenRequest{UserId: 1, Token: "apns-token", Platform: "ios"},
+			mockSetup: func(mockService *mocks.DeviceService) {
+				mockService.On("RegisterDeviceToken", mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDeviceService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewRegisterDeviceTokenHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -40,14 +40,14 @@ type RemoveNotificationRequestInternal struct {
 }
 
 func (h *RemoveNotificationHandler) Handle(ctx context.Context, req *pb.RemoveNotificationRequest) (*emptypb.Empty, error) {
-	h.log.Info("Processing remove notification request", slog.Int64("notification_id", req.GetNotificationId()))
+	h.log.InfoContext(ctx, "Processing remove notification request", slog.Int64("notification_id", req.GetNotificationId()))
 
 	validationReq := &RemoveNotificationRequestInternal{
 		NotificationID: req.GetNotificationId(),
 	}
 
 	if err := validate.Struct(validationReq); err != nil {
-		h.log.Error("Validation failed for remove notification request",
+		h.log.ErrorContext(ctx, "Validation failed for remove notification request",
 			slog.Int64("notification_id", req.GetNotificationId()),
 			slog.String("error", err.Error()))
 		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
@@ -57,23 +57,23 @@ func (h *RemoveNotificationHandler) Handle(ctx context.Context, req *pb.RemoveNo
 	if err != nil {
 		switch {
 		case errors.Is(err, custom_errors.ErrInvalidInput):
-			h.log.Error("Invalid input for remove notification",
+			h.log.ErrorContext(ctx, "Invalid input for remove notification",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
 		case errors.Is(err, custom_errors.ErrNotificationNotFound):
-			h.log.Error("Notification not found for remove request",
+			h.log.ErrorContext(ctx, "Notification not found for remove request",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
 		default:
-			h.log.Error("Internal service error while removing notification",
+			h.log.ErrorContext(ctx, "Internal service error while removing notification",
 				slog.Int64("notification_id", req.GetNotificationId()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
 		}
 	}
 
-	h.log.Info("Successfully removed notification", slog.Int64("notification_id", req.GetNotificationId()))
+	h.log.InfoContext(ctx, "Successfully removed notification", slog.Int64("notification_id", req.GetNotificationId()))
 	return &emptypb.Empty{}, nil
 }
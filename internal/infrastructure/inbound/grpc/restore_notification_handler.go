@@ -0,0 +1,80 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type NotificationRestorer interface {
+	RestoreNotification(ctx context.Context, id int64) error
+}
+
+type RestoreNotificationHandler struct {
+	notificationService NotificationRestorer
+	log                 ports.Logger
+}
+
+func NewRestoreNotificationHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *RestoreNotificationHandler {
+	return &RestoreNotificationHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type RestoreNotificationRequestInternal struct {
+	NotificationID int64 `validate:"required,gt=0"`
+}
+
+func (h *RestoreNotificationHandler) Handle(ctx context.Context, req *extpb.RestoreNotificationRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing restore notification request", slog.Int64("notification_id", req.GetNotificationId()))
+
+	validationReq := &RestoreNotificationRequestInternal{
+		NotificationID: req.GetNotificationId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for restore notification request",
+			slog.Int64("notification_id", req.GetNotificationId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.notificationService.RestoreNotification(ctx, req.GetNotificationId())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for restore notification",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrNotificationNotFound):
+			h.log.ErrorContext(ctx, "Notification not found for restore request",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while restoring notification",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully restored notification", slog.Int64("notification_id", req.GetNotificationId()))
+	return &emptypb.Empty{}, nil
+}
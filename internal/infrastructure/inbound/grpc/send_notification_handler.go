@@ -44,7 +44,7 @@ type SendNotificationRequestInternal struct {
 }
 
 func (h *SendNotificationHandler) Handle(ctx context.Context, req *pb.SendNotificationRequest) (*pb.SendNotificationResponse, error) {
-	h.log.Info("Processing send notification request",
+	h.log.InfoContext(ctx, "Processing send notification request",
 		slog.Int64("user_id", req.GetUserId()),
 		slog.String("type", req.GetType()),
 		slog.Int("payload_size", len(req.GetPayload())))
@@ -56,7 +56,7 @@ func (h *SendNotificationHandler) Handle(ctx context.Context, req *pb.SendNotifi
 	}
 
 	if err := validate.Struct(validationReq); err != nil {
-		h.log.Error("Validation failed for send notification request",
+		h.log.ErrorContext(ctx, "Validation failed for send notification request",
 			slog.Int64("user_id", req.GetUserId()),
 			slog.String("type", req.GetType()),
 			slog.String("error", err.Error()))
@@ -74,19 +74,24 @@ func (h *SendNotificationHandler) Handle(ctx context.Context, req *pb.SendNotifi
 	if err != nil {
 		switch {
 		case errors.Is(err, custom_errors.ErrInvalidInput):
-			h.log.Error("Invalid input for send notification",
+			h.log.ErrorContext(ctx, "Invalid input for send notification",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("type", req.GetType()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			h.log.Error("User not found when sending notification",
+			h.log.ErrorContext(ctx, "User not found when sending notification",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("type", req.GetType()),
 				slog.String("error", err.Error()))
 			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
+		case errors.Is(err, model.ErrNotificationSuppressed):
+			h.log.InfoContext(ctx, "Notification suppressed by spam protection",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("type", req.GetType()))
+			return nil, status.Error(codes.ResourceExhausted, model.ErrNotificationSuppressed.Error())
 		default:
-			h.log.Error("Internal service error while sending notification",
+			h.log.ErrorContext(ctx, "Internal service error while sending notification",
 				slog.Int64("user_id", req.GetUserId()),
 				slog.String("type", req.GetType()),
 				slog.String("error", err.Error()))
@@ -94,7 +99,7 @@ func (h *SendNotificationHandler) Handle(ctx context.Context, req *pb.SendNotifi
 		}
 	}
 
-	h.log.Info("Successfully sent notification",
+	h.log.InfoContext(ctx, "Successfully sent notification",
 		slog.Int64("notification_id", notificationID),
 		slog.Int64("user_id", notification.UserID),
 		slog.String("type", string(notification.Type)))
@@ -102,6 +102,20 @@ func TestSendNotificationHandler_Handle(t *testing.T) {
 			expectedCode:   codes.NotFound,
 			expectedErrMsg: "user not found",
 		},
+		{
+			name: "suppressed by spam protection",
+			req: &pb.SendNotificationRequest{
+				UserId:  1,
+				Type:    "follow_created",
+				Payload: payload,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotification", mock.Anything, mock.Anything).Return(int64(0), model.ErrNotificationSuppressed)
+			},
+			wantErr:        true,
+			expectedCode:   codes.ResourceExhausted,
+			expectedErrMsg: "notification suppressed",
+		},
 		{
 			name: "invalid input - negative user ID",
 			req: &pb.SendNotificationRequest{
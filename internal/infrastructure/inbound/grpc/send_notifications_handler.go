@@ -0,0 +1,180 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type NotificationBatchSender interface {
+	SaveNotifications(ctx context.Context, notifications []*model.Notification) ([]error, error)
+}
+
+type SendNotificationsHandler struct {
+	notificationService NotificationBatchSender
+	log                 ports.Logger
+}
+
+func NewSendNotificationsHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *SendNotificationsHandler {
+	return &SendNotificationsHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type SendNotificationsRequestInternal struct {
+	Notifications []*extpb.CreateNotificationRequest `validate:"required,min=1,max=500"`
+}
+
+func (h *SendNotificationsHandler) Handle(ctx context.Context, req *extpb.SendNotificationsRequest) (*extpb.SendNotificationsResponse, error) {
+	h.log.InfoContext(ctx, "Processing send notifications request", slog.Int("count", len(req.GetNotifications())))
+
+	validationReq := &SendNotificationsRequestInternal{
+		Notifications: req.GetNotifications(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for send notifications request",
+			slog.Int("count", len(req.GetNotifications())),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	results := make([]*extpb.SendNotificationsResult, len(req.GetNotifications()))
+	notifications := make([]*model.Notification, 0, len(req.GetNotifications()))
+	indexes := make([]int, 0, len(req.GetNotifications()))
+	for i, item := range req.GetNotifications() {
+		results[i] = &extpb.SendNotificationsResult{Index: int32(i)}
+		notification, err := h.toNotification(item)
+		if err != nil {
+			h.log.DebugContext(ctx, "Validation failed for notification in batch",
+				slog.Int("index", i),
+				slog.Int64("user_id", item.GetUserId()),
+				slog.String("error", err.Error()))
+			results[i].Code = int32(codes.InvalidArgument)
+			results[i].Message = custom_errors.ErrValidationFailed.Error()
+			continue
+		}
+		notifications = append(notifications, notification)
+		indexes = append(indexes, i)
+	}
+
+	var errs []error
+	if len(notifications) > 0 {
+		var err error
+		errs, err = h.notificationService.SaveNotifications(ctx, notifications)
+		if err != nil {
+			switch {
+			case errors.Is(err, custom_errors.ErrInvalidInput):
+				h.log.ErrorContext(ctx, "Invalid input for send notifications",
+					slog.Int("count", len(notifications)),
+					slog.String("error", err.Error()))
+				return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+			default:
+				h.log.ErrorContext(ctx, "Internal service error while sending notifications",
+					slog.Int("count", len(notifications)),
+					slog.String("error", err.Error()))
+				return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+			}
+		}
+	}
+
+	for j, notification := range notifications {
+		result := results[indexes[j]]
+		if j < len(errs) && errs[j] != nil {
+			st := sendNotificationsItemStatus(errs[j])
+			result.Code = int32(st.Code())
+			result.Message = st.Message()
+			continue
+		}
+		result.NotificationId = notification.ID
+		result.Scheduled = notification.DeliverAt != nil
+	}
+
+	resp := &extpb.SendNotificationsResponse{Results: results}
+	for _, result := range results {
+		if result.GetCode() == int32(codes.OK) {
+			resp.Created++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully processed send notifications request",
+		slog.Int("count", len(results)),
+		slog.Int("created", int(resp.GetCreated())),
+		slog.Int("failed", int(resp.GetFailed())))
+
+	return resp, nil
+}
+
+// toNotification validates one item the way CreateNotificationHandler
+// validates its request.
+func (h *SendNotificationsHandler) toNotification(item *extpb.CreateNotificationRequest) (*model.Notification, error) {
+	validationReq := &CreateNotificationRequestInternal{
+		UserID:   item.GetUserId(),
+		Type:     item.GetType(),
+		Payload:  item.GetPayload(),
+		Priority: item.GetPriority(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		return nil, err
+	}
+
+	notification := &model.Notification{
+		UserID:   item.GetUserId(),
+		Type:     events.EventType(item.GetType()),
+		Payload:  item.GetPayload(),
+		Priority: model.NotificationPriority(item.GetPriority()),
+	}
+
+	if item.GetDeliverAt() != nil {
+		if err := item.GetDeliverAt().CheckValid(); err != nil {
+			return nil, err
+		}
+		deliverAt := item.GetDeliverAt().AsTime()
+		notification.DeliverAt = &deliverAt
+	}
+
+	if item.GetExpiresAt() != nil {
+		if err := item.GetExpiresAt().CheckValid(); err != nil {
+			return nil, err
+		}
+		expiresAt := item.GetExpiresAt().AsTime()
+		notification.ExpiresAt = &expiresAt
+	}
+
+	return notification, nil
+}
+
+// sendNotificationsItemStatus is the status CreateNotification returns for
+// the same error.
+func sendNotificationsItemStatus(err error) *status.Status {
+	switch {
+	case errors.Is(err, custom_errors.ErrInvalidInput):
+		return status.New(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+	case errors.Is(err, custom_errors.ErrUserNotFound):
+		return status.New(codes.NotFound, custom_errors.ErrUserNotFound.Error())
+	case errors.Is(err, model.ErrNotificationSuppressed):
+		return status.New(codes.ResourceExhausted, model.ErrNotificationSuppressed.Error())
+	default:
+		return status.New(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+	}
+}
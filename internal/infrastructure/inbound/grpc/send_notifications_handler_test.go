@@ -0,0 +1,137 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSendNotificationsHandler_Handle(t *testing.T) {
+	payload := []byte(`{"pin_id":5}`)
+	deliverAt := time.Now().Add(time.Hour).UTC()
+
+	tests := []struct {
+		name           string
+		req            *extpb.SendNotificationsRequest
+		mockSetup      func(*mocks.NotificationService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		wantCodes      []codes.Code
+		wantIDs        []int64
+	}{
+		{
+			name: "partial failure",
+			req: &extpb.SendNotificationsRequest{Notifications: []*extpb.CreateNotificationRequest{
+				{UserId: 1, Type: "new_pin", Payload: payload},
+				{UserId: 0, Type: "new_pin", Payload: payload},
+				{UserId: 2, Type: "new_pin", Payload: payload},
+				{UserId: 3, Type: "new_pin", Payload: payload, DeliverAt: timestamppb.New(deliverAt)},
+				{UserId: 4, Type: "follow_created", Payload: payload},
+			}},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotifications", mock.Anything, mock.MatchedBy(func(ns []*model.Notification) bool {
+					return len(ns) == 4 && ns[0].UserID == 1 && ns[1].UserID == 2 && ns[2].DeliverAt != nil
+				})).Run(func(args mock.Arguments) {
+					ns := args.Get(1).([]*model.Notification)
+					ns[0].ID = 10
+					ns[2].ID = 12
+				}).Return([]error{nil, custom_errors.ErrUserNotFound, nil, model.ErrNotificationSuppressed}, nil)
+			},
+			wantCodes: []codes.Code{codes.OK, codes.InvalidArgument, codes.NotFound, codes.OK, codes.ResourceExhausted},
+			wantIDs:   []int64{10, 0, 0, 12, 0},
+		},
+		{
+			name: "every item invalid",
+			req: &extpb.SendNotificationsRequest{Notifications: []*extpb.CreateNotificationRequest{
+				{UserId: 1, Payload: payload},
+				{UserId: 1, Type: "new_pin", Payload: payload, Priority: "urgent"},
+			}},
+			wantCodes: []codes.Code{codes.InvalidArgument, codes.InvalidArgument},
+			wantIDs:   []int64{0, 0},
+		},
+		{
+			name:           "validation error - empty batch",
+			req:            &extpb.SendNotificationsRequest{},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - batch too large",
+			req:            &extpb.SendNotificationsRequest{Notifications: make([]*extpb.CreateNotificationRequest, 501)},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req: &extpb.SendNotificationsRequest{Notifications: []*extpb.CreateNotificationRequest{
+				{UserId: 1, Type: "new_pin", Payload: payload},
+			}},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("SaveNotifications", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewNotificationService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewSendNotificationsHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, resp.GetResults(), len(tt.wantCodes))
+				created := 0
+				for i, result := range resp.GetResults() {
+					assert.Equal(t, int32(i), result.GetIndex())
+					assert.Equal(t, int32(tt.wantCodes[i]), result.GetCode(), "item %d", i)
+					assert.Equal(t, tt.wantIDs[i], result.GetNotificationId(), "item %d", i)
+					if tt.wantCodes[i] == codes.OK {
+						created++
+					} else {
+						assert.NotEmpty(t, result.GetMessage())
+					}
+				}
+				assert.Equal(t, int32(created), resp.GetCreated())
+				assert.Equal(t, int32(len(tt.wantCodes)-created), resp.GetFailed())
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
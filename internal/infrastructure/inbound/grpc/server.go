@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
 	ports "pinstack-notification-service/internal/domain/ports/output"
 	"pinstack-notification-service/internal/infrastructure/inbound/middleware"
+	"pinstack-notification-service/internal/infrastructure/logger"
 	"runtime/debug"
 
 	"google.golang.org/grpc/codes"
@@ -14,25 +16,33 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/notification/v1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type Server struct {
 	notificationGRPCService *NotificationGRPCService
+	healthServer            grpc_health_v1.HealthServer
 	server                  *grpc.Server
 	address                 string
 	port                    int
 	log                     ports.Logger
 	metrics                 ports.MetricsProvider
+	logSampler              *logger.Sampler
+	rateLimiter             *middleware.RateLimiter
 }
 
-func NewServer(grpcService *NotificationGRPCService, address string, port int, log ports.Logger, metrics ports.MetricsProvider) *Server {
+func NewServer(grpcService *NotificationGRPCService, healthServer grpc_health_v1.HealthServer, address string, port int, log ports.Logger, metrics ports.MetricsProvider, logSampler *logger.Sampler, rateLimiter *middleware.RateLimiter) *Server {
 	return &Server{
 		notificationGRPCService: grpcService,
+		healthServer:            healthServer,
 		address:                 address,
 		port:                    port,
 		log:                     log,
 		metrics:                 metrics,
+		logSampler:              logSampler,
+		rateLimiter:             rateLimiter,
 	}
 }
 
@@ -50,15 +60,31 @@ func (s *Server) Run() error {
 		}),
 	}
 
+	unary := []grpc.UnaryServerInterceptor{
+		middleware.UnaryCorrelationInterceptor(),
+		middleware.UnaryLoggerInterceptor(s.log, s.logSampler),
+		middleware.UnaryMetricsInterceptor(s.metrics),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		middleware.StreamCorrelationInterceptor(),
+	}
+	// Limited calls still go through the logger and metrics interceptors.
+	if s.rateLimiter != nil {
+		unary = append(unary, s.rateLimiter.UnaryInterceptor())
+		stream = append(stream, s.rateLimiter.StreamInterceptor())
+	}
+	unary = append(unary, grpc_recovery.UnaryServerInterceptor(opts...))
+	stream = append(stream, grpc_recovery.StreamServerInterceptor(opts...))
+
 	s.server = grpc.NewServer(
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			middleware.UnaryLoggerInterceptor(s.log),
-			middleware.UnaryMetricsInterceptor(s.metrics),
-			grpc_recovery.UnaryServerInterceptor(opts...),
-		)),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unary...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(stream...)),
 	)
 
 	pb.RegisterNotificationServiceServer(s.server, s.notificationGRPCService)
+	extpb.RegisterNotificationExtServiceServer(s.server, s.notificationGRPCService)
+	grpc_health_v1.RegisterHealthServer(s.server, s.healthServer)
 
 	s.log.Info("Starting gRPC server", slog.Int("port", s.port))
 	return s.server.Serve(lis)
@@ -0,0 +1,89 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	model "pinstack-notification-service/internal/domain/models"
+	delivery_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type ChannelPreferenceSetter interface {
+	SetChannelPreference(ctx context.Context, preference *model.ChannelPreference) error
+}
+
+type SetChannelPreferenceHandler struct {
+	deliveryService ChannelPreferenceSetter
+	log             ports.Logger
+}
+
+func NewSetChannelPreferenceHandler(
+	deliveryService delivery_service.DeliveryService,
+	log ports.Logger,
+) *SetChannelPreferenceHandler {
+	return &SetChannelPreferenceHandler{
+		deliveryService: deliveryService,
+		log:             log,
+	}
+}
+
+type SetChannelPreferenceRequestInternal struct {
+	UserID  int64  `validate:"required,gt=0"`
+	Channel string `validate:"required,oneof=email push digest"`
+}
+
+func (h *SetChannelPreferenceHandler) Handle(ctx context.Context, req *extpb.SetChannelPreferenceRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing set channel preference request",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.String("channel", req.GetPreference().GetChannel()),
+		slog.Bool("enabled", req.GetPreference().GetEnabled()))
+
+	validationReq := &SetChannelPreferenceRequestInternal{
+		UserID:  req.GetUserId(),
+		Channel: req.GetPreference().GetChannel(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for set channel preference request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	preference := &model.ChannelPreference{
+		UserID:  req.GetUserId(),
+		Type:    events.EventType(req.GetPreference().GetType()),
+		Channel: model.Channel(req.GetPreference().GetChannel()),
+		Enabled: req.GetPreference().GetEnabled(),
+	}
+
+	err := h.deliveryService.SetChannelPreference(ctx, preference)
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for set channel preference",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while setting channel preference",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully set channel preference", slog.Int64("user_id", req.GetUserId()))
+	return &emptypb.Empty{}, nil
+}
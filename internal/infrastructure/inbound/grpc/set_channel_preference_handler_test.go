@@ -0,0 +1,122 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetChannelPreferenceHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.SetChannelPreferenceRequest
+		mockSetup      func(*mocks.DeliveryService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful set channel preference",
+			req: &extpb.SetChannelPreferenceRequest{
+				UserId:     1,
+				Preference: &extpb.ChannelPreference{Type: "follow_created", Channel: "email", Enabled: false},
+			},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("SetChannelPreference", mock.Anything, &model.ChannelPreference{
+					UserID:  1,
+					Type:    "follow_created",
+					Channel: model.ChannelEmail,
+					Enabled: false,
+				}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "validation error - user ID zero",
+			req: &extpb.SetChannelPreferenceRequest{
+				UserId:     0,
+				Preference: &extpb.ChannelPreference{Channel: "email"},
+			},
+			mockSetup:      func(mockService *mocks.DeliveryService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - unknown channel",
+			req: &extpb.SetChannelPreferenceRequest{
+				UserId:     1,
+				Preference: &extpb.ChannelPreference{Channel: "sms"},
+			},
+			mockSetup:      func(mockService *mocks.DeliveryService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - missing preference",
+			req: &extpb.SetChannelPreferenceRequest{
+				UserId: 1,
+			},
+			mockSetup:      func(mockService *mocks.DeliveryService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req: &extpb.SetChannelPreferenceRequest{
+				UserId:     1,
+				Preference: &extpb.ChannelPreference{Channel: "push", Enabled: true},
+			},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("SetChannelPreference", mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDeliveryService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewSetChannelPreferenceHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	model "pinstack-notification-service/internal/domain/models"
+	digest_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type DigestFrequencySetter interface {
+	SetDigestFrequency(ctx context.Context, userID int64, frequency model.DigestFrequency) error
+}
+
+type SetDigestFrequencyHandler struct {
+	digestService DigestFrequencySetter
+	log           ports.Logger
+}
+
+func NewSetDigestFrequencyHandler(
+	digestService digest_service.DigestService,
+	log ports.Logger,
+) *SetDigestFrequencyHandler {
+	return &SetDigestFrequencyHandler{
+		digestService: digestService,
+		log:           log,
+	}
+}
+
+type SetDigestFrequencyRequestInternal struct {
+	UserID    int64  `validate:"required,gt=0"`
+	Frequency string `validate:"required,oneof=daily weekly off"`
+}
+
+func (h *SetDigestFrequencyHandler) Handle(ctx context.Context, req *extpb.SetDigestFrequencyRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing set digest frequency request",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.String("frequency", req.GetFrequency()))
+
+	validationReq := &SetDigestFrequencyRequestInternal{
+		UserID:    req.GetUserId(),
+		Frequency: req.GetFrequency(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for set digest frequency request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.digestService.SetDigestFrequency(ctx, req.GetUserId(), model.DigestFrequency(req.GetFrequency()))
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for set digest frequency",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while setting digest frequency",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully set digest frequency",
+		slog.Int64("user_id", req.GetUserId()),
+		slog.String("frequency", req.GetFrequency()))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,95 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetDigestFrequencyHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.SetDigestFrequencyRequest
+		mockSetup      func(*mocks.DigestService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful subscribe",
+			req:  &extpb.SetDigestFrequencyRequest{UserId: 1, Frequency: "weekly"},
+			mockSetup: func(mockService *mocks.DigestService) {
+				mockService.On("SetDigestFrequency", mock.Anything, int64(1), model.DigestFrequencyWeekly).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:           "validation error - unknown frequency",
+			req:            &extpb.SetDigestFrequencyRequest{UserId: 1, Frequency: "hourly"},
+			mockSetup:      func(mockService *mocks.DigestService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name:           "validation error - zero user ID",
+			req:            &extpb.SetDigestFrequencyRequest{Frequency: "daily"},
+			mockSetup:      func(mockService *mocks.DigestService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.SetDigestFrequencyRequest{UserId: 1, Frequency: "off"},
+			mockSetup: func(mockService *mocks.DigestService) {
+				mockService.On("SetDigestFrequency", mock.Anything, int64(1), model.DigestFrequencyOff).Return(errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDigestService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewSetDigestFrequencyHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type NotificationPinner interface {
+	SetNotificationPinned(ctx context.Context, id int64, pinned bool) error
+}
+
+type SetNotificationPinnedHandler struct {
+	notificationService NotificationPinner
+	log                 ports.Logger
+}
+
+func NewSetNotificationPinnedHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *SetNotificationPinnedHandler {
+	return &SetNotificationPinnedHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type SetNotificationPinnedRequestInternal struct {
+	NotificationID int64 `validate:"required,gt=0"`
+}
+
+func (h *SetNotificationPinnedHandler) Handle(ctx context.Context, req *extpb.SetNotificationPinnedRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing set notification pinned request",
+		slog.Int64("notification_id", req.GetNotificationId()),
+		slog.Bool("pinned", req.GetPinned()))
+
+	validationReq := &SetNotificationPinnedRequestInternal{
+		NotificationID: req.GetNotificationId(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for set notification pinned request",
+			slog.Int64("notification_id", req.GetNotificationId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.notificationService.SetNotificationPinned(ctx, req.GetNotificationId(), req.GetPinned())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for set notification pinned",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrNotificationNotFound):
+			h.log.ErrorContext(ctx, "Notification not found for set pinned request",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
+		case errors.Is(err, custom_errors.ErrOperationNotAllowed):
+			h.log.ErrorContext(ctx, "Pinning not allowed for notification",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.FailedPrecondition, custom_errors.ErrOperationNotAllowed.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while setting notification pinned flag",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully set notification pinned flag",
+		slog.Int64("notification_id", req.GetNotificationId()),
+		slog.Bool("pinned", req.GetPinned()))
+	return &emptypb.Empty{}, nil
+}
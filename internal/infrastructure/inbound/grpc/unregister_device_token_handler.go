@@ -0,0 +1,82 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	device_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type DeviceTokenUnregisterer interface {
+	UnregisterDeviceToken(ctx context.Context, userID int64, token string) error
+}
+
+type UnregisterDeviceTokenHandler struct {
+	deviceService DeviceTokenUnregisterer
+	log           ports.Logger
+}
+
+func NewUnregisterDeviceTokenHandler(
+	deviceService device_service.DeviceService,
+	log ports.Logger,
+) *UnregisterDeviceTokenHandler {
+	return &UnregisterDeviceTokenHandler{
+		deviceService: deviceService,
+		log:           log,
+	}
+}
+
+type UnregisterDeviceTokenRequestInternal struct {
+	UserID int64  `validate:"required,gt=0"`
+	Token  string `validate:"required"`
+}
+
+func (h *UnregisterDeviceTokenHandler) Handle(ctx context.Context, req *extpb.UnregisterDeviceTokenRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing unregister device token request", slog.Int64("user_id", req.GetUserId()))
+
+	validationReq := &UnregisterDeviceTokenRequestInternal{
+		UserID: req.GetUserId(),
+		Token:  req.GetToken(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for unregister device token request",
+			slog.Int64("user_id", req.GetUserId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.deviceService.UnregisterDeviceToken(ctx, req.GetUserId(), req.GetToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for unregister device token",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrNotificationNotFound):
+			h.log.ErrorContext(ctx, "Device token not found",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while unregistering device token",
+				slog.Int64("user_id", req.GetUserId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully unregistered device token", slog.Int64("user_id", req.GetUserId()))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,96 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnregisterDeviceTokenHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.UnregisterDeviceTokenRequest
+		mockSetup      func(*mocks.DeviceService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful unregister",
+			req:  &extpb.UnregisterDeviceTokenRequest{UserId: 1, Token: "fcm-token"},
+			mockSetup: func(mockService *mocks.DeviceService) {
+				mockService.On("UnregisterDeviceToken", mock.Anything, int64(1), "fcm-token").Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:           "validation error - missing token",
+			req:            &extpb.UnregisterDeviceTokenRequest{UserId: 1},
+			mockSetup:      func(mockService *mocks.DeviceService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "token not found",
+			req:  &extpb.UnregisterDeviceTokenRequest{UserId: 1, Token: "unknown"},
+			mockSetup: func(mockService *mocks.DeviceService) {
+				mockService.On("UnregisterDeviceToken", mock.Anything, int64(1), "unknown").Return(custom_errors.ErrNotificationNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrNotificationNotFound.Error(),
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.UnregisterDeviceTokenRequest{UserId: 1, Token: "fcm-token"},
+			mockSetup: func(mockService *mocks.DeviceService) {
+				mockService.On("UnregisterDeviceToken", mock.Anything, int64(1), "fcm-token").Return(errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDeviceService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewUnregisterDeviceTokenHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	delivery_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type Unsubscriber interface {
+	Unsubscribe(ctx context.Context, token string) error
+}
+
+type UnsubscribeHandler struct {
+	deliveryService Unsubscriber
+	log             ports.Logger
+}
+
+func NewUnsubscribeHandler(
+	deliveryService delivery_service.DeliveryService,
+	log ports.Logger,
+) *UnsubscribeHandler {
+	return &UnsubscribeHandler{
+		deliveryService: deliveryService,
+		log:             log,
+	}
+}
+
+type UnsubscribeRequestInternal struct {
+	Token string `validate:"required"`
+}
+
+func (h *UnsubscribeHandler) Handle(ctx context.Context, req *extpb.UnsubscribeRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing unsubscribe request")
+
+	validationReq := &UnsubscribeRequestInternal{
+		Token: req.GetToken(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for unsubscribe request", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.deliveryService.Unsubscribe(ctx, req.GetToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid unsubscribe token", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrOperationNotAllowed):
+			h.log.ErrorContext(ctx, "Unsubscribe links are not enabled", slog.String("error", err.Error()))
+			return nil, status.Error(codes.FailedPrecondition, custom_errors.ErrOperationNotAllowed.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while unsubscribing", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully unsubscribed")
+	return &emptypb.Empty{}, nil
+}
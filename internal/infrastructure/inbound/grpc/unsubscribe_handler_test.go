@@ -0,0 +1,106 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnsubscribeHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.UnsubscribeRequest
+		mockSetup      func(*mocks.DeliveryService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful unsubscribe",
+			req:  &extpb.UnsubscribeRequest{Token: "payload.signature"},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("Unsubscribe", mock.Anything, "payload.signature").Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:           "validation error - empty token",
+			req:            &extpb.UnsubscribeRequest{},
+			mockSetup:      func(mockService *mocks.DeliveryService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "invalid token",
+			req:  &extpb.UnsubscribeRequest{Token: "forged"},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("Unsubscribe", mock.Anything, "forged").Return(custom_errors.ErrInvalidInput)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "invalid input",
+		},
+		{
+			name: "unsubscribe not configured",
+			req:  &extpb.UnsubscribeRequest{Token: "payload.signature"},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("Unsubscribe", mock.Anything, "payload.signature").Return(custom_errors.ErrOperationNotAllowed)
+			},
+			wantErr:        true,
+			expectedCode:   codes.FailedPrecondition,
+			expectedErrMsg: custom_errors.ErrOperationNotAllowed.Error(),
+		},
+		{
+			name: "internal service error",
+			req:  &extpb.UnsubscribeRequest{Token: "payload.signature"},
+			mockSetup: func(mockService *mocks.DeliveryService) {
+				mockService.On("Unsubscribe", mock.Anything, "payload.signature").Return(errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewDeliveryService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewUnsubscribeHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
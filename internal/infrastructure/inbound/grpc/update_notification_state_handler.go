@@ -0,0 +1,89 @@
+package notification_grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+type NotificationStateUpdater interface {
+	UpdateNotificationState(ctx context.Context, id int64, state model.NotificationState) error
+}
+
+type UpdateNotificationStateHandler struct {
+	notificationService NotificationStateUpdater
+	log                 ports.Logger
+}
+
+func NewUpdateNotificationStateHandler(
+	notificationService notification_service.NotificationService,
+	log ports.Logger,
+) *UpdateNotificationStateHandler {
+	return &UpdateNotificationStateHandler{
+		notificationService: notificationService,
+		log:                 log,
+	}
+}
+
+type UpdateNotificationStateRequestInternal struct {
+	NotificationID int64  `validate:"required,gt=0"`
+	State          string `validate:"required,oneof=unread read archived"`
+}
+
+func (h *UpdateNotificationStateHandler) Handle(ctx context.Context, req *extpb.UpdateNotificationStateRequest) (*emptypb.Empty, error) {
+	h.log.InfoContext(ctx, "Processing update notification state request",
+		slog.Int64("notification_id", req.GetNotificationId()),
+		slog.String("state", req.GetState().String()))
+
+	state := stateFromProto[req.GetState()]
+
+	validationReq := &UpdateNotificationStateRequestInternal{
+		NotificationID: req.GetNotificationId(),
+		State:          string(state),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		h.log.ErrorContext(ctx, "Validation failed for update notification state request",
+			slog.Int64("notification_id", req.GetNotificationId()),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+	}
+
+	err := h.notificationService.UpdateNotificationState(ctx, req.GetNotificationId(), state)
+	if err != nil {
+		switch {
+		case errors.Is(err, custom_errors.ErrInvalidInput):
+			h.log.ErrorContext(ctx, "Invalid input for update notification state",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrInvalidInput.Error())
+		case errors.Is(err, custom_errors.ErrNotificationNotFound):
+			h.log.ErrorContext(ctx, "Notification not found for update state request",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, custom_errors.ErrNotificationNotFound.Error())
+		default:
+			h.log.ErrorContext(ctx, "Internal service error while updating notification state",
+				slog.Int64("notification_id", req.GetNotificationId()),
+				slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
+		}
+	}
+
+	h.log.InfoContext(ctx, "Successfully updated notification state",
+		slog.Int64("notification_id", req.GetNotificationId()),
+		slog.String("state", string(state)))
+	return &emptypb.Empty{}, nil
+}
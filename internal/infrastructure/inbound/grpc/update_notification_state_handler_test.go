@@ -0,0 +1,131 @@
+package notification_grpc_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	notification_grpc "pinstack-notification-service/internal/infrastructure/inbound/grpc"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	extpb "pinstack-notification-service/gen/go/notification_ext/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateNotificationStateHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.UpdateNotificationStateRequest
+		mockSetup      func(*mocks.NotificationService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful mark as unread",
+			req: &extpb.UpdateNotificationStateRequest{
+				NotificationId: 1,
+				State:          extpb.NotificationState_NOTIFICATION_STATE_UNREAD,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("UpdateNotificationState", mock.Anything, int64(1), model.NotificationStateUnread).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "successful archive",
+			req: &extpb.UpdateNotificationStateRequest{
+				NotificationId: 2,
+				State:          extpb.NotificationState_NOTIFICATION_STATE_ARCHIVED,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("UpdateNotificationState", mock.Anything, int64(2), model.NotificationStateArchived).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "validation error - unspecified state",
+			req: &extpb.UpdateNotificationStateRequest{
+				NotificationId: 1,
+				State:          extpb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED,
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "validation error - notification ID zero",
+			req: &extpb.UpdateNotificationStateRequest{
+				NotificationId: 0,
+				State:          extpb.NotificationState_NOTIFICATION_STATE_READ,
+			},
+			mockSetup:      func(mockService *mocks.NotificationService) {},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "validation failed",
+		},
+		{
+			name: "notification not found",
+			req: &extpb.UpdateNotificationStateRequest{
+				NotificationId: 999,
+				State:          extpb.NotificationState_NOTIFICATION_STATE_READ,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("UpdateNotificationState", mock.Anything, int64(999), model.NotificationStateRead).Return(custom_errors.ErrNotificationNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: "notification not found",
+		},
+		{
+			name: "internal service error",
+			req: &extpb.UpdateNotificationStateRequest{
+				NotificationId: 1,
+				State:          extpb.NotificationState_NOTIFICATION_STATE_READ,
+			},
+			mockSetup: func(mockService *mocks.NotificationService) {
+				mockService.On("UpdateNotificationState", mock.Anything, int64(1), model.NotificationStateRead).Return(errors.New("database error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewNotificationService(t)
+			log := logger.New("dev")
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := notification_grpc.NewUpdateNotificationStateHandler(mockService, log)
+			resp, err := handler.Handle(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
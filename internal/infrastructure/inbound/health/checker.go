@@ -0,0 +1,254 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	grpc_health "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	StatusOK       = "ok"
+	StatusFail     = "fail"
+	StatusReady    = "ready"
+	StatusNotReady = "not_ready"
+	StatusDraining = "draining"
+)
+
+// Probe checks one dependency; a nil error means it can be used.
+type Probe func(ctx context.Context) error
+
+type DependencyStatus struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+// Report is the readiness of the service with the status of every probed
+// dependency.
+type Report struct {
+	Status string                      `json:"status"`
+	Checks map[string]DependencyStatus `json:"checks"`
+}
+
+func (r Report) Ready() bool {
+	return r.Status == StatusReady
+}
+
+type namedProbe struct {
+	name     string
+	probe    Probe
+	optional bool
+}
+
+// Checker probes the dependencies the service cannot work without and
+// reports the result over grpc.health.v1, /healthz and /readyz. Once Drain
+// is called it reports not serving for good.
+type Checker struct {
+	config   config.HealthConfig
+	probes   []namedProbe
+	grpc     *grpc_health.Server
+	services []string
+	draining atomic.Bool
+	log      ports.Logger
+	metrics  ports.MetricsProvider
+}
+
+// NewChecker creates a checker that reports the status of the whole server
+// ("") and of each of the given gRPC services.
+func NewChecker(cfg config.HealthConfig, log ports.Logger, metrics ports.MetricsProvider, services ...string) *Checker {
+	c := &Checker{
+		config:   cfg,
+		grpc:     grpc_health.NewServer(),
+		services: append([]string{""}, services...),
+		log:      log,
+		metrics:  metrics,
+	}
+	c.setServing(false)
+	return c
+}
+
+// Register adds a probe of a dependency the service cannot serve without.
+// It must be called before Start.
+func (c *Checker) Register(name string, probe Probe) {
+	c.probes = append(c.probes, namedProbe{name: name, probe: probe})
+}
+
+// RegisterOptional adds a probe that is only reported in the readiness
+// detail: while it fails the service stays ready and keeps serving gRPC.
+// It must be called before Start.
+func (c *Checker) RegisterOptional(name string, probe Probe) {
+	c.probes = append(c.probes, namedProbe{name: name, probe: probe, optional: true})
+}
+
+// GRPCServer is the grpc.health.v1 implementation to register on the
+// gRPC server.
+func (c *Checker) GRPCServer() grpc_health_v1.HealthServer {
+	return c.grpc
+}
+
+// Check runs all probes concurrently, each bounded by the probe timeout,
+// and publishes the result to the gRPC health service. Only the probes
+// added with Register decide the result.
+func (c *Checker) Check(ctx context.Context) Report {
+	report := Report{Status: StatusReady, Checks: make(map[string]DependencyStatus, len(c.probes))}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, p := range c.probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status := c.run(ctx, p)
+			mu.Lock()
+			report.Checks[p.name] = status
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	for _, p := range c.probes {
+		status := report.Checks[p.name]
+		if status.Status == StatusOK {
+			continue
+		}
+		if !p.optional {
+			report.Status = StatusNotReady
+		}
+		c.log.Warn("Dependency probe failed",
+			slog.String("dependency", p.name),
+			slog.Bool("optional", p.optional),
+			slog.String("error", status.Error))
+	}
+	if c.draining.Load() {
+		report.Status = StatusDraining
+	}
+
+	c.setServing(report.Ready())
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, p namedProbe) DependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.config.ProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("probe panicked: %v", r)
+			}
+		}()
+		return p.probe(ctx)
+	}()
+
+	status := DependencyStatus{Status: StatusOK, LatencyMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		status.Status = StatusFail
+		status.Error = err.Error()
+	}
+	return status
+}
+
+// Start re-checks the dependencies on every interval until ctx is done.
+func (c *Checker) Start(ctx context.Context) {
+	c.log.Info("Starting health checker",
+		slog.Duration("interval", c.config.Interval),
+		slog.Int("probes", len(c.probes)),
+	)
+
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+		select {
+		case <-ctx.Done():
+			c.log.Info("Stopping health checker", slog.String("reason", "context done"))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain switches every service to NOT_SERVING and keeps it there, so load
+// balancers stop routing calls before the server stops.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+	c.grpc.Shutdown()
+	c.metrics.SetServiceHealth(false)
+	c.log.Info("Health status switched to not serving")
+}
+
+func (c *Checker) setServing(serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	// After Drain the health server ignores updates.
+	for _, service := range c.services {
+		c.grpc.SetServingStatus(service, status)
+	}
+	c.metrics.SetServiceHealth(serving && !c.draining.Load())
+}
+
+// LivenessHandler answers 200 while the process can serve HTTP at all.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": StatusOK})
+	})
+}
+
+// ReadinessHandler probes the dependencies and answers 200 when all the
+// required ones are usable, 503 otherwise or while draining.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Check(r.Context())
+		code := http.StatusOK
+		if !report.Ready() {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, report)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// ClientConnProbe checks that a gRPC client connection is, or within the
+// probe timeout becomes, ready. Idle connections are asked to connect.
+func ClientConnProbe(conn *grpc.ClientConn) Probe {
+	return func(ctx context.Context) error {
+		for {
+			state := conn.GetState()
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.Shutdown:
+				return errors.New("connection is shut down")
+			case connectivity.Idle:
+				conn.Connect()
+			}
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection is %s", state)
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/inbound/health"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const testService = "notification.v1.NotificationService"
+
+func newChecker(probes, optional map[string]health.Probe) *health.Checker {
+	checker := health.NewChecker(config.HealthConfig{ProbeTimeout: 50 * time.Millisecond, Interval: time.Second},
+		logger.New("dev"), prometheus.NewPrometheusMetricsProvider(), testService)
+	for name, probe := range probes {
+		checker.Register(name, probe)
+	}
+	for name, probe := range optional {
+		checker.RegisterOptional(name, probe)
+	}
+	return checker
+}
+
+func ok(context.Context) error { return nil }
+
+func TestChecker_Readiness(t *testing.T) {
+	tests := []struct {
+		name       string
+		probes     map[string]health.Probe
+		optional   map[string]health.Probe
+		drain      bool
+		wantCode   int
+		wantStatus string
+		wantChecks map[string]string
+		wantGRPC   grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:       "all dependencies up",
+			probes:     map[string]health.Probe{"postgres": ok},
+			optional:   map[string]health.Probe{"kafka": ok},
+			wantCode:   http.StatusOK,
+			wantStatus: health.StatusReady,
+			wantChecks: map[string]string{"postgres": health.StatusOK, "kafka": health.StatusOK},
+			wantGRPC:   grpc_health_v1.HealthCheckResponse_SERVING,
+		},
+		{
+			name: "failing dependency",
+			probes: map[string]health.Probe{
+				"postgres":     ok,
+				"user_service": func(context.Context) error { return errors.New("connection is TRANSIENT_FAILURE") },
+			},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: health.StatusNotReady,
+			wantChecks: map[string]string{"postgres": health.StatusOK, "user_service": health.StatusFail},
+			wantGRPC:   grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:   "failing optional dependency",
+			probes: map[string]health.Probe{"postgres": ok},
+			optional: map[string]health.Probe{
+				"kafka": func(context.Context) error { return errors.New("no partitions assigned") },
+			},
+			wantCode:   http.StatusOK,
+			wantStatus: health.StatusReady,
+			wantChecks: map[string]string{"postgres": health.StatusOK, "kafka": health.StatusFail},
+			wantGRPC:   grpc_health_v1.HealthCheckResponse_SERVING,
+		},
+		{
+			name: "probe exceeding the timeout",
+			probes: map[string]health.Probe{
+				"user_service": func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: health.StatusNotReady,
+			wantChecks: map[string]string{"user_service": health.StatusFail},
+			wantGRPC:   grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:       "draining",
+			probes:     map[string]health.Probe{"postgres": ok},
+			drain:      true,
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: health.StatusDraining,
+			wantChecks: map[string]string{"postgres": health.StatusOK},
+			wantGRPC:   grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := newChecker(tt.probes, tt.optional)
+			if tt.drain {
+				checker.Drain()
+			}
+
+			rec := httptest.NewRecorder()
+			checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			var report health.Report
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+			assert.Equal(t, tt.wantStatus, report.Status)
+			checks := make(map[string]string, len(report.Checks))
+			for name, status := range report.Checks {
+				checks[name] = status.Status
+				if status.Status == health.StatusFail {
+					assert.NotEmpty(t, status.Error)
+				}
+			}
+			assert.Equal(t, tt.wantChecks, checks)
+
+			for _, service := range []string{"", testService} {
+				resp, err := checker.GRPCServer().Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantGRPC, resp.GetStatus(), service)
+			}
+		})
+	}
+}
+
+func TestChecker_LivenessWhileDraining(t *testing.T) {
+	checker := newChecker(map[string]health.Probe{"postgres": func(context.Context) error { return errors.New("down") }}, nil)
+	checker.Drain()
+
+	rec := httptest.NewRecorder()
+	checker.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	broadcast_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"time"
+)
+
+// BroadcastJob fans broadcasts out in the background. It is safe to run on
+// every replica: broadcasts are leased with SKIP LOCKED and a chunk only
+// counts if the broadcast has not moved on.
+type BroadcastJob struct {
+	config           config.BroadcastConfig
+	broadcastService broadcast_service.BroadcastService
+	log              ports.Logger
+}
+
+func NewBroadcastJob(cfg config.BroadcastConfig, broadcastSvc broadcast_service.BroadcastService, log ports.Logger) *BroadcastJob {
+	return &BroadcastJob{
+		config:           cfg,
+		broadcastService: broadcastSvc,
+		log:              log,
+	}
+}
+
+// Start polls for unfinished broadcasts on every interval until ctx is done.
+func (j *BroadcastJob) Start(ctx context.Context) {
+	j.log.Info("Starting broadcast job",
+		slog.Duration("interval", j.config.Interval),
+		slog.Int("batch_size", j.config.BatchSize),
+		slog.Int("chunk_size", j.config.ChunkSize),
+	)
+
+	runPeriodically(ctx, j.config.Interval, j.RunOnce)
+	j.log.Info("Stopping broadcast job", slog.String("reason", "context done"))
+}
+
+// RunOnce keeps taking chunks while there are any, so a broadcast is fanned
+// out in one run rather than one chunk per interval.
+func (j *BroadcastJob) RunOnce(ctx context.Context) {
+	start := time.Now()
+	total := 0
+	for ctx.Err() == nil {
+		processed, err := j.broadcastService.ProcessDue(ctx, j.config.BatchSize)
+		total += processed
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				j.log.Error("Broadcast run failed",
+					slog.Int("chunks", total),
+					slog.String("error", err.Error()),
+				)
+			}
+			return
+		}
+		if processed == 0 {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.log.Debug("Broadcast run finished",
+			slog.Int("chunks", total),
+			slog.Duration("duration", time.Since(start)),
+		)
+	}
+}
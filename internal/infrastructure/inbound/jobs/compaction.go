@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"time"
+)
+
+// CompactionJob is the retention job: it periodically hard-deletes
+// notification tombstones older than the configured retention and
+// notifications that have expired.
+type CompactionJob struct {
+	config              config.CompactionConfig
+	notificationService notification_service.NotificationService
+	log                 ports.Logger
+}
+
+func NewCompactionJob(cfg config.CompactionConfig, notificationSvc notification_service.NotificationService, log ports.Logger) *CompactionJob {
+	return &CompactionJob{
+		config:              cfg,
+		notificationService: notificationSvc,
+		log:                 log,
+	}
+}
+
+// Start runs compaction immediately and then on every interval until ctx is done.
+func (j *CompactionJob) Start(ctx context.Context) {
+	j.log.Info("Starting compaction job",
+		slog.Duration("interval", j.config.Interval),
+		slog.Duration("retention", j.config.Retention),
+		slog.Int("batch_size", j.config.BatchSize),
+	)
+
+	runPeriodically(ctx, j.config.Interval, j.RunOnce)
+	j.log.Info("Stopping compaction job", slog.String("reason", "context done"))
+}
+
+func (j *CompactionJob) RunOnce(ctx context.Context) {
+	start := time.Now()
+	purgedDeleted, err := j.notificationService.PurgeDeletedNotifications(ctx, j.config.Retention, j.config.BatchSize)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		j.log.Error("Compaction of deleted notifications failed",
+			slog.Int64("purged", purgedDeleted),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	purgedExpired, err := j.notificationService.PurgeExpiredNotifications(ctx, j.config.BatchSize)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		j.log.Error("Compaction of expired notifications failed",
+			slog.Int64("purged", purgedExpired),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	j.log.Debug("Compaction run finished",
+		slog.Int64("purged_deleted", purgedDeleted),
+		slog.Int64("purged_expired", purgedExpired),
+		slog.Duration("duration", time.Since(start)),
+	)
+}
@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	delivery_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+)
+
+// DeliveryWorkerJob drains the delivery queue with a pool of workers. Like
+// the scheduler it is safe to run on every replica: deliveries are leased
+// with SKIP LOCKED.
+type DeliveryWorkerJob struct {
+	config          config.DeliveryConfig
+	deliveryService delivery_service.DeliveryService
+	log             ports.Logger
+}
+
+func NewDeliveryWorkerJob(cfg config.DeliveryConfig, deliverySvc delivery_service.DeliveryService, log ports.Logger) *DeliveryWorkerJob {
+	return &DeliveryWorkerJob{
+		config:          cfg,
+		deliveryService: deliverySvc,
+		log:             log,
+	}
+}
+
+// Start runs the configured number of workers until ctx is done.
+func (j *DeliveryWorkerJob) Start(ctx context.Context) {
+	workers := max(j.config.Workers, 1)
+	j.log.Info("Starting delivery workers",
+		slog.Int("workers", workers),
+		slog.Duration("poll_interval", j.config.PollInterval),
+		slog.Int("batch_size", j.config.BatchSize),
+	)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runPeriodically(ctx, j.config.PollInterval, j.RunOnce)
+		}()
+	}
+	wg.Wait()
+
+	j.log.Info("Stopping delivery workers", slog.String("reason", "context done"))
+}
+
+// RunOnce keeps processing while full batches come back, so a backlog is
+// drained without waiting for the next tick.
+func (j *DeliveryWorkerJob) RunOnce(ctx context.Context) {
+	start := time.Now()
+	total := 0
+	for ctx.Err() == nil {
+		sent, err := j.deliveryService.ProcessPending(ctx, j.config.BatchSize)
+		total += sent
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				j.log.Error("Delivery run failed",
+					slog.Int("sent", total),
+					slog.String("error", err.Error()),
+				)
+			}
+			return
+		}
+		if sent < j.config.BatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.log.Debug("Delivery run finished",
+			slog.Int("sent", total),
+			slog.Duration("duration", time.Since(start)),
+		)
+	}
+}
@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	digest_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"time"
+)
+
+// DigestJob sends digests as they fall due. Like the scheduler it is safe
+// to run on every replica: due subscriptions are leased with SKIP LOCKED.
+type DigestJob struct {
+	config        config.DigestConfig
+	digestService digest_service.DigestService
+	log           ports.Logger
+}
+
+func NewDigestJob(cfg config.DigestConfig, digestSvc digest_service.DigestService, log ports.Logger) *DigestJob {
+	return &DigestJob{
+		config:        cfg,
+		digestService: digestSvc,
+		log:           log,
+	}
+}
+
+// Start polls for due digests on every interval until ctx is done.
+func (j *DigestJob) Start(ctx context.Context) {
+	j.log.Info("Starting digest job",
+		slog.Duration("interval", j.config.Interval),
+		slog.Int("batch_size", j.config.BatchSize),
+		slog.Int("send_hour", j.config.SendHour),
+		slog.String("weekly_day", j.config.WeeklyDay),
+	)
+
+	runPeriodically(ctx, j.config.Interval, j.RunOnce)
+	j.log.Info("Stopping digest job", slog.String("reason", "context done"))
+}
+
+// RunOnce keeps processing while full batches come back: digests fall due
+// together at the send hour, so most of the backlog goes out in one run.
+func (j *DigestJob) RunOnce(ctx context.Context) {
+	start := time.Now()
+	total := 0
+	for ctx.Err() == nil {
+		sent, err := j.digestService.ProcessDue(ctx, j.config.BatchSize)
+		total += sent
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				j.log.Error("Digest run failed",
+					slog.Int("sent", total),
+					slog.String("error", err.Error()),
+				)
+			}
+			return
+		}
+		if sent < j.config.BatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.log.Debug("Digest run finished",
+			slog.Int("sent", total),
+			slog.Duration("duration", time.Since(start)),
+		)
+	}
+}
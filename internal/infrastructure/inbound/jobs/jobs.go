@@ -0,0 +1,22 @@
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+// runPeriodically calls run immediately and then on every interval until ctx is done.
+func runPeriodically(ctx context.Context, interval time.Duration, run func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	notification_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"time"
+)
+
+// SchedulerJob delivers scheduled notifications once they are due. It is
+// safe to run on every replica: due rows are claimed with SKIP LOCKED.
+type SchedulerJob struct {
+	config              config.SchedulerConfig
+	notificationService notification_service.NotificationService
+	log                 ports.Logger
+}
+
+func NewSchedulerJob(cfg config.SchedulerConfig, notificationSvc notification_service.NotificationService, log ports.Logger) *SchedulerJob {
+	return &SchedulerJob{
+		config:              cfg,
+		notificationService: notificationSvc,
+		log:                 log,
+	}
+}
+
+// Start polls for due notifications on every interval until ctx is done.
+func (j *SchedulerJob) Start(ctx context.Context) {
+	j.log.Info("Starting notification scheduler",
+		slog.Duration("interval", j.config.Interval),
+		slog.Int("batch_size", j.config.BatchSize),
+	)
+
+	runPeriodically(ctx, j.config.Interval, j.RunOnce)
+	j.log.Info("Stopping notification scheduler", slog.String("reason", "context done"))
+}
+
+func (j *SchedulerJob) RunOnce(ctx context.Context) {
+	start := time.Now()
+	delivered, err := j.notificationService.DeliverDueNotifications(ctx, j.config.BatchSize)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		j.log.Error("Scheduler run failed",
+			slog.Int("delivered", delivered),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if delivered > 0 {
+		j.log.Debug("Scheduler run finished",
+			slog.Int("delivered", delivered),
+			slog.Duration("duration", time.Since(start)),
+		)
+	}
+}
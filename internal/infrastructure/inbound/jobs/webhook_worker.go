@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	webhook_service "pinstack-notification-service/internal/domain/ports/input"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+)
+
+// WebhookWorkerJob drains the webhook queue the same way DeliveryWorkerJob
+// drains the channel queue.
+type WebhookWorkerJob struct {
+	config         config.WebhooksConfig
+	webhookService webhook_service.WebhookService
+	log            ports.Logger
+}
+
+func NewWebhookWorkerJob(cfg config.WebhooksConfig, webhookSvc webhook_service.WebhookService, log ports.Logger) *WebhookWorkerJob {
+	return &WebhookWorkerJob{
+		config:         cfg,
+		webhookService: webhookSvc,
+		log:            log,
+	}
+}
+
+// Start runs the configured number of workers until ctx is done.
+func (j *WebhookWorkerJob) Start(ctx context.Context) {
+	workers := max(j.config.Workers, 1)
+	j.log.Info("Starting webhook workers",
+		slog.Int("workers", workers),
+		slog.Duration("poll_interval", j.config.PollInterval),
+		slog.Int("batch_size", j.config.BatchSize),
+	)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runPeriodically(ctx, j.config.PollInterval, j.RunOnce)
+		}()
+	}
+	wg.Wait()
+
+	j.log.Info("Stopping webhook workers", slog.String("reason", "context done"))
+}
+
+// RunOnce keeps processing while full batches come back.
+func (j *WebhookWorkerJob) RunOnce(ctx context.Context) {
+	start := time.Now()
+	total := 0
+	for ctx.Err() == nil {
+		sent, err := j.webhookService.ProcessPending(ctx, j.config.BatchSize)
+		total += sent
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				j.log.Error("Webhook run failed",
+					slog.Int("sent", total),
+					slog.String("error", err.Error()),
+				)
+			}
+			return
+		}
+		if sent < j.config.BatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.log.Debug("Webhook run finished",
+			slog.Int("sent", total),
+			slog.Duration("duration", time.Since(start)),
+		)
+	}
+}
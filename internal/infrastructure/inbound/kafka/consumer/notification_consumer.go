@@ -9,6 +9,10 @@ import (
 	notification_service "pinstack-notification-service/internal/domain/ports/input"
 	ports "pinstack-notification-service/internal/domain/ports/output"
 	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/correlation"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/tracing"
+	"sync"
 	"time"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
@@ -22,10 +26,17 @@ type NotificationConsumer struct {
 	log                 ports.Logger
 	consumer            *kafka.Consumer
 	notificationService notification_service.NotificationService
+	userDataService     notification_service.UserDataService
 	metrics             ports.MetricsProvider
+	logSampler          *logger.Sampler
+
+	// mu guards the consumer handle against use after Close.
+	mu         sync.RWMutex
+	subscribed bool
+	closed     bool
 }
 
-func NewNotificationConsumer(cfg config.KafkaConfig, log ports.Logger, notificationSvc notification_service.NotificationService, metrics ports.MetricsProvider) (*NotificationConsumer, error) {
+func NewNotificationConsumer(cfg config.KafkaConfig, log ports.Logger, notificationSvc notification_service.NotificationService, userDataSvc notification_service.UserDataService, metrics ports.MetricsProvider, logSampler *logger.Sampler) (*NotificationConsumer, error) {
 	c, err := kafka.NewConsumer(&kafka.ConfigMap{
 		"bootstrap.servers":       cfg.Brokers,
 		"group.id":                cfg.ConsumerGroupID,
@@ -46,39 +57,48 @@ func NewNotificationConsumer(cfg config.KafkaConfig, log ports.Logger, notificat
 		log:                 log,
 		consumer:            c,
 		notificationService: notificationSvc,
+		userDataService:     userDataSvc,
 		metrics:             metrics,
+		logSampler:          logSampler,
 	}, nil
 }
 
 func (c *NotificationConsumer) Start(ctx context.Context) {
-	c.log.Info("Starting Kafka consumer", slog.String("topic", c.config.RelationTopic))
+	topics := []string{c.config.RelationTopic}
+	if c.config.UserTopic != "" {
+		topics = append(topics, c.config.UserTopic)
+	}
+	c.log.InfoContext(ctx, "Starting Kafka consumer", slog.Any("topics", topics))
 
-	err := c.consumer.SubscribeTopics([]string{c.config.RelationTopic}, nil)
+	err := c.consumer.SubscribeTopics(topics, nil)
 	if err != nil {
-		c.log.Error("Failed to subscribe to topic",
-			slog.String("topic", c.config.RelationTopic),
+		c.log.ErrorContext(ctx, "Failed to subscribe to topics",
+			slog.Any("topics", topics),
 			slog.String("error", err.Error()))
 		return
 	}
+	c.mu.Lock()
+	c.subscribed = true
+	c.mu.Unlock()
 
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
-				c.log.Error("Recovered from panic in Kafka consumer", slog.Any("panic", r))
+				c.log.ErrorContext(ctx, "Recovered from panic in Kafka consumer", slog.Any("panic", r))
 			}
 		}()
 
 		for {
 			select {
 			case <-ctx.Done():
-				c.log.Info("Stopping Kafka consumer", slog.String("reason", "context done"))
+				c.log.InfoContext(ctx, "Stopping Kafka consumer", slog.String("reason", "context done"))
 				c.Close()
 				return
 			default:
 				msg, err := c.consumer.ReadMessage(100 * time.Millisecond)
 				if err != nil {
 					if !errors.Is(err, kafka.NewError(kafka.ErrTimedOut, "", false)) {
-						c.log.Error("Error reading message from Kafka", slog.String("error", err.Error()))
+						c.log.ErrorContext(ctx, "Error reading message from Kafka", slog.String("error", err.Error()))
 					}
 					continue
 				}
@@ -87,15 +107,20 @@ func (c *NotificationConsumer) Start(ctx context.Context) {
 					continue
 				}
 
-				if err := c.processMessage(ctx, msg); err != nil {
-					c.log.Error("Failed to process message",
+				msgCtx := ctx
+				if !c.logSampler.Allow() {
+					msgCtx = context.WithValue(ctx, sampledOutKey{}, true)
+				}
+
+				if err := c.processMessage(msgCtx, msg); err != nil {
+					c.log.ErrorContext(ctx, "Failed to process message",
 						slog.String("topic", *msg.TopicPartition.Topic),
 						slog.Int("partition", int(msg.TopicPartition.Partition)),
 						slog.Int64("offset", int64(msg.TopicPartition.Offset)),
 						slog.String("key", string(msg.Key)),
 						slog.String("error", err.Error()))
 				} else {
-					c.log.Info("Message processed successfully",
+					c.infoContext(msgCtx, "Message processed successfully",
 						slog.String("topic", *msg.TopicPartition.Topic),
 						slog.Int("partition", int(msg.TopicPartition.Partition)),
 						slog.Int64("offset", int64(msg.TopicPartition.Offset)),
@@ -103,7 +128,7 @@ func (c *NotificationConsumer) Start(ctx context.Context) {
 
 					if !c.config.EnableAutoCommit {
 						if _, err := c.consumer.Commit(); err != nil {
-							c.log.Error("Failed to commit offset",
+							c.log.ErrorContext(ctx, "Failed to commit offset",
 								slog.String("topic", *msg.TopicPartition.Topic),
 								slog.Int("partition", int(msg.TopicPartition.Partition)),
 								slog.Int64("offset", int64(msg.TopicPartition.Offset)),
@@ -116,6 +141,19 @@ func (c *NotificationConsumer) Start(ctx context.Context) {
 	}()
 }
 
+// sampledOutKey marks the context of a message whose routine log lines
+// were left out by the log sampler.
+type sampledOutKey struct{}
+
+// infoContext logs the routine per-message lines, unless the sampler left
+// the message out. Warnings and errors are always logged.
+func (c *NotificationConsumer) infoContext(ctx context.Context, msg string, args ...any) {
+	if ctx.Value(sampledOutKey{}) != nil {
+		return
+	}
+	c.log.InfoContext(ctx, msg, args...)
+}
+
 func (c *NotificationConsumer) processMessage(ctx context.Context, msg *kafka.Message) (err error) {
 	start := time.Now()
 	topic := "unknown"
@@ -131,23 +169,44 @@ func (c *NotificationConsumer) processMessage(ctx context.Context, msg *kafka.Me
 		return custom_errors.ErrInvalidInput
 	}
 
-	var eventType string
+	ctx, span := tracing.StartConsumerSpan(ctx, msg)
+	defer func() { tracing.End(span, err) }()
+
+	var eventType, correlationID string
 	for _, header := range msg.Headers {
-		if header.Key == "event_type" {
+		switch header.Key {
+		case "event_type":
 			eventType = string(header.Value)
-			break
+		case correlation.HeaderKey:
+			correlationID = string(header.Value)
 		}
 	}
+	// Producers that do not set the header key their messages by the
+	// entity the event is about, which ties its log lines together too.
+	if correlationID == "" {
+		correlationID = string(msg.Key)
+	}
+	ctx = correlation.NewContext(ctx, correlation.Ensure(correlationID))
 
-	c.log.Info("Received event from Kafka",
+	c.infoContext(ctx, "Received event from Kafka",
 		slog.String("event_type", eventType),
-		slog.String("payload", string(msg.Value)))
+		slog.Int("payload_size", len(msg.Value)),
+		slog.String("payload_sha256", logger.Fingerprint(msg.Value)))
+
+	// The user topic carries every user lifecycle event; only deletions
+	// concern this service.
+	if c.config.UserTopic != "" && topic == c.config.UserTopic && eventType != string(model.EventTypeUserDeleted) {
+		c.log.DebugContext(ctx, "Ignoring user event", slog.String("event_type", eventType))
+		return nil
+	}
 
 	switch eventType {
 	case string(events.EventTypeFollowCreated):
 		return c.handleFollowCreated(ctx, msg.Value)
+	case string(model.EventTypeUserDeleted):
+		return c.handleUserDeleted(ctx, msg.Value)
 	default:
-		c.log.Warn("Unknown event type", slog.String("event_type", eventType))
+		c.log.WarnContext(ctx, "Unknown event type", slog.String("event_type", eventType))
 		return custom_errors.ErrInvalidInput
 	}
 }
@@ -159,14 +218,15 @@ func (c *NotificationConsumer) handleFollowCreated(ctx context.Context, payload
 
 	var followEvent events.FollowCreatedPayload
 	if err := json.Unmarshal(payload, &followEvent); err != nil {
-		c.log.Error("Failed to unmarshal follow created event",
-			slog.String("payload", string(payload)),
+		c.log.ErrorContext(ctx, "Failed to unmarshal follow created event",
+			slog.Int("payload_size", len(payload)),
+			slog.String("payload_sha256", logger.Fingerprint(payload)),
 			slog.String("error", err.Error()))
 		return custom_errors.ErrInvalidInput
 	}
 
 	if followEvent.FolloweeID <= 0 || followEvent.FollowerID <= 0 {
-		c.log.Error("Invalid follow event data",
+		c.log.ErrorContext(ctx, "Invalid follow event data",
 			slog.Int64("follower_id", followEvent.FollowerID),
 			slog.Int64("followee_id", followEvent.FolloweeID))
 		return custom_errors.ErrInvalidInput
@@ -180,26 +240,125 @@ func (c *NotificationConsumer) handleFollowCreated(ctx context.Context, payload
 		Payload:   payload,
 	}
 
-	c.log.Info("Created follow notification",
+	c.infoContext(ctx, "Created follow notification",
 		slog.Int64("user_id", notification.UserID),
 		slog.String("type", string(notification.Type)),
-		slog.String("payload", string(notification.Payload)))
+		slog.Int("payload_size", len(notification.Payload)))
 
 	notificationID, err := c.notificationService.SaveNotification(ctx, notification)
+	if errors.Is(err, model.ErrNotificationSuppressed) {
+		c.infoContext(ctx, "Follow notification suppressed",
+			slog.Int64("user_id", notification.UserID),
+			slog.Int64("follower_id", followEvent.FollowerID))
+		return nil
+	}
 	if err != nil {
-		c.log.Error("Failed to save notification", slog.String("error", err.Error()))
+		c.log.ErrorContext(ctx, "Failed to save notification", slog.String("error", err.Error()))
 		return err
 	}
 
-	c.log.Info("Notification saved successfully",
+	c.infoContext(ctx, "Notification saved successfully",
 		slog.Int64("notification_id", notificationID),
 		slog.Int64("user_id", notification.UserID))
 
 	return nil
 }
 
+func (c *NotificationConsumer) handleUserDeleted(ctx context.Context, payload json.RawMessage) (err error) {
+	defer func() {
+		c.metrics.IncrementNotificationOperations("process_user_deleted_event", err == nil)
+	}()
+
+	var event model.UserDeletedPayload
+	if err := json.Unmarshal(payload, &event); err != nil {
+		c.log.ErrorContext(ctx, "Failed to unmarshal user deleted event",
+			slog.Int("payload_size", len(payload)),
+			slog.String("payload_sha256", logger.Fingerprint(payload)),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrInvalidInput
+	}
+
+	if event.UserID <= 0 {
+		c.log.ErrorContext(ctx, "Invalid user deleted event data", slog.Int64("user_id", event.UserID))
+		return custom_errors.ErrInvalidInput
+	}
+
+	c.log.InfoContext(ctx, "User deleted, purging their data",
+		slog.Int64("user_id", event.UserID),
+		slog.Time("deleted_at", event.DeletedAt))
+
+	if err := c.purgeUser(ctx, event.UserID); err != nil {
+		c.log.ErrorContext(ctx, "Failed to purge deleted user data",
+			slog.Int64("user_id", event.UserID),
+			slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// purgeUser retries a failed purge with exponential backoff before giving
+// up, since the offset moves past the event once it returns. Purging is
+// safe to repeat, so a retry after a partial purge does no harm.
+func (c *NotificationConsumer) purgeUser(ctx context.Context, userID int64) error {
+	backoff := time.Duration(c.config.PurgeRetryBackoffMs) * time.Millisecond
+	var err error
+	for attempt := 0; attempt <= c.config.PurgeMaxRetries; attempt++ {
+		if attempt > 0 {
+			if sleepErr := sleep(ctx, backoff<<(attempt-1)); sleepErr != nil {
+				return err
+			}
+		}
+
+		if _, err = c.userDataService.PurgeUser(ctx, userID); err == nil {
+			return nil
+		}
+		c.log.WarnContext(ctx, "Purge of deleted user data failed",
+			slog.Int64("user_id", userID),
+			slog.Int("attempt", attempt+1),
+			slog.String("error", err.Error()))
+	}
+	return err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Ready reports whether the consumer is subscribed and has partitions
+// assigned, i.e. whether it is actually receiving events.
+func (c *NotificationConsumer) Ready(ctx context.Context) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	switch {
+	case c.closed:
+		return errors.New("consumer is closed")
+	case !c.subscribed:
+		return errors.New("consumer is not subscribed")
+	}
+	partitions, err := c.consumer.Assignment()
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return errors.New("no partitions assigned")
+	}
+	return nil
+}
+
 func (c *NotificationConsumer) Close() {
-	if c.consumer != nil {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.consumer != nil && !c.closed {
+		c.closed = true
 		if err := c.consumer.Close(); err != nil {
 			c.log.Error("Failed to close Kafka consumer", slog.String("error", err.Error()))
 		} else {
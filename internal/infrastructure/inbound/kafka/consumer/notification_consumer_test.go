@@ -0,0 +1,110 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func userDeletedMessage(topic string) *kafka.Message {
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic},
+		Key:            []byte("42"),
+		Value:          []byte(`{"user_id":42,"deleted_at":"2025-06-02T08:00:00Z"}`),
+		Headers:        []kafka.Header{{Key: "event_type", Value: []byte("user_deleted")}},
+	}
+}
+
+func TestNotificationConsumer_HandleUserDeleted(t *testing.T) {
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mocks.UserDataService)
+		wantErr   error
+	}{
+		{
+			name: "purged",
+			mockSetup: func(svc *mocks.UserDataService) {
+				svc.On("PurgeUser", mock.Anything, int64(42)).Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "failed purge is retried",
+			mockSetup: func(svc *mocks.UserDataService) {
+				svc.On("PurgeUser", mock.Anything, int64(42)).Return(nil, dbErr).Twice()
+				svc.On("PurgeUser", mock.Anything, int64(42)).Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "purge still failing after the retries",
+			mockSetup: func(svc *mocks.UserDataService) {
+				svc.On("PurgeUser", mock.Anything, int64(42)).Return(nil, dbErr).Times(3)
+			},
+			wantErr: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userDataService := mocks.NewUserDataService(t)
+			tt.mockSetup(userDataService)
+
+			c := &NotificationConsumer{
+				config:          config.KafkaConfig{UserTopic: "user-events", PurgeMaxRetries: 2, PurgeRetryBackoffMs: 1},
+				log:             logger.New("dev"),
+				userDataService: userDataService,
+				metrics:         prometheus.NewPrometheusMetricsProvider(),
+			}
+			err := c.processMessage(context.Background(), userDeletedMessage("user-events"))
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestNotificationConsumer_ProcessMessage_EventTypes(t *testing.T) {
+	tests := []struct {
+		name      string
+		topic     string
+		eventType string
+		wantErr   error
+	}{
+		{
+			name:      "other user event is ignored",
+			topic:     "user-events",
+			eventType: "user_updated",
+		},
+		{
+			name:      "unknown relation event is rejected",
+			topic:     "relation-events",
+			eventType: "user_updated",
+			wantErr:   custom_errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &NotificationConsumer{
+				config:          config.KafkaConfig{RelationTopic: "relation-events", UserTopic: "user-events"},
+				log:             logger.New("dev"),
+				userDataService: mocks.NewUserDataService(t),
+				metrics:         prometheus.NewPrometheusMetricsProvider(),
+			}
+			msg := userDeletedMessage(tt.topic)
+			msg.Headers = []kafka.Header{{Key: "event_type", Value: []byte(tt.eventType)}}
+			err := c.processMessage(context.Background(), msg)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -12,28 +12,36 @@ import (
 
 type Server struct {
 	server  *http.Server
+	mux     *http.ServeMux
 	address string
 	port    int
 	log     output.Logger
 }
 
 func NewMetricsServer(address string, port int, log output.Logger) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
 	return &Server{
+		mux:     mux,
 		address: address,
 		port:    port,
 		log:     log,
 	}
 }
 
+// Handle serves another endpoint, such as health probes, next to /metrics.
+// It must be called before Run.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
 func (s *Server) Run() error {
 	addr := fmt.Sprintf("%s:%d", s.address, s.port)
 
-	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
-
 	s.server = &http.Server{
 		Addr:    addr,
-		Handler: mux,
+		Handler: s.mux,
 	}
 
 	s.log.Info("Starting Prometheus metrics server", slog.String("address", addr))
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"pinstack-notification-service/internal/infrastructure/correlation"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryCorrelationInterceptor takes the correlation ID from the incoming
+// metadata, or generates one, puts it in the context for the loggers down
+// the call and echoes it in the response headers. It must run before the
+// logger interceptor.
+func UnaryCorrelationInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx = withCorrelationID(ctx)
+		return handler(ctx, req)
+	}
+}
+
+// StreamCorrelationInterceptor is UnaryCorrelationInterceptor for
+// streaming calls.
+func StreamCorrelationInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &correlatedStream{ServerStream: ss, ctx: withCorrelationID(ss.Context())})
+	}
+}
+
+// UnaryClientCorrelationInterceptor passes the correlation ID of the
+// context on to the called service.
+func UnaryClientCorrelationInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if id := correlation.FromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, correlation.MetadataKey, id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func withCorrelationID(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range []string{correlation.MetadataKey, correlation.RequestIDMetadataKey} {
+			if values := md.Get(key); len(values) > 0 {
+				id = values[0]
+				break
+			}
+		}
+	}
+	id = correlation.Ensure(id)
+
+	// Fails only outside a gRPC call, where there is no header to set.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(correlation.MetadataKey, id))
+	return correlation.NewContext(ctx, id)
+}
+
+type correlatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *correlatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,75 @@
+package middleware_test
+
+import (
+	"context"
+	"pinstack-notification-service/internal/infrastructure/correlation"
+	"pinstack-notification-service/internal/infrastructure/inbound/middleware"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryCorrelationInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		md     metadata.MD
+		wantID string
+	}{
+		{
+			name:   "correlation id from caller",
+			md:     metadata.Pairs(correlation.MetadataKey, "req-42"),
+			wantID: "req-42",
+		},
+		{
+			name:   "request id from caller",
+			md:     metadata.Pairs(correlation.RequestIDMetadataKey, "req-43"),
+			wantID: "req-43",
+		},
+		{
+			name: "generated when missing",
+			md:   metadata.MD{},
+		},
+		{
+			name: "generated when unusable",
+			md:   metadata.Pairs(correlation.MetadataKey, strings.Repeat("x", 200)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			var got string
+			_, err := middleware.UnaryCorrelationInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					got = correlation.FromContext(ctx)
+					return nil, nil
+				})
+
+			require.NoError(t, err)
+			if tt.wantID != "" {
+				assert.Equal(t, tt.wantID, got)
+			} else {
+				assert.Len(t, got, 32)
+			}
+		})
+	}
+}
+
+func TestUnaryClientCorrelationInterceptor(t *testing.T) {
+	ctx := correlation.NewContext(context.Background(), "req-42")
+
+	var outgoing metadata.MD
+	err := middleware.UnaryClientCorrelationInterceptor()(ctx, "/user.v1.UserService/GetUser", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			outgoing, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"req-42"}, outgoing.Get(correlation.MetadataKey))
+}
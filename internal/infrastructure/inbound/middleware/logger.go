@@ -6,13 +6,17 @@ import (
 
 	"log/slog"
 	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/logger"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
-func UnaryLoggerInterceptor(log ports.Logger) grpc.UnaryServerInterceptor {
+// UnaryLoggerInterceptor logs every failed request and the successful ones
+// the sampler lets through.
+func UnaryLoggerInterceptor(log ports.Logger, sampler *logger.Sampler) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -30,13 +34,16 @@ func UnaryLoggerInterceptor(log ports.Logger) grpc.UnaryServerInterceptor {
 
 		latency := time.Since(start)
 		st, _ := status.FromError(err)
+		if st.Code() == codes.OK && !sampler.Allow() {
+			return resp, err
+		}
 
 		log.With(
 			slog.String("method", info.FullMethod),
 			slog.String("remote_address", remoteAddr),
 			slog.String("latency", latency.String()),
 			slog.String("grpc_code", st.Code().String()),
-		).Info("gRPC request completed")
+		).InfoContext(ctx, "gRPC request completed")
 
 		return resp, err
 	}
@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/ratelimit"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// CallerMetadataKey identifies the calling service for rate limiting.
+// Callers that do not set it are told apart by their address.
+const CallerMetadataKey = "x-caller-id"
+
+// RateLimitBy says whose calls share a bucket.
+type RateLimitBy string
+
+const (
+	// RateLimitByUser keys the bucket by the user_id of the request, so a
+	// single user cannot exhaust the method for everyone. Requests without
+	// a user ID fall back to the caller.
+	RateLimitByUser RateLimitBy = "user"
+	// RateLimitByCaller keys the bucket by the calling service.
+	RateLimitByCaller RateLimitBy = "caller"
+)
+
+type RateLimitRule struct {
+	ratelimit.Rule
+	By RateLimitBy
+}
+
+// RateLimiter limits the methods that have a rule; rules are keyed by the
+// method name without the service, case-insensitively ("GetUnreadCount").
+// When the backend fails the call goes through.
+type RateLimiter struct {
+	limiter ratelimit.Limiter
+	rules   map[string]RateLimitRule
+	log     ports.Logger
+	metrics ports.MetricsProvider
+}
+
+func NewRateLimiter(limiter ratelimit.Limiter, rules map[string]RateLimitRule, log ports.Logger, metrics ports.MetricsProvider) *RateLimiter {
+	normalized := make(map[string]RateLimitRule, len(rules))
+	for method, rule := range rules {
+		normalized[strings.ToLower(method)] = rule
+	}
+	return &RateLimiter{limiter: limiter, rules: normalized, log: log, metrics: metrics}
+}
+
+func (l *RateLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := l.limit(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor limits streaming calls by caller, since the request is
+// read only inside the handler.
+func (l *RateLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := l.limit(ss.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (l *RateLimiter) limit(ctx context.Context, fullMethod string, req interface{}) error {
+	rule, ok := l.rules[strings.ToLower(methodName(fullMethod))]
+	if !ok {
+		return nil
+	}
+
+	key := fullMethod + ":" + identity(ctx, rule.By, req)
+	decision, err := l.limiter.Allow(ctx, key, rule.Rule)
+	if err != nil {
+		l.metrics.IncrementRateLimitDecisions(fullMethod, "error")
+		l.log.WarnContext(ctx, "Rate limiter failed, letting the call through",
+			slog.String("method", fullMethod),
+			slog.String("error", err.Error()))
+		return nil
+	}
+	if decision.Allowed {
+		l.metrics.IncrementRateLimitDecisions(fullMethod, "allowed")
+		return nil
+	}
+
+	l.metrics.IncrementRateLimitDecisions(fullMethod, "limited")
+	l.log.WarnContext(ctx, "Rate limit exceeded",
+		slog.String("method", fullMethod),
+		slog.String("key", key),
+		slog.Duration("retry_after", decision.RetryAfter))
+
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit exceeded, retry in %s", decision.RetryAfter))
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(decision.RetryAfter)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func methodName(fullMethod string) string {
+	if i := strings.LastIndexByte(fullMethod, '/'); i >= 0 {
+		return fullMethod[i+1:]
+	}
+	return fullMethod
+}
+
+func identity(ctx context.Context, by RateLimitBy, req interface{}) string {
+	if by == RateLimitByUser {
+		if r, ok := req.(interface{ GetUserId() int64 }); ok && r.GetUserId() > 0 {
+			return fmt.Sprintf("user:%d", r.GetUserId())
+		}
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(CallerMetadataKey); len(values) > 0 && values[0] != "" {
+			return "caller:" + values[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		return "addr:" + addr
+	}
+	return "unknown"
+}
@@ -0,0 +1,127 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"pinstack-notification-service/internal/infrastructure/inbound/middleware"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/internal/infrastructure/ratelimit"
+	"testing"
+	"time"
+
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/notification/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string, ratelimit.Rule) (ratelimit.Decision, error) {
+	return ratelimit.Decision{}, errors.New("redis: connection refused")
+}
+
+func TestRateLimiterUnaryInterceptor(t *testing.T) {
+	const method = "/notification.v1.NotificationService/GetUnreadCount"
+	rules := map[string]middleware.RateLimitRule{
+		"getunreadcount": {Rule: ratelimit.Rule{Rate: 1, Burst: 2}, By: middleware.RateLimitByUser},
+	}
+
+	type call struct {
+		method string
+		req    interface{}
+		md     metadata.MD
+	}
+	unread := func(userID int64) call {
+		return call{method: method, req: &pb.GetUnreadCountRequest{UserId: userID}}
+	}
+
+	tests := []struct {
+		name      string
+		limiter   ratelimit.Limiter
+		calls     []call
+		wantCodes []codes.Code
+	}{
+		{
+			name:      "limited after burst",
+			limiter:   ratelimit.NewMemoryLimiter(),
+			calls:     []call{unread(1), unread(1), unread(1)},
+			wantCodes: []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name:      "users have separate buckets",
+			limiter:   ratelimit.NewMemoryLimiter(),
+			calls:     []call{unread(1), unread(1), unread(2)},
+			wantCodes: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		{
+			name:    "requests without user fall back to caller",
+			limiter: ratelimit.NewMemoryLimiter(),
+			calls: []call{
+				{method: method, req: &pb.GetUnreadCountRequest{}, md: metadata.Pairs(middleware.CallerMetadataKey, "feed")},
+				{method: method, req: &pb.GetUnreadCountRequest{}, md: metadata.Pairs(middleware.CallerMetadataKey, "feed")},
+				{method: method, req: &pb.GetUnreadCountRequest{}, md: metadata.Pairs(middleware.CallerMetadataKey, "feed")},
+				{method: method, req: &pb.GetUnreadCountRequest{}, md: metadata.Pairs(middleware.CallerMetadataKey, "api-gateway")},
+			},
+			wantCodes: []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted, codes.OK},
+		},
+		{
+			name:    "methods without rule are not limited",
+			limiter: ratelimit.NewMemoryLimiter(),
+			calls: []call{
+				{method: "/notification.v1.NotificationService/GetNotificationDetails"},
+				{method: "/notification.v1.NotificationService/GetNotificationDetails"},
+				{method: "/notification.v1.NotificationService/GetNotificationDetails"},
+			},
+			wantCodes: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		{
+			name:      "backend failure lets calls through",
+			limiter:   failingLimiter{},
+			calls:     []call{unread(1), unread(1), unread(1)},
+			wantCodes: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := middleware.NewRateLimiter(tt.limiter, rules, logger.New("test"), prometheus.NewPrometheusMetricsProvider()).UnaryInterceptor()
+
+			for i, c := range tt.calls {
+				ctx := context.Background()
+				if c.md != nil {
+					ctx = metadata.NewIncomingContext(ctx, c.md)
+				}
+				_, err := interceptor(ctx, c.req, &grpc.UnaryServerInfo{FullMethod: c.method},
+					func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+				assert.Equal(t, tt.wantCodes[i], status.Code(err), "call %d", i)
+			}
+		})
+	}
+}
+
+func TestRateLimiterRetryInfo(t *testing.T) {
+	rules := map[string]middleware.RateLimitRule{
+		"SendNotification": {Rule: ratelimit.Rule{Rate: 0.5, Burst: 1}, By: middleware.RateLimitByUser},
+	}
+	interceptor := middleware.NewRateLimiter(ratelimit.NewMemoryLimiter(), rules, logger.New("test"), prometheus.NewPrometheusMetricsProvider()).UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/notification.v1.NotificationService/SendNotification"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	req := &pb.SendNotificationRequest{UserId: 7}
+
+	_, err := interceptor(context.Background(), req, info, handler)
+	require.NoError(t, err)
+	_, err = interceptor(context.Background(), req, info, handler)
+
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.InDelta(t, 2*time.Second, retryInfo.GetRetryDelay().AsDuration(), float64(100*time.Millisecond))
+}
@@ -0,0 +1,114 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// ComponentKey is the attribute that names the component a logger belongs
+// to. Loggers derived with With(slog.String(ComponentKey, name)) follow the
+// level set for that component, if any, instead of the global one.
+const ComponentKey = "component"
+
+// Levels holds the global log level and per-component overrides. Both can
+// be changed while the service runs.
+type Levels struct {
+	global     slog.LevelVar
+	mu         sync.RWMutex
+	components map[string]*slog.LevelVar
+}
+
+func NewLevels(level slog.Level) *Levels {
+	l := &Levels{components: make(map[string]*slog.LevelVar)}
+	l.global.Set(level)
+	return l
+}
+
+// Set changes the level of component, or the global level for "".
+func (l *Levels) Set(component string, level slog.Level) {
+	if component == "" {
+		l.global.Set(level)
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if v, ok := l.components[component]; ok {
+		v.Set(level)
+		return
+	}
+	v := &slog.LevelVar{}
+	v.Set(level)
+	l.components[component] = v
+}
+
+// Reset makes component follow the global level again.
+func (l *Levels) Reset(component string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.components, component)
+}
+
+// Level returns the level in effect for component.
+func (l *Levels) Level(component string) slog.Level {
+	if component != "" {
+		l.mu.RLock()
+		v, ok := l.components[component]
+		l.mu.RUnlock()
+		if ok {
+			return v.Level()
+		}
+	}
+	return l.global.Level()
+}
+
+// Global returns the global level.
+func (l *Levels) Global() slog.Level {
+	return l.global.Level()
+}
+
+// Components returns the per-component overrides.
+func (l *Levels) Components() map[string]slog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	levels := make(map[string]slog.Level, len(l.components))
+	for name, v := range l.components {
+		levels[name] = v.Level()
+	}
+	return levels
+}
+
+// ParseLevel parses debug, info, warn or error, in any case.
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	err := level.UnmarshalText([]byte(strings.TrimSpace(s)))
+	return level, err
+}
+
+// levelHandler drops records below the level of its component. It must be
+// the outermost handler, since slog asks it whether a level is enabled
+// before a record is built.
+type levelHandler struct {
+	slog.Handler
+	levels    *Levels
+	component string
+}
+
+func (h levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.levels.Level(h.component)
+}
+
+func (h levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	component := h.component
+	for _, a := range attrs {
+		if a.Key == ComponentKey {
+			component = a.Value.String()
+		}
+	}
+	return levelHandler{Handler: h.Handler.WithAttrs(attrs), levels: h.levels, component: component}
+}
+
+func (h levelHandler) WithGroup(name string) slog.Handler {
+	return levelHandler{Handler: h.Handler.WithGroup(name), levels: h.levels, component: h.component}
+}
@@ -0,0 +1,124 @@
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevelHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(levels *Levels)
+		log    func(l *Logger)
+		logged []string
+	}{
+		{
+			name: "global level applies to all loggers",
+			log: func(l *Logger) {
+				l.Debug("debug")
+				l.Info("info")
+				l.With(slog.String(ComponentKey, "kafka_consumer")).Debug("consumer debug")
+			},
+			logged: []string{"info"},
+		},
+		{
+			name:  "global level can be lowered at runtime",
+			setup: func(levels *Levels) { levels.Set("", slog.LevelDebug) },
+			log: func(l *Logger) {
+				l.Debug("debug")
+			},
+			logged: []string{"debug"},
+		},
+		{
+			name:  "component override only affects that component",
+			setup: func(levels *Levels) { levels.Set("kafka_consumer", slog.LevelDebug) },
+			log: func(l *Logger) {
+				l.Debug("debug")
+				consumer := l.With(slog.String(ComponentKey, "kafka_consumer"))
+				consumer.Debug("consumer debug")
+				consumer.With(slog.Int("partition", 1)).Debug("partition debug")
+				l.With(slog.String(ComponentKey, "grpc")).Debug("grpc debug")
+			},
+			logged: []string{"consumer debug", "partition debug"},
+		},
+		{
+			name: "component can be quieter than global",
+			setup: func(levels *Levels) {
+				levels.Set("grpc", slog.LevelError)
+			},
+			log: func(l *Logger) {
+				l.With(slog.String(ComponentKey, "grpc")).Info("grpc info")
+				l.Info("info")
+			},
+			logged: []string{"info"},
+		},
+		{
+			name: "reset makes component follow global again",
+			setup: func(levels *Levels) {
+				levels.Set("grpc", slog.LevelError)
+				levels.Reset("grpc")
+			},
+			log: func(l *Logger) {
+				l.With(slog.String(ComponentKey, "grpc")).Info("grpc info")
+			},
+			logged: []string{"grpc info"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			levels := NewLevels(slog.LevelInfo)
+			handler := levelHandler{Handler: slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}), levels: levels}
+			log := &Logger{Logger: slog.New(handler), levels: levels}
+
+			if tt.setup != nil {
+				tt.setup(levels)
+			}
+			tt.log(log)
+
+			var logged []string
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				if line == "" {
+					continue
+				}
+				for _, msg := range []string{"consumer debug", "partition debug", "grpc debug", "grpc info", "debug", "info"} {
+					if strings.Contains(line, `"msg":"`+msg+`"`) {
+						logged = append(logged, msg)
+						break
+					}
+				}
+			}
+			assert.Equal(t, tt.logged, logged)
+		})
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    slog.Level
+		wantErr bool
+	}{
+		{input: "debug", want: slog.LevelDebug},
+		{input: " WARN ", want: slog.LevelWarn},
+		{input: "Error", want: slog.LevelError},
+		{input: "verbose", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			level, err := ParseLevel(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, level)
+		})
+	}
+}
@@ -6,38 +6,76 @@ import (
 	ports "pinstack-notification-service/internal/domain/ports/output"
 )
 
-const (
-	envDev  = "dev"
-	envProd = "prod"
-)
+const envDev = "dev"
 
 type Logger struct {
 	*slog.Logger
+	levels *Levels
 }
 
 func (l *Logger) With(args ...any) ports.Logger {
-	return &Logger{Logger: l.Logger.With(args...)}
+	return &Logger{Logger: l.Logger.With(args...), levels: l.levels}
+}
+
+// Levels returns the levels the logger and everything derived from it
+// follow, so they can be changed at runtime.
+func (l *Logger) Levels() *Levels {
+	return l.levels
+}
+
+type options struct {
+	redactKeys     []string
+	maxValueLength int
+	level          string
 }
 
-func New(env string) *Logger {
-	var log *slog.Logger
-	switch env {
-	case envDev:
-		log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level:     slog.LevelDebug,
-			AddSource: true,
-		}))
-	case envProd:
-		log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level:     slog.LevelInfo,
-			AddSource: true,
-		}))
-	default:
-		log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level:     slog.LevelInfo,
-			AddSource: true,
-		}))
+type Option func(*options)
+
+// WithRedaction replaces the default redacted keys and value length limit.
+// Empty keys or a zero limit keep the defaults; a negative limit disables
+// truncation.
+func WithRedaction(keys []string, maxValueLength int) Option {
+	return func(o *options) {
+		if len(keys) > 0 {
+			o.redactKeys = keys
+		}
+		if maxValueLength != 0 {
+			o.maxValueLength = maxValueLength
+		}
+	}
+}
+
+// WithLevel sets the initial level, overriding the default for env. An
+// empty or unknown level keeps the default.
+func WithLevel(level string) Option {
+	return func(o *options) {
+		o.level = level
+	}
+}
+
+func New(env string, opts ...Option) *Logger {
+	o := options{redactKeys: DefaultRedactKeys, maxValueLength: DefaultMaxValueLength}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	level := slog.LevelInfo
+	if env == envDev {
+		level = slog.LevelDebug
+	}
+	if o.level != "" {
+		if parsed, err := ParseLevel(o.level); err == nil {
+			level = parsed
+		}
 	}
+	levels := NewLevels(level)
 
-	return &Logger{log}
+	// The JSON handler lets everything through; levelHandler decides.
+	var handler slog.Handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level:     slog.LevelDebug,
+		AddSource: true,
+	})
+	handler = newRedactHandler(contextHandler{handler}, o.redactKeys, o.maxValueLength)
+	handler = levelHandler{Handler: handler, levels: levels}
+	return &Logger{Logger: slog.New(handler), levels: levels}
 }
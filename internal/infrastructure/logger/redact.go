@@ -0,0 +1,112 @@
+package logger
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+)
+
+const redacted = "[REDACTED]"
+
+// DefaultRedactKeys are masked when no keys are configured.
+var DefaultRedactKeys = []string{"email", "payload", "password", "secret", "token", "authorization"}
+
+// DefaultMaxValueLength is the length string values are cut to when no
+// limit is configured.
+const DefaultMaxValueLength = 2048
+
+// redactHandler masks the values of sensitive keys and cuts long string
+// values before records reach the output. A key is sensitive when it, or
+// its last underscore-separated part, is one of the configured keys, so
+// "email" also covers "user_email" but not "email_sha256".
+type redactHandler struct {
+	slog.Handler
+	keys   map[string]bool
+	maxLen int
+}
+
+func newRedactHandler(next slog.Handler, keys []string, maxLen int) redactHandler {
+	set := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		set[strings.ToLower(key)] = true
+	}
+	return redactHandler{Handler: next, keys: set, maxLen: maxLen}
+}
+
+func (h redactHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.redact(a))
+		return true
+	})
+	return h.Handler.Handle(ctx, out)
+}
+
+func (h redactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redactedAttrs := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redactedAttrs[i] = h.redact(a)
+	}
+	return redactHandler{Handler: h.Handler.WithAttrs(redactedAttrs), keys: h.keys, maxLen: h.maxLen}
+}
+
+func (h redactHandler) WithGroup(name string) slog.Handler {
+	return redactHandler{Handler: h.Handler.WithGroup(name), keys: h.keys, maxLen: h.maxLen}
+}
+
+func (h redactHandler) redact(a slog.Attr) slog.Attr {
+	if h.sensitive(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+
+	value := a.Value.Resolve()
+	switch value.Kind() {
+	case slog.KindGroup:
+		group := value.Group()
+		redactedGroup := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			redactedGroup[i] = h.redact(ga)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(redactedGroup...)}
+	case slog.KindString:
+		return slog.String(a.Key, h.truncate(value.String()))
+	case slog.KindAny:
+		if b, ok := value.Any().([]byte); ok {
+			return slog.String(a.Key, h.truncate(string(b)))
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: value}
+}
+
+func (h redactHandler) sensitive(key string) bool {
+	key = strings.ToLower(key)
+	if h.keys[key] {
+		return true
+	}
+	if i := strings.LastIndexByte(key, '_'); i >= 0 {
+		return h.keys[key[i+1:]]
+	}
+	return false
+}
+
+func (h redactHandler) truncate(s string) string {
+	if h.maxLen <= 0 || len(s) <= h.maxLen {
+		return s
+	}
+	n := h.maxLen
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return fmt.Sprintf("%s...[truncated %d bytes]", s[:n], len(s)-n)
+}
+
+// Fingerprint returns a short hash of value, for logs that need to tell
+// values apart without containing them.
+func Fingerprint(value []byte) string {
+	sum := sha256.Sum256(value)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactHandler(t *testing.T) {
+	tests := []struct {
+		name  string
+		log   func(*Logger)
+		check func(t *testing.T, record map[string]any)
+	}{
+		{
+			name: "configured keys are masked",
+			log: func(l *Logger) {
+				l.Info("user lookup", slog.String("email", "jane@example.com"), slog.String("Password", "hunter2"), slog.Int64("user_id", 7))
+			},
+			check: func(t *testing.T, record map[string]any) {
+				assert.Equal(t, redacted, record["email"])
+				assert.Equal(t, redacted, record["Password"])
+				assert.Equal(t, float64(7), record["user_id"])
+			},
+		},
+		{
+			name: "suffix matches but derived keys do not",
+			log: func(l *Logger) {
+				l.Info("event", slog.String("user_email", "jane@example.com"), slog.Int("payload_size", 12), slog.String("email_sha256", "abc"))
+			},
+			check: func(t *testing.T, record map[string]any) {
+				assert.Equal(t, redacted, record["user_email"])
+				assert.Equal(t, float64(12), record["payload_size"])
+				assert.Equal(t, "abc", record["email_sha256"])
+			},
+		},
+		{
+			name: "keys inside groups and With attributes",
+			log: func(l *Logger) {
+				l.With(slog.String("token", "device-token")).Info("push", slog.Group("request", slog.Any("payload", []byte(`{"a":1}`))))
+			},
+			check: func(t *testing.T, record map[string]any) {
+				assert.Equal(t, redacted, record["token"])
+				assert.Equal(t, map[string]any{"payload": redacted}, record["request"])
+			},
+		},
+		{
+			name: "long values are truncated",
+			log: func(l *Logger) {
+				l.Info("failure", slog.String("error", strings.Repeat("x", 40)))
+			},
+			check: func(t *testing.T, record map[string]any) {
+				assert.Equal(t, strings.Repeat("x", 16)+"...[truncated 24 bytes]", record["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			handler := newRedactHandler(slog.NewJSONHandler(&buf, nil), []string{"email", "password", "payload", "token"}, 16)
+			tt.log(&Logger{Logger: slog.New(handler)})
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+			tt.check(t, record)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+// Sampler thins out high-volume log lines: in every tick the first Initial
+// calls are allowed, then every Thereafter-th. A nil Sampler, or one with
+// Initial <= 0, allows everything.
+type Sampler struct {
+	initial    int
+	thereafter int
+	tick       time.Duration
+	now        func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	count       int
+}
+
+func NewSampler(initial, thereafter int, tick time.Duration) *Sampler {
+	if tick <= 0 {
+		tick = time.Second
+	}
+	return &Sampler{initial: initial, thereafter: thereafter, tick: tick, now: time.Now}
+}
+
+// Allow reports whether the next log line should be written.
+func (s *Sampler) Allow() bool {
+	if s == nil || s.initial <= 0 {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.windowStart) >= s.tick {
+		s.windowStart = now
+		s.count = 0
+	}
+	s.count++
+	if s.count <= s.initial {
+		return true
+	}
+	return s.thereafter > 0 && (s.count-s.initial)%s.thereafter == 0
+}
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampler(t *testing.T) {
+	tests := []struct {
+		name       string
+		sampler    *Sampler
+		calls      int
+		advance    time.Duration
+		wantPassed int
+	}{
+		{name: "nil sampler allows everything", sampler: nil, calls: 10, wantPassed: 10},
+		{name: "disabled sampler allows everything", sampler: NewSampler(0, 0, time.Second), calls: 10, wantPassed: 10},
+		{name: "first initial then every thereafter", sampler: NewSampler(3, 5, time.Second), calls: 20, wantPassed: 3 + 3},
+		{name: "zero thereafter drops the rest", sampler: NewSampler(3, 0, time.Second), calls: 20, wantPassed: 3},
+		{name: "new tick starts over", sampler: NewSampler(3, 0, time.Second), calls: 20, advance: 100 * time.Millisecond, wantPassed: 3 + 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(0, 0)
+			if tt.sampler != nil {
+				tt.sampler.now = func() time.Time { return now }
+			}
+
+			passed := 0
+			for range tt.calls {
+				if tt.sampler.Allow() {
+					passed++
+				}
+				now = now.Add(tt.advance)
+			}
+			assert.Equal(t, tt.wantPassed, passed)
+		})
+	}
+}
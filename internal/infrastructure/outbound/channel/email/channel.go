@@ -0,0 +1,223 @@
+// Package email delivers notifications over SMTP.
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"strconv"
+	"time"
+)
+
+const (
+	TLSNone     = "none"
+	TLSStartTLS = "starttls"
+	TLSImplicit = "tls"
+)
+
+// UnsubscribeLinker builds the per-user unsubscribe link put in every email.
+type UnsubscribeLinker interface {
+	URL(userID int64, channel model.Channel) string
+}
+
+type Channel struct {
+	config      config.EmailConfig
+	templates   *Templates
+	unsubscribe UnsubscribeLinker
+	log         ports.Logger
+}
+
+func NewChannel(cfg config.EmailConfig, templates *Templates, unsubscribe UnsubscribeLinker, log ports.Logger) (*Channel, error) {
+	switch cfg.TLS {
+	case TLSNone, TLSStartTLS, TLSImplicit:
+	default:
+		return nil, fmt.Errorf("unknown email tls mode %q", cfg.TLS)
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return nil, fmt.Errorf("invalid email sender %q: %w", cfg.From, err)
+	}
+
+	return &Channel{
+		config:      cfg,
+		templates:   templates,
+		unsubscribe: unsubscribe,
+		log:         log,
+	}, nil
+}
+
+func (c *Channel) Name() model.Channel {
+	return model.ChannelEmail
+}
+
+func (c *Channel) Send(ctx context.Context, notification *model.Notification, recipient *model.User) error {
+	to, err := recipientAddress(recipient)
+	if err != nil {
+		return err
+	}
+	unsubscribeURL := c.unsubscribeURL(recipient.ID, model.ChannelEmail)
+
+	msg, err := c.templates.Render(TemplateData{
+		Recipient:      recipient,
+		Notification:   notification,
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		// A broken template fails the same way on every attempt.
+		return fmt.Errorf("%w: %w", model.ErrPermanentDeliveryFailure, err)
+	}
+
+	now := time.Now()
+	from := mail.Address{Name: c.config.FromName, Address: c.config.From}
+	raw, err := buildMessage(from, to.String(), msg, messageID("notification", notification.ID, c.config.From, now), unsubscribeURL, now)
+	if err != nil {
+		return err
+	}
+
+	if err := c.send(ctx, to.Address, raw); err != nil {
+		return err
+	}
+
+	c.log.Debug("Email sent",
+		slog.Int64("notification_id", notification.ID),
+		slog.Int64("user_id", recipient.ID),
+	)
+	return nil
+}
+
+// SendDigest sends the digest as one email. Its unsubscribe link turns off
+// digests only, not per-notification emails.
+func (c *Channel) SendDigest(ctx context.Context, recipient *model.User, digest *model.Digest) error {
+	to, err := recipientAddress(recipient)
+	if err != nil {
+		return err
+	}
+	unsubscribeURL := c.unsubscribeURL(recipient.ID, model.ChannelDigest)
+
+	msg, err := c.templates.RenderDigest(DigestTemplateData{
+		Recipient:      recipient,
+		Digest:         digest,
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", model.ErrPermanentDeliveryFailure, err)
+	}
+
+	now := time.Now()
+	from := mail.Address{Name: c.config.FromName, Address: c.config.From}
+	raw, err := buildMessage(from, to.String(), msg, messageID("digest", recipient.ID, c.config.From, now), unsubscribeURL, now)
+	if err != nil {
+		return err
+	}
+
+	if err := c.send(ctx, to.Address, raw); err != nil {
+		return err
+	}
+
+	c.log.Debug("Digest email sent",
+		slog.Int64("user_id", recipient.ID),
+		slog.Int("notifications", digest.Total),
+	)
+	return nil
+}
+
+func recipientAddress(recipient *model.User) (*mail.Address, error) {
+	if recipient.Email == "" {
+		return nil, fmt.Errorf("user %d has no email address: %w", recipient.ID, model.ErrPermanentDeliveryFailure)
+	}
+	to, err := mail.ParseAddress(recipient.Email)
+	if err != nil {
+		return nil, fmt.Errorf("invalid email address for user %d: %w", recipient.ID, model.ErrPermanentDeliveryFailure)
+	}
+	return to, nil
+}
+
+func (c *Channel) unsubscribeURL(userID int64, channel model.Channel) string {
+	if c.unsubscribe == nil {
+		return ""
+	}
+	return c.unsubscribe.URL(userID, channel)
+}
+
+func (c *Channel) send(ctx context.Context, to string, raw []byte) (err error) {
+	addr := net.JoinHostPort(c.config.Host, strconv.Itoa(c.config.Port))
+	dialer := &net.Dialer{Timeout: c.config.Timeout}
+
+	var conn net.Conn
+	if c.config.TLS == TLSImplicit {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: c.config.Host}}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect to smtp server: %w", err)
+	}
+
+	deadline, hasDeadline := ctx.Deadline()
+	if c.config.Timeout > 0 {
+		if timeout := time.Now().Add(c.config.Timeout); !hasDeadline || timeout.Before(deadline) {
+			deadline, hasDeadline = timeout, true
+		}
+	}
+	if hasDeadline {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, c.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if c.config.TLS == TLSStartTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: c.config.Host}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+
+	if c.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.config.Username, c.config.Password, c.config.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(c.config.From); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", classify(err))
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := w.Write(raw); err != nil {
+		return fmt.Errorf("smtp write: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp data: %w", classify(err))
+	}
+
+	return client.Quit()
+}
+
+// classify marks 5xx replies as permanent: the server rejected the message
+// or mailbox and retrying will not change that.
+func classify(err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return fmt.Errorf("%w: %w", model.ErrPermanentDeliveryFailure, err)
+	}
+	return err
+}
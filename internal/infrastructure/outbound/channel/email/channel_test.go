@@ -0,0 +1,282 @@
+package email_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/email"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticLinker string
+
+func (l staticLinker) URL(userID int64, channel model.Channel) string {
+	return string(l)
+}
+
+// smtpSink is a minimal SMTP server that accepts one message per connection
+// and hands it to received, or rejects recipients with rejectRcpt.
+type smtpSink struct {
+	listener   net.Listener
+	received   chan string
+	rejectRcpt string
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	sink := &smtpSink{listener: listener, received: make(chan string, 1)}
+	t.Cleanup(func() { _ = listener.Close() })
+	go sink.serve()
+	return sink
+}
+
+func (s *smtpSink) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpSink) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	reply("220 sink ready")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 sink")
+		case strings.HasPrefix(cmd, "MAIL FROM"):
+			reply("250 ok")
+		case strings.HasPrefix(cmd, "RCPT TO"):
+			if s.rejectRcpt != "" && strings.Contains(cmd, strings.ToUpper(s.rejectRcpt)) {
+				reply("550 no such user")
+				continue
+			}
+			reply("250 ok")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.received <- data.String()
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func newTestChannel(t *testing.T, sink *smtpSink) *email.Channel {
+	addr := sink.listener.Addr().(*net.TCPAddr)
+	templates, err := email.LoadTemplates("")
+	require.NoError(t, err)
+
+	channel, err := email.NewChannel(config.EmailConfig{
+		Host:     addr.IP.String(),
+		Port:     addr.Port,
+		From:     "no-reply@pinstack.local",
+		FromName: "Pinstack",
+		TLS:      email.TLSNone,
+		Timeout:  5 * time.Second,
+	}, templates, staticLinker("https://pinstack.local/unsubscribe?token=abc"), logger.New("dev"))
+	require.NoError(t, err)
+	return channel
+}
+
+func TestChannel_Send(t *testing.T) {
+	sink := newSMTPSink(t)
+	channel := newTestChannel(t, sink)
+
+	notification := &model.Notification{
+		ID:      10,
+		UserID:  1,
+		Type:    events.EventTypeFollowCreated,
+		Payload: json.RawMessage(`{"follower_id":1234567}`),
+	}
+	recipient := &model.User{ID: 1, Username: "alice", Email: "alice@example.com"}
+
+	require.NoError(t, channel.Send(context.Background(), notification, recipient))
+
+	var raw string
+	select {
+	case raw = <-sink.received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not delivered to the sink")
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(raw))
+	require.NoError(t, err)
+	assert.Equal(t, "You have a new follower", msg.Header.Get("Subject"))
+	assert.Equal(t, "<https://pinstack.local/unsubscribe?token=abc>", msg.Header.Get("List-Unsubscribe"))
+	assert.Contains(t, msg.Header.Get("From"), "no-reply@pinstack.local")
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var contentTypes, bodies []string
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+		bodies = append(bodies, string(body))
+	}
+
+	assert.Equal(t, []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}, contentTypes)
+	assert.Contains(t, bodies[0], "User #1234567 started following you")
+	assert.Contains(t, bodies[1], `href="https://pinstack.local/unsubscribe?token=abc"`)
+}
+
+func TestChannel_Send_PermanentFailures(t *testing.T) {
+	sink := newSMTPSink(t)
+	sink.rejectRcpt = "ghost@example.com"
+	channel := newTestChannel(t, sink)
+	notification := &model.Notification{ID: 10, UserID: 1, Type: "relation"}
+
+	tests := []struct {
+		name      string
+		recipient *model.User
+	}{
+		{name: "no email address", recipient: &model.User{ID: 1}},
+		{name: "malformed email address", recipient: &model.User{ID: 1, Email: "not-an-address"}},
+		{name: "recipient rejected by server", recipient: &model.User{ID: 1, Email: "ghost@example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := channel.Send(context.Background(), notification, tt.recipient)
+			assert.ErrorIs(t, err, model.ErrPermanentDeliveryFailure)
+		})
+	}
+}
+
+func TestChannel_Send_ServerUnavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().(*net.TCPAddr)
+	require.NoError(t, listener.Close())
+
+	templates, err := email.LoadTemplates("")
+	require.NoError(t, err)
+	channel, err := email.NewChannel(config.EmailConfig{
+		Host:    addr.IP.String(),
+		Port:    addr.Port,
+		From:    "no-reply@pinstack.local",
+		TLS:     email.TLSNone,
+		Timeout: time.Second,
+	}, templates, nil, logger.New("dev"))
+	require.NoError(t, err)
+
+	err = channel.Send(context.Background(), &model.Notification{ID: 1, Type: "relation"}, &model.User{ID: 1, Email: "alice@example.com"})
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, model.ErrPermanentDeliveryFailure)
+}
+
+func TestLoadTemplates_Override(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "follow_created.subject.tmpl"), []byte("{{.Recipient.Username}}, someone followed you"), 0o600))
+
+	templates, err := email.LoadTemplates(dir)
+	require.NoError(t, err)
+
+	msg, err := templates.Render(email.TemplateData{
+		Recipient:    &model.User{Username: "alice"},
+		Notification: &model.Notification{Type: events.EventTypeFollowCreated, Payload: json.RawMessage(`{"follower_id":42}`)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice, someone followed you", msg.Subject)
+	assert.Contains(t, msg.Text, "User #42 started following you")
+	assert.NotContains(t, msg.Text, "unsubscribe")
+}
+
+func TestLoadTemplates_InvalidTemplate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "default.txt.tmpl"), []byte("{{.Broken"), 0o600))
+
+	_, err := email.LoadTemplates(dir)
+
+	assert.Error(t, err)
+}
+
+func TestTemplates_RenderDigest(t *testing.T) {
+	templates, err := email.LoadTemplates("")
+	require.NoError(t, err)
+
+	createdAt := time.Date(2025, 6, 2, 14, 30, 0, 0, time.UTC)
+	digest := &model.Digest{
+		UserID:    1,
+		Frequency: model.DigestFrequencyWeekly,
+		Total:     3,
+		Groups: []*model.DigestGroup{
+			{Type: events.EventTypeFollowCreated, Notifications: []*model.Notification{
+				{ID: 3, Type: events.EventTypeFollowCreated, CreatedAt: createdAt, Payload: json.RawMessage(`{"follower_id":42}`)},
+				{ID: 2, Type: events.EventTypeFollowCreated, CreatedAt: createdAt, Payload: json.RawMessage(`{"follower_id":43}`)},
+			}},
+			{Type: "post_liked", Notifications: []*model.Notification{
+				{ID: 1, Type: "post_liked", CreatedAt: createdAt},
+			}},
+		},
+	}
+
+	msg, err := templates.RenderDigest(email.DigestTemplateData{
+		Recipient:      &model.User{ID: 1, Username: "alice"},
+		Digest:         digest,
+		UnsubscribeURL: "https://pinstack.local/unsubscribe?token=abc",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Your weekly Pinstack digest: 3 unread", msg.Subject)
+	assert.Contains(t, msg.Text, "New followers (2):")
+	assert.Contains(t, msg.Text, "User #42 started following you (Jun 2, 14:30)")
+	assert.Contains(t, msg.Text, "post_liked (1):")
+	assert.Contains(t, msg.Text, "To stop receiving digests, unsubscribe: https://pinstack.local/unsubscribe?token=abc")
+	assert.Contains(t, msg.HTML, "User #43 started following you")
+}
@@ -0,0 +1,205 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	model "pinstack-notification-service/internal/domain/models"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+const (
+	// defaultTemplateName is used for notification types without their own templates.
+	defaultTemplateName = "default"
+	// digestTemplateName renders the periodic digest of unread notifications.
+	digestTemplateName = "digest"
+)
+
+// Templates holds the subject, plain text and HTML templates for each
+// notification type. Files are named <type>.subject.tmpl, <type>.txt.tmpl
+// and <type>.html.tmpl; a directory given to LoadTemplates overrides the
+// built-in templates file by file.
+type Templates struct {
+	subjects map[string]*texttemplate.Template
+	texts    map[string]*texttemplate.Template
+	htmls    map[string]*htmltemplate.Template
+}
+
+// TemplateData is what email templates are executed with. Payload is the
+// notification payload decoded as a JSON object.
+type TemplateData struct {
+	Recipient      *model.User
+	Notification   *model.Notification
+	Payload        map[string]any
+	UnsubscribeURL string
+}
+
+// DigestTemplateData is what digest templates are executed with. Groups
+// mirror Digest.Groups with every payload decoded.
+type DigestTemplateData struct {
+	Recipient      *model.User
+	Digest         *model.Digest
+	Groups         []DigestGroupData
+	UnsubscribeURL string
+}
+
+type DigestGroupData struct {
+	Type  string
+	Items []DigestItemData
+}
+
+type DigestItemData struct {
+	Notification *model.Notification
+	Payload      map[string]any
+}
+
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+func LoadTemplates(dir string) (*Templates, error) {
+	t := &Templates{
+		subjects: make(map[string]*texttemplate.Template),
+		texts:    make(map[string]*texttemplate.Template),
+		htmls:    make(map[string]*htmltemplate.Template),
+	}
+
+	sources := []fs.FS{defaultTemplates}
+	if dir != "" {
+		sources = append(sources, os.DirFS(dir))
+	}
+
+	for i, source := range sources {
+		pattern := "*.tmpl"
+		if i == 0 {
+			pattern = "templates/*.tmpl"
+		}
+		files, err := fs.Glob(source, pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if err := t.add(source, file); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, name := range []string{defaultTemplateName, digestTemplateName} {
+		if t.subjects[name] == nil || t.texts[name] == nil || t.htmls[name] == nil {
+			return nil, fmt.Errorf("%s email templates are missing", name)
+		}
+	}
+	return t, nil
+}
+
+func (t *Templates) add(source fs.FS, file string) error {
+	content, err := fs.ReadFile(source, file)
+	if err != nil {
+		return err
+	}
+
+	base := file[strings.LastIndex(file, "/")+1:]
+	name, kind, ok := strings.Cut(strings.TrimSuffix(base, ".tmpl"), ".")
+	if !ok {
+		return fmt.Errorf("email template %s: expected <type>.<subject|txt|html>.tmpl", file)
+	}
+
+	switch kind {
+	case "subject":
+		tmpl, err := texttemplate.New(base).Option("missingkey=zero").Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("email template %s: %w", file, err)
+		}
+		t.subjects[name] = tmpl
+	case "txt":
+		tmpl, err := texttemplate.New(base).Option("missingkey=zero").Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("email template %s: %w", file, err)
+		}
+		t.texts[name] = tmpl
+	case "html":
+		tmpl, err := htmltemplate.New(base).Option("missingkey=zero").Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("email template %s: %w", file, err)
+		}
+		t.htmls[name] = tmpl
+	default:
+		return fmt.Errorf("email template %s: unknown kind %q", file, kind)
+	}
+	return nil
+}
+
+// Render executes the templates for the notification type, falling back to
+// the default templates for any part the type does not define.
+func (t *Templates) Render(data TemplateData) (*Message, error) {
+	if data.Payload == nil {
+		data.Payload = decodePayload(data.Notification)
+	}
+	return t.execute(string(data.Notification.Type), data)
+}
+
+// RenderDigest executes the digest templates.
+func (t *Templates) RenderDigest(data DigestTemplateData) (*Message, error) {
+	if data.Groups == nil {
+		data.Groups = make([]DigestGroupData, 0, len(data.Digest.Groups))
+		for _, group := range data.Digest.Groups {
+			items := make([]DigestItemData, 0, len(group.Notifications))
+			for _, n := range group.Notifications {
+				items = append(items, DigestItemData{Notification: n, Payload: decodePayload(n)})
+			}
+			data.Groups = append(data.Groups, DigestGroupData{Type: string(group.Type), Items: items})
+		}
+	}
+	return t.execute(digestTemplateName, data)
+}
+
+func (t *Templates) execute(name string, data any) (*Message, error) {
+	var subject, text, html bytes.Buffer
+	if err := pick(t.subjects, name).Execute(&subject, data); err != nil {
+		return nil, fmt.Errorf("render email subject: %w", err)
+	}
+	if err := pick(t.texts, name).Execute(&text, data); err != nil {
+		return nil, fmt.Errorf("render email text: %w", err)
+	}
+	if err := pick(t.htmls, name).Execute(&html, data); err != nil {
+		return nil, fmt.Errorf("render email html: %w", err)
+	}
+
+	return &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// decodePayload decodes the payload as a JSON object. Numbers stay
+// json.Number so ids are not printed in float notation. A payload that is
+// not an object is still available as .Notification.Payload.
+func decodePayload(notification *model.Notification) map[string]any {
+	if len(notification.Payload) == 0 {
+		return nil
+	}
+	var payload map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(notification.Payload))
+	decoder.UseNumber()
+	_ = decoder.Decode(&payload)
+	return payload
+}
+
+func pick[T any](templates map[string]*T, name string) *T {
+	if tmpl, ok := templates[name]; ok {
+		return tmpl
+	}
+	return templates[defaultTemplateName]
+}
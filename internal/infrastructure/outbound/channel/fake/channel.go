@@ -0,0 +1,66 @@
+// Package fake provides an in-memory delivery channel for local runs and tests.
+package fake
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"sync"
+)
+
+// Sent is one notification accepted by a fake channel.
+type Sent struct {
+	Notification *model.Notification
+	Recipient    *model.User
+}
+
+// Channel records what it is asked to send and logs it instead of
+// contacting a provider. FailWith makes subsequent sends return an error.
+type Channel struct {
+	name model.Channel
+	log  ports.Logger
+
+	mu   sync.Mutex
+	sent []Sent
+	err  error
+}
+
+func NewChannel(name model.Channel, log ports.Logger) *Channel {
+	return &Channel{name: name, log: log}
+}
+
+func (c *Channel) Name() model.Channel {
+	return c.name
+}
+
+func (c *Channel) Send(ctx context.Context, notification *model.Notification, recipient *model.User) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+
+	c.sent = append(c.sent, Sent{Notification: notification, Recipient: recipient})
+	if c.log != nil {
+		c.log.Info("Fake channel delivered notification",
+			slog.String("channel", string(c.name)),
+			slog.Int64("notification_id", notification.ID),
+			slog.Int64("user_id", recipient.ID),
+		)
+	}
+	return nil
+}
+
+func (c *Channel) FailWith(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func (c *Channel) Sent() []Sent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Sent(nil), c.sent...)
+}
@@ -0,0 +1,182 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	apnsProductionEndpoint = "https://api.push.apple.com"
+	apnsSandboxEndpoint    = "https://api.sandbox.push.apple.com"
+	// apnsTokenTTL: APNs rejects provider tokens older than an hour and
+	// throttles ones refreshed more often than every 20 minutes.
+	apnsTokenTTL = 50 * time.Minute
+)
+
+// apnsInvalidTokenReasons are APNs error reasons that mean the token will
+// never work again.
+var apnsInvalidTokenReasons = map[string]bool{
+	"BadDeviceToken":         true,
+	"Unregistered":           true,
+	"DeviceTokenNotForTopic": true,
+}
+
+// APNsProvider sends through the APNs HTTP/2 API with token-based
+// authentication.
+type APNsProvider struct {
+	endpoint string
+	teamID   string
+	keyID    string
+	topic    string
+	key      *ecdsa.PrivateKey
+	client   *http.Client
+	now      func() time.Time
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func NewAPNsProvider(cfg config.APNsConfig, timeout time.Duration) (*APNsProvider, error) {
+	if cfg.TeamID == "" || cfg.KeyID == "" || cfg.Topic == "" {
+		return nil, errors.New("apns: team_id, key_id and topic are required")
+	}
+	raw, err := os.ReadFile(cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read apns key: %w", err)
+	}
+	parsed, err := parsePKCS8(raw)
+	if err != nil {
+		return nil, fmt.Errorf("apns key: %w", err)
+	}
+	key, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("apns key: private key is not ECDSA")
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = apnsSandboxEndpoint
+		if cfg.Production {
+			endpoint = apnsProductionEndpoint
+		}
+	}
+
+	return &APNsProvider{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		teamID:   cfg.TeamID,
+		keyID:    cfg.KeyID,
+		topic:    cfg.Topic,
+		key:      key,
+		client:   newHTTP2Client(timeout),
+		now:      time.Now,
+	}, nil
+}
+
+func (p *APNsProvider) Name() string {
+	return "apns"
+}
+
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+func (p *APNsProvider) Send(ctx context.Context, device *model.DeviceToken, message *model.PushMessage) error {
+	providerToken, err := p.providerToken()
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{
+		"aps": map[string]any{
+			"alert": apnsAlert{Title: message.Title, Body: message.Body},
+			"sound": "default",
+		},
+	}
+	for k, v := range messageData(message) {
+		payload[k] = v
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		p.endpoint+"/3/device/"+url.PathEscape(device.Token), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("apns-topic", p.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("apns request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var apnsErr struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&apnsErr)
+
+	switch {
+	case resp.StatusCode == http.StatusGone || apnsInvalidTokenReasons[apnsErr.Reason]:
+		return fmt.Errorf("apns %s: %w", apnsErr.Reason, model.ErrInvalidDeviceToken)
+	case apnsErr.Reason == "ExpiredProviderToken":
+		p.resetToken()
+		return errors.New("apns rejected an expired provider token")
+	default:
+		return classifyStatus("apns", resp.StatusCode, apnsErr.Reason)
+	}
+}
+
+func (p *APNsProvider) providerToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.token != "" && now.Sub(p.issuedAt) < apnsTokenTTL {
+		return p.token, nil
+	}
+
+	token, err := signJWT(
+		map[string]any{"alg": "ES256", "kid": p.keyID},
+		map[string]any{"iss": p.teamID, "iat": now.Unix()},
+		signES256(p.key),
+	)
+	if err != nil {
+		return "", fmt.Errorf("sign apns token: %w", err)
+	}
+	p.token = token
+	p.issuedAt = now
+	return token, nil
+}
+
+func (p *APNsProvider) resetToken() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.token = ""
+}
@@ -0,0 +1,131 @@
+package push_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/push"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAPNsKey(t *testing.T) (string, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "AuthKey.p8")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return path, key
+}
+
+// verifyES256 checks a provider token the way APNs does.
+func verifyES256(t *testing.T, token string, key *ecdsa.PublicKey) map[string]any {
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	require.Len(t, signature, 64)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+	require.True(t, ecdsa.Verify(key, digest[:], r, s), "provider token signature does not verify")
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	var header map[string]any
+	require.NoError(t, json.Unmarshal(rawHeader, &header))
+	return header
+}
+
+func TestAPNsProvider_Send(t *testing.T) {
+	device := &model.DeviceToken{Token: "apns-token", Platform: model.DevicePlatformIOS}
+	message := &model.PushMessage{Title: "New follower", Body: "User #42 started following you", DeepLink: "pinstack://app/users/42"}
+
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{name: "accepted", status: http.StatusOK},
+		{name: "unregistered token", status: http.StatusGone, body: `{"reason":"Unregistered"}`, wantErr: model.ErrInvalidDeviceToken},
+		{name: "bad device token", status: http.StatusBadRequest, body: `{"reason":"BadDeviceToken"}`, wantErr: model.ErrInvalidDeviceToken},
+		{name: "payload rejected", status: http.StatusRequestEntityTooLarge, body: `{"reason":"PayloadTooLarge"}`, wantErr: model.ErrPermanentDeliveryFailure},
+		{name: "outage is transient", status: http.StatusServiceUnavailable, body: `{"reason":"ServiceUnavailable"}`, wantAnyErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyFile, key := writeAPNsKey(t)
+
+			var gotPayload map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/3/device/apns-token", r.URL.Path)
+				assert.Equal(t, "com.pinstack.app", r.Header.Get("apns-topic"))
+				assert.Equal(t, "alert", r.Header.Get("apns-push-type"))
+
+				header := verifyES256(t, strings.TrimPrefix(r.Header.Get("Authorization"), "bearer "), &key.PublicKey)
+				assert.Equal(t, "KEY123", header["kid"])
+
+				raw, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(raw, &gotPayload)
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+
+			provider, err := push.NewAPNsProvider(config.APNsConfig{
+				TeamID:   "TEAM123",
+				KeyID:    "KEY123",
+				KeyFile:  keyFile,
+				Topic:    "com.pinstack.app",
+				Endpoint: server.URL,
+			}, 5*time.Second)
+			require.NoError(t, err)
+
+			err = provider.Send(context.Background(), device, message)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantAnyErr:
+				require.Error(t, err)
+				assert.False(t, errors.Is(err, model.ErrPermanentDeliveryFailure))
+				assert.False(t, errors.Is(err, model.ErrInvalidDeviceToken))
+			default:
+				require.NoError(t, err)
+				alert := gotPayload["aps"].(map[string]any)["alert"].(map[string]any)
+				assert.Equal(t, "New follower", alert["title"])
+				assert.Equal(t, "pinstack://app/users/42", gotPayload["deep_link"])
+			}
+		})
+	}
+}
+
+func TestNewAPNsProvider_RequiresIdentity(t *testing.T) {
+	keyFile, _ := writeAPNsKey(t)
+	_, err := push.NewAPNsProvider(config.APNsConfig{KeyFile: keyFile, Topic: "com.pinstack.app"}, time.Second)
+	assert.Error(t, err)
+}
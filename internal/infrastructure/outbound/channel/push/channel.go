@@ -0,0 +1,135 @@
+// Package push delivers notifications to mobile devices through FCM or APNs.
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+)
+
+// Channel sends a notification to every device the recipient registered,
+// through the provider configured for the device platform. Tokens the
+// provider reports as invalid are removed from the registry.
+type Channel struct {
+	tokens       ports.DeviceTokenRepository
+	providers    map[model.DevicePlatform]ports.PushProvider
+	templates    *Templates
+	deepLinkBase string
+	log          ports.Logger
+}
+
+func NewChannel(
+	tokens ports.DeviceTokenRepository,
+	providers map[model.DevicePlatform]ports.PushProvider,
+	templates *Templates,
+	deepLinkBase string,
+	log ports.Logger,
+) *Channel {
+	return &Channel{
+		tokens:       tokens,
+		providers:    providers,
+		templates:    templates,
+		deepLinkBase: deepLinkBase,
+		log:          log,
+	}
+}
+
+func (c *Channel) Name() model.Channel {
+	return model.ChannelPush
+}
+
+// Send succeeds when at least one device accepted the push. It is retried
+// only when no device did and some failure was transient, so a retry may
+// reach a device that already got the message.
+func (c *Channel) Send(ctx context.Context, notification *model.Notification, recipient *model.User) error {
+	devices, err := c.tokens.ListByUser(ctx, recipient.ID)
+	if err != nil {
+		return fmt.Errorf("list device tokens: %w", err)
+	}
+	if len(devices) == 0 {
+		return fmt.Errorf("user %d has no registered devices: %w", recipient.ID, model.ErrPermanentDeliveryFailure)
+	}
+
+	var delivered int
+	var invalid []string
+	var transient error
+	for _, device := range devices {
+		provider, ok := c.providers[device.Platform]
+		if !ok {
+			c.log.Warn("No push provider for platform",
+				slog.Int64("user_id", recipient.ID),
+				slog.String("platform", string(device.Platform)),
+			)
+			continue
+		}
+
+		message, err := c.templates.Render(TemplateData{
+			Recipient:    recipient,
+			Device:       device,
+			Notification: notification,
+			DeepLinkBase: c.deepLinkBase,
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %v", model.ErrPermanentDeliveryFailure, err)
+		}
+
+		err = provider.Send(ctx, device, message)
+		switch {
+		case err == nil:
+			delivered++
+		case errors.Is(err, model.ErrInvalidDeviceToken):
+			invalid = append(invalid, device.Token)
+		case errors.Is(err, model.ErrPermanentDeliveryFailure):
+			c.log.Warn("Push rejected by provider",
+				slog.String("provider", provider.Name()),
+				slog.Int64("user_id", recipient.ID),
+				slog.String("error", err.Error()),
+			)
+		default:
+			transient = err
+			c.log.Warn("Push failed",
+				slog.String("provider", provider.Name()),
+				slog.Int64("user_id", recipient.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	c.prune(ctx, recipient.ID, invalid)
+
+	switch {
+	case delivered > 0:
+		c.log.Debug("Push delivered",
+			slog.Int64("notification_id", notification.ID),
+			slog.Int64("user_id", recipient.ID),
+			slog.Int("devices", delivered),
+		)
+		return nil
+	case transient != nil:
+		return transient
+	default:
+		return fmt.Errorf("no device of user %d accepted the push: %w", recipient.ID, model.ErrPermanentDeliveryFailure)
+	}
+}
+
+func (c *Channel) prune(ctx context.Context, userID int64, tokens []string) {
+	if len(tokens) == 0 {
+		return
+	}
+
+	deleted, err := c.tokens.DeleteTokens(ctx, tokens)
+	if err != nil {
+		c.log.Error("Failed to prune invalid device tokens",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	c.log.Info("Pruned invalid device tokens",
+		slog.Int64("user_id", userID),
+		slog.Int64("count", deleted),
+	)
+}
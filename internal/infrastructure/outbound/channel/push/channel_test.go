@@ -0,0 +1,142 @@
+package push_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/push"
+	"pinstack-notification-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannel_Send(t *testing.T) {
+	notification := &model.Notification{
+		ID:      10,
+		UserID:  1,
+		Type:    events.EventTypeFollowCreated,
+		Payload: json.RawMessage(`{"follower_id":42,"followee_id":1}`),
+	}
+	recipient := &model.User{ID: 1, Username: "alice"}
+	android := &model.DeviceToken{UserID: 1, Token: "android-token", Platform: model.DevicePlatformAndroid}
+	ios := &model.DeviceToken{UserID: 1, Token: "ios-token", Platform: model.DevicePlatformIOS}
+
+	tests := []struct {
+		name          string
+		devices       []*model.DeviceToken
+		invalid       []string
+		providerErr   error
+		noIOSProvider bool
+		wantPruned    []string
+		wantSent      int
+		wantErr       bool
+		wantPermanent bool
+	}{
+		{
+			name:     "every device gets the push",
+			devices:  []*model.DeviceToken{android, ios},
+			wantSent: 2,
+		},
+		{
+			name:       "invalid tokens are pruned and the rest delivered",
+			devices:    []*model.DeviceToken{android, ios},
+			invalid:    []string{"ios-token"},
+			wantPruned: []string{"ios-token"},
+			wantSent:   1,
+		},
+		{
+			name:          "only invalid tokens is a permanent failure",
+			devices:       []*model.DeviceToken{android, ios},
+			invalid:       []string{"android-token", "ios-token"},
+			wantPruned:    []string{"android-token", "ios-token"},
+			wantErr:       true,
+			wantPermanent: true,
+		},
+		{
+			name:          "no registered devices is a permanent failure",
+			devices:       nil,
+			wantErr:       true,
+			wantPermanent: true,
+		},
+		{
+			name:        "provider outage is retried",
+			devices:     []*model.DeviceToken{android},
+			providerErr: errors.New("fcm: status 503"),
+			wantErr:     true,
+		},
+		{
+			name:          "platform without a provider is skipped",
+			devices:       []*model.DeviceToken{android, ios},
+			noIOSProvider: true,
+			wantSent:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := mocks.NewDeviceTokenRepository(t)
+			tokens.On("ListByUser", mock.Anything, int64(1)).Return(tt.devices, nil)
+			if len(tt.wantPruned) > 0 {
+				tokens.On("DeleteTokens", mock.Anything, tt.wantPruned).Return(int64(len(tt.wantPruned)), nil)
+			}
+
+			provider := push.NewFakeProvider()
+			provider.Invalidate(tt.invalid...)
+			if tt.providerErr != nil {
+				provider.FailWith(tt.providerErr)
+			}
+			providers := map[model.DevicePlatform]ports.PushProvider{
+				model.DevicePlatformAndroid: provider,
+				model.DevicePlatformIOS:     provider,
+			}
+			if tt.noIOSProvider {
+				delete(providers, model.DevicePlatformIOS)
+			}
+
+			templates, err := push.LoadTemplates("")
+			require.NoError(t, err)
+			channel := push.NewChannel(tokens, providers, templates, "pinstack://app", logger.New("dev"))
+
+			err = channel.Send(context.Background(), notification, recipient)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantPermanent, errors.Is(err, model.ErrPermanentDeliveryFailure))
+			} else {
+				require.NoError(t, err)
+			}
+
+			sent := provider.Sent()
+			require.Len(t, sent, tt.wantSent)
+			for _, s := range sent {
+				assert.Equal(t, "New follower", s.Message.Title)
+				assert.Equal(t, "User #42 started following you", s.Message.Body)
+				assert.Equal(t, "pinstack://app/users/42", s.Message.DeepLink)
+				assert.Equal(t, "10", s.Message.Data["notification_id"])
+			}
+		})
+	}
+}
+
+func TestTemplates_DefaultFallback(t *testing.T) {
+	templates, err := push.LoadTemplates("")
+	require.NoError(t, err)
+
+	message, err := templates.Render(push.TemplateData{
+		Notification: &model.Notification{ID: 7, Type: "post_liked"},
+		DeepLinkBase: "pinstack://app",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Pinstack", message.Title)
+	assert.Equal(t, "You have a new notification", message.Body)
+	assert.Equal(t, "pinstack://app/notifications/7", message.DeepLink)
+}
@@ -0,0 +1,65 @@
+package push
+
+import (
+	"context"
+	model "pinstack-notification-service/internal/domain/models"
+	"sync"
+)
+
+// FakeSent is one message accepted by a FakeProvider.
+type FakeSent struct {
+	Device  *model.DeviceToken
+	Message *model.PushMessage
+}
+
+// FakeProvider records pushes instead of sending them. Tokens marked with
+// Invalidate are rejected as invalid, the way a real provider reports an
+// uninstalled app.
+type FakeProvider struct {
+	mu      sync.Mutex
+	sent    []FakeSent
+	invalid map[string]bool
+	err     error
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{invalid: make(map[string]bool)}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Send(ctx context.Context, device *model.DeviceToken, message *model.PushMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.invalid[device.Token] {
+		return model.ErrInvalidDeviceToken
+	}
+	if p.err != nil {
+		return p.err
+	}
+	p.sent = append(p.sent, FakeSent{Device: device, Message: message})
+	return nil
+}
+
+func (p *FakeProvider) Invalidate(tokens ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, token := range tokens {
+		p.invalid[token] = true
+	}
+}
+
+func (p *FakeProvider) FailWith(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func (p *FakeProvider) Sent() []FakeSent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]FakeSent(nil), p.sent...)
+}
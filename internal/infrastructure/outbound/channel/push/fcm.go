@@ -0,0 +1,241 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+	// accessTokenSlack renews OAuth tokens a little before they expire.
+	accessTokenSlack = time.Minute
+)
+
+// serviceAccount is the subset of a Google service account key file FCM needs.
+type serviceAccount struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMProvider sends through the FCM HTTP v1 API. The OAuth access token is
+// obtained with a signed JWT assertion and cached until shortly before it
+// expires.
+type FCMProvider struct {
+	endpoint string
+	project  string
+	account  serviceAccount
+	key      *rsa.PrivateKey
+	client   *http.Client
+	now      func() time.Time
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func NewFCMProvider(cfg config.FCMConfig, timeout time.Duration) (*FCMProvider, error) {
+	raw, err := os.ReadFile(cfg.CredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read fcm credentials: %w", err)
+	}
+	var account serviceAccount
+	if err := json.Unmarshal(raw, &account); err != nil {
+		return nil, fmt.Errorf("decode fcm credentials: %w", err)
+	}
+	parsed, err := parsePKCS8([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("fcm credentials: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("fcm credentials: private key is not RSA")
+	}
+
+	project := cfg.ProjectID
+	if project == "" {
+		project = account.ProjectID
+	}
+	if project == "" || account.ClientEmail == "" || account.TokenURI == "" {
+		return nil, errors.New("fcm credentials: project_id, client_email and token_uri are required")
+	}
+
+	return &FCMProvider{
+		endpoint: strings.TrimSuffix(cfg.Endpoint, "/"),
+		project:  project,
+		account:  account,
+		key:      key,
+		client:   newHTTP2Client(timeout),
+		now:      time.Now,
+	}, nil
+}
+
+func (p *FCMProvider) Name() string {
+	return "fcm"
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+	Android      fcmAndroid        `json:"android"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmAndroid struct {
+	Priority string `json:"priority"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func (p *FCMProvider) Send(ctx context.Context, device *model.DeviceToken, message *model.PushMessage) error {
+	accessToken, err := p.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(fcmRequest{Message: fcmMessage{
+		Token:        device.Token,
+		Notification: fcmNotification{Title: message.Title, Body: message.Body},
+		Data:         messageData(message),
+		Android:      fcmAndroid{Priority: "high"},
+	}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/v1/projects/%s/messages:send", p.endpoint, url.PathEscape(p.project)), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fcm request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var fcmErr fcmErrorResponse
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&fcmErr)
+	code := fcmErr.Error.Status
+	for _, d := range fcmErr.Error.Details {
+		if d.ErrorCode != "" {
+			code = d.ErrorCode
+		}
+	}
+
+	switch {
+	case code == "UNREGISTERED" || resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("fcm %s: %w", code, model.ErrInvalidDeviceToken)
+	case resp.StatusCode == http.StatusUnauthorized:
+		p.resetToken()
+		return fmt.Errorf("fcm rejected the access token: %s", fcmErr.Error.Message)
+	default:
+		return classifyStatus("fcm", resp.StatusCode, code)
+	}
+}
+
+// token returns a cached access token or exchanges a fresh JWT assertion.
+func (p *FCMProvider) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.accessToken != "" && now.Add(accessTokenSlack).Before(p.expiresAt) {
+		return p.accessToken, nil
+	}
+
+	assertion, err := signJWT(
+		map[string]any{"alg": "RS256", "typ": "JWT"},
+		map[string]any{
+			"iss":   p.account.ClientEmail,
+			"scope": fcmScope,
+			"aud":   p.account.TokenURI,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		},
+		signRS256(p.key),
+	)
+	if err != nil {
+		return "", fmt.Errorf("sign fcm assertion: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fcm token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fcm token request: unexpected status %d", resp.StatusCode)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("decode fcm token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("fcm token response has no access_token")
+	}
+
+	p.accessToken = token.AccessToken
+	p.expiresAt = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	return p.accessToken, nil
+}
+
+func (p *FCMProvider) resetToken() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.accessToken = ""
+}
@@ -0,0 +1,162 @@
+package push_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/outbound/channel/push"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fcmServer serves both the OAuth token endpoint and the FCM send endpoint.
+type fcmServer struct {
+	*httptest.Server
+	tokenRequests atomic.Int32
+	lastMessage   atomic.Value
+	status        int
+	body          string
+}
+
+func newFCMServer(t *testing.T, status int, body string) *fcmServer {
+	s := &fcmServer{status: status, body: body}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		s.tokenRequests.Add(1)
+		if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || r.FormValue("assertion") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = io.WriteString(w, `{"access_token":"access-123","expires_in":3600,"token_type":"Bearer"}`)
+	})
+	mux.HandleFunc("/v1/projects/pinstack-test/messages:send", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		raw, _ := io.ReadAll(r.Body)
+		s.lastMessage.Store(raw)
+		w.WriteHeader(s.status)
+		_, _ = io.WriteString(w, s.body)
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func writeServiceAccount(t *testing.T, tokenURI string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	account, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "pinstack-test",
+		"client_email": "push@pinstack-test.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":    tokenURI,
+	})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "service-account.json")
+	require.NoError(t, os.WriteFile(path, account, 0o600))
+	return path
+}
+
+func TestFCMProvider_Send(t *testing.T) {
+	device := &model.DeviceToken{Token: "fcm-token", Platform: model.DevicePlatformAndroid}
+	message := &model.PushMessage{Title: "New follower", Body: "User #42 started following you", DeepLink: "pinstack://app/users/42"}
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantErr     error
+		wantAnyErr  bool
+		wantMessage bool
+	}{
+		{name: "accepted", status: http.StatusOK, body: `{"name":"projects/pinstack-test/messages/1"}`, wantMessage: true},
+		{
+			name:    "unregistered token",
+			status:  http.StatusNotFound,
+			body:    `{"error":{"code":404,"status":"NOT_FOUND","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`,
+			wantErr: model.ErrInvalidDeviceToken,
+		},
+		{
+			name:    "bad request is permanent",
+			status:  http.StatusBadRequest,
+			body:    `{"error":{"code":400,"status":"INVALID_ARGUMENT","message":"bad payload"}}`,
+			wantErr: model.ErrPermanentDeliveryFailure,
+		},
+		{name: "outage is transient", status: http.StatusServiceUnavailable, body: `{}`, wantAnyErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFCMServer(t, tt.status, tt.body)
+			provider, err := push.NewFCMProvider(config.FCMConfig{
+				CredentialsFile: writeServiceAccount(t, server.URL+"/token"),
+				Endpoint:        server.URL,
+			}, 5*time.Second)
+			require.NoError(t, err)
+
+			err = provider.Send(context.Background(), device, message)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantAnyErr:
+				require.Error(t, err)
+				assert.False(t, errors.Is(err, model.ErrPermanentDeliveryFailure))
+				assert.False(t, errors.Is(err, model.ErrInvalidDeviceToken))
+			default:
+				require.NoError(t, err)
+			}
+
+			if tt.wantMessage {
+				var sent struct {
+					Message struct {
+						Token        string            `json:"token"`
+						Notification map[string]string `json:"notification"`
+						Data         map[string]string `json:"data"`
+					} `json:"message"`
+				}
+				require.NoError(t, json.Unmarshal(server.lastMessage.Load().([]byte), &sent))
+				assert.Equal(t, "fcm-token", sent.Message.Token)
+				assert.Equal(t, "New follower", sent.Message.Notification["title"])
+				assert.Equal(t, "pinstack://app/users/42", sent.Message.Data["deep_link"])
+			}
+		})
+	}
+}
+
+func TestFCMProvider_CachesAccessToken(t *testing.T) {
+	server := newFCMServer(t, http.StatusOK, `{}`)
+	provider, err := push.NewFCMProvider(config.FCMConfig{
+		CredentialsFile: writeServiceAccount(t, server.URL+"/token"),
+		Endpoint:        server.URL,
+	}, 5*time.Second)
+	require.NoError(t, err)
+
+	device := &model.DeviceToken{Token: "fcm-token"}
+	for range 3 {
+		require.NoError(t, provider.Send(context.Background(), device, &model.PushMessage{Title: "t", Body: "b"}))
+	}
+	assert.Equal(t, int32(1), server.tokenRequests.Load())
+}
@@ -0,0 +1,44 @@
+package push
+
+import (
+	"fmt"
+	"net/http"
+	model "pinstack-notification-service/internal/domain/models"
+	"time"
+)
+
+// newHTTP2Client returns a client that negotiates HTTP/2, which APNs
+// requires and FCM prefers.
+func newHTTP2Client(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConnsPerHost: 16,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// classifyStatus turns an unsuccessful provider response into an error:
+// throttling and server errors are retried, other rejections are not.
+func classifyStatus(provider string, status int, reason string) error {
+	if status == http.StatusTooManyRequests || status >= 500 {
+		return fmt.Errorf("%s: status %d %s", provider, status, reason)
+	}
+	return fmt.Errorf("%s: status %d %s: %w", provider, status, reason, model.ErrPermanentDeliveryFailure)
+}
+
+// messageData is the custom data sent along with the alert; the deep link
+// travels as deep_link.
+func messageData(message *model.PushMessage) map[string]string {
+	data := make(map[string]string, len(message.Data)+1)
+	for k, v := range message.Data {
+		data[k] = v
+	}
+	if message.DeepLink != "" {
+		data["deep_link"] = message.DeepLink
+	}
+	return data
+}
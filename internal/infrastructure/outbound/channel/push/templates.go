@@ -0,0 +1,133 @@
+package push
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	model "pinstack-notification-service/internal/domain/models"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// defaultTemplateName is used for notification types without their own templates.
+const defaultTemplateName = "default"
+
+// Templates holds the title, body and deep link templates for each
+// notification type, named <type>.title.tmpl, <type>.body.tmpl and
+// <type>.link.tmpl. Like email templates, a directory given to
+// LoadTemplates overrides the built-in ones file by file.
+type Templates struct {
+	parts map[string]map[string]*template.Template
+}
+
+// TemplateData is what push templates are executed with. Payload is the
+// notification payload decoded as a JSON object.
+type TemplateData struct {
+	Recipient    *model.User
+	Device       *model.DeviceToken
+	Notification *model.Notification
+	Payload      map[string]any
+	DeepLinkBase string
+}
+
+var templateParts = []string{"title", "body", "link"}
+
+func LoadTemplates(dir string) (*Templates, error) {
+	t := &Templates{parts: make(map[string]map[string]*template.Template, len(templateParts))}
+	for _, part := range templateParts {
+		t.parts[part] = make(map[string]*template.Template)
+	}
+
+	sources := []fs.FS{defaultTemplates}
+	if dir != "" {
+		sources = append(sources, os.DirFS(dir))
+	}
+
+	for i, source := range sources {
+		pattern := "*.tmpl"
+		if i == 0 {
+			pattern = "templates/*.tmpl"
+		}
+		files, err := fs.Glob(source, pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if err := t.add(source, file); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, part := range templateParts {
+		if t.parts[part][defaultTemplateName] == nil {
+			return nil, errors.New("default push templates are missing")
+		}
+	}
+	return t, nil
+}
+
+func (t *Templates) add(source fs.FS, file string) error {
+	content, err := fs.ReadFile(source, file)
+	if err != nil {
+		return err
+	}
+
+	base := file[strings.LastIndex(file, "/")+1:]
+	name, part, ok := strings.Cut(strings.TrimSuffix(base, ".tmpl"), ".")
+	if !ok {
+		return fmt.Errorf("push template %s: expected <type>.<title|body|link>.tmpl", file)
+	}
+	templates, ok := t.parts[part]
+	if !ok {
+		return fmt.Errorf("push template %s: unknown part %q", file, part)
+	}
+
+	tmpl, err := template.New(base).Option("missingkey=zero").Parse(string(content))
+	if err != nil {
+		return fmt.Errorf("push template %s: %w", file, err)
+	}
+	templates[name] = tmpl
+	return nil
+}
+
+// Render builds the push message for the notification type, falling back
+// to the default templates for any part the type does not define.
+func (t *Templates) Render(data TemplateData) (*model.PushMessage, error) {
+	name := string(data.Notification.Type)
+	if data.Payload == nil && len(data.Notification.Payload) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data.Notification.Payload))
+		decoder.UseNumber()
+		_ = decoder.Decode(&data.Payload)
+	}
+
+	rendered := make(map[string]string, len(templateParts))
+	for _, part := range templateParts {
+		tmpl, ok := t.parts[part][name]
+		if !ok {
+			tmpl = t.parts[part][defaultTemplateName]
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("render push %s: %w", part, err)
+		}
+		rendered[part] = strings.TrimSpace(buf.String())
+	}
+
+	return &model.PushMessage{
+		Title:    rendered["title"],
+		Body:     rendered["body"],
+		DeepLink: rendered["link"],
+		Data: map[string]string{
+			"notification_id": fmt.Sprint(data.Notification.ID),
+			"type":            string(data.Notification.Type),
+		},
+	}, nil
+}
@@ -0,0 +1,66 @@
+package relation_client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultCallTimeout = 5 * time.Second
+
+// RelationClient calls relation-service. A user that does not exist is
+// ErrUserNotFound; other failures are ErrExternalServiceError wrapping the
+// gRPC status.
+type RelationClient struct {
+	client  pb.RelationServiceClient
+	log     ports.Logger
+	timeout time.Duration
+}
+
+func NewRelationClient(conn *grpc.ClientConn, log ports.Logger, timeout time.Duration) *RelationClient {
+	if timeout <= 0 {
+		timeout = DefaultCallTimeout
+	}
+	return &RelationClient{
+		client:  pb.NewRelationServiceClient(conn),
+		log:     log,
+		timeout: timeout,
+	}
+}
+
+func (c *RelationClient) GetFollowers(ctx context.Context, userID int64, limit, page int) ([]int64, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	c.log.DebugContext(ctx, "Getting followers",
+		slog.Int64("user_id", userID),
+		slog.Int("limit", limit),
+		slog.Int("page", page))
+	resp, err := c.client.GetFollowers(ctx, &pb.GetFollowersRequest{
+		FolloweeId: userID,
+		Limit:      int32(limit),
+		Page:       int32(page),
+	})
+	if err != nil {
+		c.log.ErrorContext(ctx, "Failed to get followers", slog.Int64("user_id", userID), slog.String("error", err.Error()))
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return nil, 0, custom_errors.ErrUserNotFound
+		}
+		return nil, 0, fmt.Errorf("%w: %w", custom_errors.ErrExternalServiceError, err)
+	}
+
+	followerIDs := make([]int64, 0, len(resp.GetFollowers()))
+	for _, follower := range resp.GetFollowers() {
+		followerIDs = append(followerIDs, follower.GetFollowerId())
+	}
+	return followerIDs, int(resp.GetTotal()), nil
+}
@@ -0,0 +1,132 @@
+package user_client
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling user-service while the breaker
+// is open.
+var ErrCircuitOpen = errors.New("user service circuit breaker is open")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerHalfOpen
+	breakerOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerHalfOpen:
+		return "half_open"
+	case breakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// breakerOutcome is what a call allowed by the breaker ended with. Neutral
+// outcomes (the caller gave up, the user does not exist) say nothing about
+// the health of user-service.
+type breakerOutcome int
+
+const (
+	outcomeSuccess breakerOutcome = iota
+	outcomeFailure
+	outcomeNeutral
+)
+
+// breaker opens after failureThreshold consecutive failures and rejects
+// calls for openFor. Then a single probe call is let through: its success
+// closes the breaker, its failure opens it again.
+type breaker struct {
+	mu               sync.Mutex
+	state            breakerState
+	failures         int
+	openedAt         time.Time
+	probing          bool
+	failureThreshold int
+	openFor          time.Duration
+	now              func() time.Time
+	onChange         func(breakerState)
+}
+
+func newBreaker(failureThreshold int, openFor time.Duration, onChange func(breakerState)) *breaker {
+	return &breaker{
+		failureThreshold: failureThreshold,
+		openFor:          openFor,
+		now:              time.Now,
+		onChange:         onChange,
+	}
+}
+
+// allow reports whether a call may go through. Every allowed call must be
+// followed by record.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.openFor {
+			return false
+		}
+		b.setState(breakerHalfOpen)
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *breaker) record(outcome breakerOutcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.probing = false
+		switch outcome {
+		case outcomeSuccess:
+			b.failures = 0
+			b.setState(breakerClosed)
+		case outcomeFailure:
+			b.open()
+		}
+		return
+	}
+
+	switch outcome {
+	case outcomeSuccess:
+		b.failures = 0
+	case outcomeFailure:
+		b.failures++
+		if b.state == breakerClosed && b.failures >= b.failureThreshold {
+			b.open()
+		}
+	}
+}
+
+func (b *breaker) open() {
+	b.openedAt = b.now()
+	b.setState(breakerOpen)
+}
+
+func (b *breaker) setState(state breakerState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(state)
+	}
+}
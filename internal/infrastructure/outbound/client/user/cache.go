@@ -0,0 +1,76 @@
+package user_client
+
+import (
+	"container/list"
+	model "pinstack-notification-service/internal/domain/models"
+	"sync"
+	"time"
+)
+
+// userCache keeps users by id until their entry expires, dropping the least
+// recently used entry when it is full. A nil user is a cached "not found".
+type userCache struct {
+	mu      sync.Mutex
+	entries map[int64]*list.Element
+	order   *list.List // front is the most recently used
+	size    int
+}
+
+type cacheEntry struct {
+	id        int64
+	user      *model.User
+	expiresAt time.Time
+}
+
+func newUserCache(size int) *userCache {
+	return &userCache{entries: make(map[int64]*list.Element), order: list.New(), size: size}
+}
+
+func (c *userCache) get(id int64, now time.Time) (user *model.User, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !now.Before(entry.expiresAt) {
+		c.removeElement(elem)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.user, true
+}
+
+func (c *userCache) put(id int64, user *model.User, now time.Time, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[id]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.user = user
+		entry.expiresAt = now.Add(ttl)
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.size {
+		c.removeElement(c.order.Back())
+	}
+	c.entries[id] = c.order.PushFront(&cacheEntry{id: id, user: user, expiresAt: now.Add(ttl)})
+}
+
+func (c *userCache) remove(id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[id]; ok {
+		c.removeElement(elem)
+	}
+}
+
+func (c *userCache) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).id)
+}
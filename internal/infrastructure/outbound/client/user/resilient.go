@@ -0,0 +1,217 @@
+package user_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultCallTimeout      = 2 * time.Second
+	DefaultMaxRetries       = 2
+	DefaultRetryBackoff     = 100 * time.Millisecond
+	DefaultCacheTTL         = 5 * time.Minute
+	DefaultNegativeCacheTTL = time.Minute
+	DefaultCacheSize        = 10000
+	DefaultBreakerFailures  = 5
+	DefaultBreakerOpenFor   = 30 * time.Second
+
+	breakerName = "user_service"
+)
+
+// ResilientConfig tunes ResilientClient. Each attempt gets CallTimeout;
+// Unavailable and DeadlineExceeded are retried up to MaxRetries times with
+// jittered exponential backoff from RetryBackoff. Users found by id are
+// cached for CacheTTL, ids that do not exist for NegativeCacheTTL.
+type ResilientConfig struct {
+	CallTimeout      time.Duration
+	MaxRetries       int
+	RetryBackoff     time.Duration
+	CacheTTL         time.Duration
+	NegativeCacheTTL time.Duration
+	CacheSize        int
+	BreakerFailures  int
+	BreakerOpenFor   time.Duration
+}
+
+// ResilientClient decorates a ports.Client with per-call deadlines, retries,
+// a circuit breaker and a cache for GetUser, the lookup made for every
+// saved notification. Lookups by username and email are not cached.
+type ResilientClient struct {
+	next    ports.Client
+	log     ports.Logger
+	metrics ports.MetricsProvider
+	config  ResilientConfig
+	cache   *userCache
+	breaker *breaker
+	now     func() time.Time
+}
+
+func NewResilientClient(next ports.Client, log ports.Logger, metrics ports.MetricsProvider, cfg ResilientConfig) *ResilientClient {
+	if cfg.CallTimeout <= 0 {
+		cfg.CallTimeout = DefaultCallTimeout
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = DefaultRetryBackoff
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	if cfg.NegativeCacheTTL <= 0 {
+		cfg.NegativeCacheTTL = DefaultNegativeCacheTTL
+	}
+	if cfg.CacheSize <= 0 {
+		cfg.CacheSize = DefaultCacheSize
+	}
+	if cfg.BreakerFailures <= 0 {
+		cfg.BreakerFailures = DefaultBreakerFailures
+	}
+	if cfg.BreakerOpenFor <= 0 {
+		cfg.BreakerOpenFor = DefaultBreakerOpenFor
+	}
+
+	c := &ResilientClient{
+		next:    next,
+		log:     log,
+		metrics: metrics,
+		config:  cfg,
+		cache:   newUserCache(cfg.CacheSize),
+		now:     time.Now,
+	}
+	c.breaker = newBreaker(cfg.BreakerFailures, cfg.BreakerOpenFor, func(state breakerState) {
+		c.log.Warn("User service circuit breaker changed state", slog.String("state", state.String()))
+		c.metrics.SetCircuitBreakerState(breakerName, state.String())
+	})
+	metrics.SetCircuitBreakerState(breakerName, breakerClosed.String())
+	return c
+}
+
+func (c *ResilientClient) GetUser(ctx context.Context, id int64) (*model.User, error) {
+	if user, ok := c.cache.get(id, c.now()); ok {
+		c.metrics.IncrementCacheLookups(breakerName, "hit")
+		if user == nil {
+			return nil, custom_errors.ErrUserNotFound
+		}
+		return copyUser(user), nil
+	}
+	c.metrics.IncrementCacheLookups(breakerName, "miss")
+
+	user, err := c.call(ctx, "get_user", func(ctx context.Context) (*model.User, error) {
+		return c.next.GetUser(ctx, id)
+	})
+	switch {
+	case err == nil:
+		c.cache.put(id, copyUser(user), c.now(), c.config.CacheTTL)
+	case errors.Is(err, custom_errors.ErrUserNotFound):
+		c.cache.put(id, nil, c.now(), c.config.NegativeCacheTTL)
+	}
+	return user, err
+}
+
+// Forget drops the cached lookup of the user, so the next GetUser asks
+// user-service again.
+func (c *ResilientClient) Forget(id int64) {
+	c.cache.remove(id)
+}
+
+func (c *ResilientClient) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	return c.call(ctx, "get_user_by_username", func(ctx context.Context) (*model.User, error) {
+		return c.next.GetUserByUsername(ctx, username)
+	})
+}
+
+func (c *ResilientClient) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	return c.call(ctx, "get_user_by_email", func(ctx context.Context) (*model.User, error) {
+		return c.next.GetUserByEmail(ctx, email)
+	})
+}
+
+func (c *ResilientClient) call(ctx context.Context, method string, fn func(context.Context) (*model.User, error)) (*model.User, error) {
+	var lastErr error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, c.backoff(attempt)); err != nil {
+				return nil, lastErr
+			}
+		}
+
+		if !c.breaker.allow() {
+			return nil, fmt.Errorf("%w: %w", custom_errors.ErrExternalServiceError, ErrCircuitOpen)
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, c.config.CallTimeout)
+		user, err := fn(callCtx)
+		cancel()
+
+		c.breaker.record(c.outcome(ctx, err))
+		if err == nil || !retryable(err) {
+			return user, err
+		}
+
+		lastErr = err
+		c.log.WarnContext(ctx, "User service call failed",
+			slog.String("method", method),
+			slog.Int("attempt", attempt+1),
+			slog.String("error", err.Error()),
+		)
+	}
+	return nil, lastErr
+}
+
+// outcome counts errors against user-service unless the user simply does
+// not exist or the caller's own context ended.
+func (c *ResilientClient) outcome(ctx context.Context, err error) breakerOutcome {
+	switch {
+	case err == nil:
+		return outcomeSuccess
+	case errors.Is(err, custom_errors.ErrUserNotFound), ctx.Err() != nil:
+		return outcomeNeutral
+	default:
+		return outcomeFailure
+	}
+}
+
+// backoff doubles RetryBackoff per attempt and randomizes the upper half,
+// so replicas retrying after the same blip spread out.
+func (c *ResilientClient) backoff(attempt int) time.Duration {
+	wait := c.config.RetryBackoff << (attempt - 1)
+	return wait/2 + rand.N(wait/2+1)
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func copyUser(user *model.User) *model.User {
+	u := *user
+	return &u
+}
@@ -0,0 +1,195 @@
+package user_client_test
+
+import (
+	"context"
+	"fmt"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	user_client "pinstack-notification-service/internal/infrastructure/outbound/client/user"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-notification-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serviceError is what UserClient returns for a failed call.
+func serviceError(code codes.Code) error {
+	return fmt.Errorf("%w: %w", custom_errors.ErrExternalServiceError, status.Error(code, code.String()))
+}
+
+func newResilientClient(next *mocks.Client, cfg user_client.ResilientConfig) *user_client.ResilientClient {
+	if cfg.RetryBackoff == 0 {
+		cfg.RetryBackoff = time.Millisecond
+	}
+	return user_client.NewResilientClient(next, logger.New("dev"), prometheus.NewPrometheusMetricsProvider(), cfg)
+}
+
+func TestResilientClient_GetUser(t *testing.T) {
+	user := &model.User{ID: 1, Username: "jane"}
+
+	tests := []struct {
+		name      string
+		config    user_client.ResilientConfig
+		mockSetup func(*mocks.Client)
+		calls     int
+		wantUser  *model.User
+		wantErr   error
+	}{
+		{
+			name: "found user is cached",
+			mockSetup: func(c *mocks.Client) {
+				c.On("GetUser", mock.Anything, int64(1)).Return(user, nil).Once()
+			},
+			calls:    3,
+			wantUser: user,
+		},
+		{
+			name: "missing user is cached",
+			mockSetup: func(c *mocks.Client) {
+				c.On("GetUser", mock.Anything, int64(1)).Return(nil, custom_errors.ErrUserNotFound).Once()
+			},
+			calls:   3,
+			wantErr: custom_errors.ErrUserNotFound,
+		},
+		{
+			name:   "unavailable is retried",
+			config: user_client.ResilientConfig{MaxRetries: 2},
+			mockSetup: func(c *mocks.Client) {
+				c.On("GetUser", mock.Anything, int64(1)).Return(nil, serviceError(codes.Unavailable)).Twice()
+				c.On("GetUser", mock.Anything, int64(1)).Return(user, nil).Once()
+			},
+			calls:    1,
+			wantUser: user,
+		},
+		{
+			name:   "retries give up with the last error",
+			config: user_client.ResilientConfig{MaxRetries: 1},
+			mockSetup: func(c *mocks.Client) {
+				c.On("GetUser", mock.Anything, int64(1)).Return(nil, serviceError(codes.DeadlineExceeded)).Twice()
+			},
+			calls:   1,
+			wantErr: custom_errors.ErrExternalServiceError,
+		},
+		{
+			name:   "other codes are not retried",
+			config: user_client.ResilientConfig{MaxRetries: 2},
+			mockSetup: func(c *mocks.Client) {
+				c.On("GetUser", mock.Anything, int64(1)).Return(nil, serviceError(codes.Internal)).Once()
+			},
+			calls:   1,
+			wantErr: custom_errors.ErrExternalServiceError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := mocks.NewClient(t)
+			tt.mockSetup(next)
+			client := newResilientClient(next, tt.config)
+
+			for i := 0; i < tt.calls; i++ {
+				got, err := client.GetUser(context.Background(), 1)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+					assert.Nil(t, got)
+				} else {
+					require.NoError(t, err)
+					assert.Equal(t, tt.wantUser, got)
+				}
+			}
+		})
+	}
+}
+
+func TestResilientClient_Cache(t *testing.T) {
+	user := func(id int64) *model.User { return &model.User{ID: id} }
+
+	t.Run("least recently used user is evicted when full", func(t *testing.T) {
+		next := mocks.NewClient(t)
+		next.On("GetUser", mock.Anything, int64(1)).Return(user(1), nil).Once()
+		next.On("GetUser", mock.Anything, int64(2)).Return(user(2), nil).Twice()
+		next.On("GetUser", mock.Anything, int64(3)).Return(user(3), nil).Once()
+		client := newResilientClient(next, user_client.ResilientConfig{CacheSize: 2})
+
+		for _, id := range []int64{1, 2, 1, 3, 1, 2} {
+			got, err := client.GetUser(context.Background(), id)
+			require.NoError(t, err)
+			assert.Equal(t, id, got.ID)
+		}
+	})
+
+	t.Run("forgotten user is looked up again", func(t *testing.T) {
+		next := mocks.NewClient(t)
+		next.On("GetUser", mock.Anything, int64(1)).Return(user(1), nil).Once()
+		next.On("GetUser", mock.Anything, int64(1)).Return(nil, custom_errors.ErrUserNotFound).Once()
+		client := newResilientClient(next, user_client.ResilientConfig{})
+
+		_, err := client.GetUser(context.Background(), 1)
+		require.NoError(t, err)
+		client.Forget(1)
+		_, err = client.GetUser(context.Background(), 1)
+		assert.ErrorIs(t, err, custom_errors.ErrUserNotFound)
+	})
+}
+
+func TestResilientClient_PerCallDeadline(t *testing.T) {
+	next := mocks.NewClient(t)
+	next.On("GetUser", mock.Anything, int64(1)).Return(&model.User{ID: 1, Username: "jane"}, nil).Once()
+
+	client := newResilientClient(next, user_client.ResilientConfig{CallTimeout: 50 * time.Millisecond})
+	_, err := client.GetUser(context.Background(), 1)
+	require.NoError(t, err)
+
+	deadline, ok := next.Calls[0].Arguments.Get(0).(context.Context).Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), deadline, 50*time.Millisecond)
+}
+
+func TestResilientClient_CircuitBreaker(t *testing.T) {
+	next := mocks.NewClient(t)
+	client := newResilientClient(next, user_client.ResilientConfig{
+		BreakerFailures: 2,
+		BreakerOpenFor:  50 * time.Millisecond,
+	})
+
+	next.On("GetUserByUsername", mock.Anything, "jane").Return(nil, serviceError(codes.Internal)).Twice()
+	for i := 0; i < 2; i++ {
+		_, err := client.GetUserByUsername(context.Background(), "jane")
+		require.ErrorIs(t, err, custom_errors.ErrExternalServiceError)
+	}
+
+	_, err := client.GetUserByUsername(context.Background(), "jane")
+	assert.ErrorIs(t, err, user_client.ErrCircuitOpen)
+	assert.ErrorIs(t, err, custom_errors.ErrExternalServiceError)
+	next.AssertNumberOfCalls(t, "GetUserByUsername", 2)
+
+	time.Sleep(60 * time.Millisecond)
+
+	user := &model.User{ID: 1, Username: "jane"}
+	next.On("GetUserByUsername", mock.Anything, "jane").Return(user, nil)
+	got, err := client.GetUserByUsername(context.Background(), "jane")
+	require.NoError(t, err)
+	assert.Equal(t, user, got)
+
+	_, err = client.GetUserByUsername(context.Background(), "jane")
+	require.NoError(t, err)
+}
+
+func TestResilientClient_NotFoundDoesNotOpenBreaker(t *testing.T) {
+	next := mocks.NewClient(t)
+	client := newResilientClient(next, user_client.ResilientConfig{BreakerFailures: 1})
+
+	next.On("GetUserByEmail", mock.Anything, "gone@example.com").Return(nil, custom_errors.ErrUserNotFound).Twice()
+	for i := 0; i < 2; i++ {
+		_, err := client.GetUserByEmail(context.Background(), "gone@example.com")
+		assert.ErrorIs(t, err, custom_errors.ErrUserNotFound)
+	}
+}
@@ -2,9 +2,11 @@ package user_client
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	model "pinstack-notification-service/internal/domain/models"
 	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/logger"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
 
@@ -14,6 +16,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// UserClient calls user-service directly. Transport failures are
+// ErrExternalServiceError wrapping the gRPC status, so ResilientClient can
+// tell transient codes from permanent ones.
 type UserClient struct {
 	client pb.UserServiceClient
 	log    ports.Logger
@@ -27,49 +32,49 @@ func NewUserClient(conn *grpc.ClientConn, log ports.Logger) *UserClient {
 }
 
 func (u *UserClient) GetUser(ctx context.Context, id int64) (*model.User, error) {
-	u.log.Info("Getting user by ID", slog.Int64("id", id))
+	u.log.InfoContext(ctx, "Getting user by ID", slog.Int64("id", id))
 	resp, err := u.client.GetUser(ctx, &pb.GetUserRequest{Id: id})
 	if err != nil {
-		u.log.Error("Error getting user", slog.String("error", err.Error()), slog.Int64("id", id))
+		u.log.ErrorContext(ctx, "Error getting user", slog.String("error", err.Error()), slog.Int64("id", id))
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
 				return nil, custom_errors.ErrUserNotFound
 			}
 		}
-		return nil, custom_errors.ErrExternalServiceError
+		return nil, fmt.Errorf("%w: %w", custom_errors.ErrExternalServiceError, err)
 	}
-	u.log.Info("Successfully got user", slog.Int64("id", id))
+	u.log.InfoContext(ctx, "Successfully got user", slog.Int64("id", id))
 	return model.UserFromProto(resp), nil
 }
 
 func (u *UserClient) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
-	u.log.Info("Getting user by username", slog.String("username", username))
+	u.log.InfoContext(ctx, "Getting user by username", slog.String("username", username))
 	resp, err := u.client.GetUserByUsername(ctx, &pb.GetUserByUsernameRequest{Username: username})
 	if err != nil {
-		u.log.Error("Failed to get user by username", slog.String("username", username), slog.String("error", err.Error()))
+		u.log.ErrorContext(ctx, "Failed to get user by username", slog.String("username", username), slog.String("error", err.Error()))
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
 				return nil, custom_errors.ErrUserNotFound
 			}
 		}
-		return nil, custom_errors.ErrExternalServiceError
+		return nil, fmt.Errorf("%w: %w", custom_errors.ErrExternalServiceError, err)
 	}
-	u.log.Info("Successfully got user by username", slog.String("username", username))
+	u.log.InfoContext(ctx, "Successfully got user by username", slog.String("username", username))
 	return model.UserFromProto(resp), nil
 }
 
 func (u *UserClient) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
-	u.log.Info("Getting user by email", slog.String("email", email))
+	u.log.InfoContext(ctx, "Getting user by email", slog.String("email_sha256", logger.Fingerprint([]byte(email))))
 	resp, err := u.client.GetUserByEmail(ctx, &pb.GetUserByEmailRequest{Email: email})
 	if err != nil {
-		u.log.Error("Failed to get user by email", slog.String("email", email), slog.String("error", err.Error()))
+		u.log.ErrorContext(ctx, "Failed to get user by email", slog.String("email_sha256", logger.Fingerprint([]byte(email))), slog.String("error", err.Error()))
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.NotFound {
 				return nil, custom_errors.ErrUserNotFound
 			}
 		}
-		return nil, custom_errors.ErrExternalServiceError
+		return nil, fmt.Errorf("%w: %w", custom_errors.ErrExternalServiceError, err)
 	}
-	u.log.Info("Successfully got user by email", slog.String("email", email))
+	u.log.InfoContext(ctx, "Successfully got user by email", slog.String("email_sha256", logger.Fingerprint([]byte(email))))
 	return model.UserFromProto(resp), nil
 }
@@ -2,37 +2,130 @@ package producer
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
 	ports "pinstack-notification-service/internal/domain/ports/output"
 	"pinstack-notification-service/internal/infrastructure/config"
+	"pinstack-notification-service/internal/infrastructure/correlation"
+	"pinstack-notification-service/internal/infrastructure/tracing"
 	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 )
 
+const eventTypeNotificationDelivered = "notification_delivered"
+
 type NotificationProducer struct {
-	config  config.KafkaConfig
-	log     ports.Logger
-	metrics ports.MetricsProvider
+	config   config.KafkaConfig
+	log      ports.Logger
+	metrics  ports.MetricsProvider
+	producer *kafka.Producer
 }
 
-func NewNotificationProducer(cfg config.KafkaConfig, log ports.Logger, metrics ports.MetricsProvider) *NotificationProducer {
-	return &NotificationProducer{
-		config:  cfg,
-		log:     log,
-		metrics: metrics,
+func NewNotificationProducer(cfg config.KafkaConfig, log ports.Logger, metrics ports.MetricsProvider) (*NotificationProducer, error) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":            cfg.Brokers,
+		"acks":                         cfg.Acks,
+		"retries":                      cfg.Retries,
+		"retry.backoff.ms":             cfg.RetryBackoffMs,
+		"delivery.timeout.ms":          cfg.DeliveryTimeoutMs,
+		"queue.buffering.max.messages": cfg.QueueBufferingMaxMsgs,
+		"queue.buffering.max.ms":       cfg.QueueBufferingMaxMs,
+		"compression.type":             cfg.CompressionType,
+		"batch.size":                   cfg.BatchSize,
+		"linger.ms":                    cfg.LingerMs,
+	})
+	if err != nil {
+		log.Error("Failed to create Kafka producer", slog.String("error", err.Error()))
+		return nil, err
 	}
+
+	return &NotificationProducer{
+		config:   cfg,
+		log:      log,
+		metrics:  metrics,
+		producer: p,
+	}, nil
 }
 
-func (p *NotificationProducer) Send(ctx context.Context, topic string, message []byte) error {
+func (p *NotificationProducer) Send(ctx context.Context, topic string, key, message []byte, headers ...kafka.Header) (err error) {
 	start := time.Now()
-	var success bool
 	defer func() {
-		p.metrics.IncrementKafkaMessages(topic, "produce", success)
+		p.metrics.IncrementKafkaMessages(topic, "produce", err == nil)
 		p.metrics.RecordKafkaMessageDuration(topic, "produce", time.Since(start))
 	}()
 
-	p.log.Debug("Sending message to Kafka topic", slog.String("topic", topic))
-	// TODO: Реализовать логику отправки в Kafka
+	if id := correlation.FromContext(ctx); id != "" {
+		headers = append(headers, kafka.Header{Key: correlation.HeaderKey, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, topic, &headers)
+	defer func() { tracing.End(span, err) }()
+
+	p.log.DebugContext(ctx, "Sending message to Kafka topic", slog.String("topic", topic))
 
-	success = true
-	return nil
+	deliveryChan := make(chan kafka.Event, 1)
+	err = p.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            key,
+		Value:          message,
+		Headers:        headers,
+	}, deliveryChan)
+	if err != nil {
+		p.log.ErrorContext(ctx, "Failed to enqueue Kafka message", slog.String("topic", topic), slog.String("error", err.Error()))
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case e := <-deliveryChan:
+		msg, ok := e.(*kafka.Message)
+		if !ok {
+			return nil
+		}
+		if msg.TopicPartition.Error != nil {
+			p.log.ErrorContext(ctx, "Kafka message delivery failed",
+				slog.String("topic", topic),
+				slog.String("error", msg.TopicPartition.Error.Error()))
+			return msg.TopicPartition.Error
+		}
+		return nil
+	}
+}
+
+type notificationDeliveredEvent struct {
+	NotificationID int64           `json:"notification_id"`
+	UserID         int64           `json:"user_id"`
+	Type           string          `json:"type"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+}
+
+func (p *NotificationProducer) PublishDelivered(ctx context.Context, notification *model.Notification) error {
+	message, err := json.Marshal(notificationDeliveredEvent{
+		NotificationID: notification.ID,
+		UserID:         notification.UserID,
+		Type:           string(notification.Type),
+		CreatedAt:      notification.CreatedAt,
+		Payload:        notification.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Keyed by recipient so a user's notifications stay ordered within a partition.
+	key := []byte(fmt.Sprintf("%d", notification.UserID))
+	return p.Send(ctx, p.config.NotificationTopic, key, message,
+		kafka.Header{Key: "event_type", Value: []byte(eventTypeNotificationDelivered)})
+}
+
+func (p *NotificationProducer) Close() {
+	p.log.Info("Closing Kafka producer")
+	remaining := p.producer.Flush(p.config.DeliveryTimeoutMs)
+	if remaining > 0 {
+		p.log.Warn("Kafka producer closed with undelivered messages", slog.Int("remaining", remaining))
+	}
+	p.producer.Close()
 }
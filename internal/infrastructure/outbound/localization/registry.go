@@ -0,0 +1,205 @@
+// Package localization renders notification titles and bodies from
+// text/template files keyed by notification type and locale.
+package localization
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	model "pinstack-notification-service/internal/domain/models"
+	"strings"
+	"sync/atomic"
+	"text/template"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// defaultTemplateName is used for notification types without their own templates.
+const defaultTemplateName = "default"
+
+// ErrNoTemplate is returned when neither the requested locale nor the
+// default locale has a template for the notification.
+var ErrNoTemplate = errors.New("no notification template")
+
+// Registry holds a title and a body template per locale and notification
+// type. Files are laid out as <locale>/<type>.title.tmpl and
+// <locale>/<type>.body.tmpl; a directory given to NewRegistry overrides the
+// built-in templates file by file and can be reloaded while serving.
+type Registry struct {
+	dir           string
+	defaultLocale string
+	set           atomic.Pointer[templateSet]
+}
+
+// templateSet is one immutable load of all templates, swapped as a whole on reload.
+type templateSet struct {
+	titles map[string]*template.Template
+	bodies map[string]*template.Template
+}
+
+// TemplateData is what notification templates are executed with. Payload is
+// the notification payload decoded as a JSON object.
+type TemplateData struct {
+	Notification *model.Notification
+	Payload      map[string]any
+	Locale       string
+}
+
+func NewRegistry(dir, defaultLocale string) (*Registry, error) {
+	r := &Registry{dir: dir, defaultLocale: normalizeLocale(defaultLocale)}
+	if r.defaultLocale == "" {
+		return nil, errors.New("default locale is required")
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload parses all templates again and swaps them in. On error the
+// templates loaded before stay in use.
+func (r *Registry) Reload() error {
+	set := &templateSet{
+		titles: make(map[string]*template.Template),
+		bodies: make(map[string]*template.Template),
+	}
+
+	builtin, err := fs.Sub(defaultTemplates, "templates")
+	if err != nil {
+		return err
+	}
+	sources := []fs.FS{builtin}
+	if r.dir != "" {
+		sources = append(sources, os.DirFS(r.dir))
+	}
+
+	for _, source := range sources {
+		files, err := fs.Glob(source, "*/*.tmpl")
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if err := set.add(source, file); err != nil {
+				return err
+			}
+		}
+	}
+
+	key := templateKey(r.defaultLocale, defaultTemplateName)
+	if set.titles[key] == nil || set.bodies[key] == nil {
+		return fmt.Errorf("default templates are missing for locale %q", r.defaultLocale)
+	}
+
+	r.set.Store(set)
+	return nil
+}
+
+func (s *templateSet) add(source fs.FS, file string) error {
+	content, err := fs.ReadFile(source, file)
+	if err != nil {
+		return err
+	}
+
+	locale := normalizeLocale(path.Dir(file))
+	name, kind, ok := strings.Cut(strings.TrimSuffix(path.Base(file), ".tmpl"), ".")
+	if !ok {
+		return fmt.Errorf("notification template %s: expected <locale>/<type>.<title|body>.tmpl", file)
+	}
+
+	tmpl, err := template.New(file).Option("missingkey=zero").Parse(string(content))
+	if err != nil {
+		return fmt.Errorf("notification template %s: %w", file, err)
+	}
+
+	switch kind {
+	case "title":
+		s.titles[templateKey(locale, name)] = tmpl
+	case "body":
+		s.bodies[templateKey(locale, name)] = tmpl
+	default:
+		return fmt.Errorf("notification template %s: unknown kind %q", file, kind)
+	}
+	return nil
+}
+
+// Render executes the title and body templates for the notification in the
+// first locale of the fallback chain that has them: the requested locale,
+// its parent languages (pt-br, then pt) and the default locale. A type
+// without its own templates in any of them gets the default templates.
+func (r *Registry) Render(notification *model.Notification, locale string) (*model.RenderedText, error) {
+	set := r.set.Load()
+	chain := r.fallbackChain(locale)
+
+	for _, name := range []string{string(notification.Type), defaultTemplateName} {
+		for _, candidate := range chain {
+			key := templateKey(candidate, name)
+			title, body := set.titles[key], set.bodies[key]
+			if title == nil || body == nil {
+				continue
+			}
+			return execute(title, body, TemplateData{
+				Notification: notification,
+				Payload:      decodePayload(notification),
+				Locale:       candidate,
+			})
+		}
+	}
+	return nil, fmt.Errorf("%w for type %q", ErrNoTemplate, notification.Type)
+}
+
+func execute(title, body *template.Template, data TemplateData) (*model.RenderedText, error) {
+	var titleBuf, bodyBuf bytes.Buffer
+	if err := title.Execute(&titleBuf, data); err != nil {
+		return nil, fmt.Errorf("render notification title: %w", err)
+	}
+	if err := body.Execute(&bodyBuf, data); err != nil {
+		return nil, fmt.Errorf("render notification body: %w", err)
+	}
+	return &model.RenderedText{
+		Locale: data.Locale,
+		Title:  strings.TrimSpace(titleBuf.String()),
+		Body:   strings.TrimSpace(bodyBuf.String()),
+	}, nil
+}
+
+func (r *Registry) fallbackChain(locale string) []string {
+	chain := make([]string, 0, 3)
+	for locale = normalizeLocale(locale); locale != ""; {
+		chain = append(chain, locale)
+		cut := strings.LastIndex(locale, "-")
+		if cut < 0 {
+			break
+		}
+		locale = locale[:cut]
+	}
+	return append(chain, r.defaultLocale)
+}
+
+// normalizeLocale lowercases a BCP 47 tag and accepts underscores, so
+// pt_BR and pt-BR find the same templates.
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+func templateKey(locale, name string) string {
+	return locale + "/" + name
+}
+
+// decodePayload decodes the payload as a JSON object. Numbers stay
+// json.Number so ids are not printed in float notation.
+func decodePayload(notification *model.Notification) map[string]any {
+	if len(notification.Payload) == 0 {
+		return nil
+	}
+	var payload map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(notification.Payload))
+	decoder.UseNumber()
+	_ = decoder.Decode(&payload)
+	return payload
+}
@@ -0,0 +1,139 @@
+package localization_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/outbound/localization"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func followNotification() *model.Notification {
+	payload, _ := json.Marshal(map[string]any{"follower_id": 42})
+	return &model.Notification{
+		ID:      1,
+		UserID:  7,
+		Type:    events.EventTypeFollowCreated,
+		Payload: payload,
+	}
+}
+
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	file := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+}
+
+func TestRegistry_Render(t *testing.T) {
+	registry, err := localization.NewRegistry("", "en")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		notification *model.Notification
+		locale       string
+		wantLocale   string
+		wantTitle    string
+		wantBody     string
+	}{
+		{
+			name:         "english",
+			notification: followNotification(),
+			locale:       "en",
+			wantLocale:   "en",
+			wantTitle:    "New follower",
+			wantBody:     "User #42 started following you.",
+		},
+		{
+			name:         "russian with region",
+			notification: followNotification(),
+			locale:       "ru_RU",
+			wantLocale:   "ru",
+			wantTitle:    "Новый подписчик",
+			wantBody:     "Пользователь #42 подписался на вас.",
+		},
+		{
+			name: "actor username when resolved",
+			notification: func() *model.Notification {
+				n := followNotification()
+				n.Actor = &model.Actor{ID: 42, Username: "jane"}
+				return n
+			}(),
+			locale:     "en",
+			wantLocale: "en",
+			wantTitle:  "New follower",
+			wantBody:   "jane started following you.",
+		},
+		{
+			name:         "unknown locale falls back to default",
+			notification: followNotification(),
+			locale:       "pt-BR",
+			wantLocale:   "en",
+			wantTitle:    "New follower",
+			wantBody:     "User #42 started following you.",
+		},
+		{
+			name:         "type without templates uses default templates",
+			notification: &model.Notification{ID: 2, UserID: 7, Type: events.EventTypeFollowDeleted},
+			locale:       "ru",
+			wantLocale:   "ru",
+			wantTitle:    "Новое уведомление",
+			wantBody:     "У вас новое уведомление.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := registry.Render(tt.notification, tt.locale)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLocale, text.Locale)
+			assert.Equal(t, tt.wantTitle, text.Title)
+			assert.Equal(t, tt.wantBody, text.Body)
+		})
+	}
+}
+
+func TestRegistry_Overrides(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "pt/follow_created.title.tmpl", "Novo seguidor")
+	writeTemplate(t, dir, "pt/follow_created.body.tmpl", "O usuário #{{.Payload.follower_id}} começou a seguir você.")
+
+	registry, err := localization.NewRegistry(dir, "en")
+	require.NoError(t, err)
+
+	text, err := registry.Render(followNotification(), "pt-BR")
+	require.NoError(t, err)
+	assert.Equal(t, "pt", text.Locale)
+	assert.Equal(t, "Novo seguidor", text.Title)
+
+	t.Run("reload picks up changes", func(t *testing.T) {
+		writeTemplate(t, dir, "en/follow_created.title.tmpl", "Someone followed you")
+		require.NoError(t, registry.Reload())
+
+		text, err := registry.Render(followNotification(), "en")
+		require.NoError(t, err)
+		assert.Equal(t, "Someone followed you", text.Title)
+		assert.Equal(t, "User #42 started following you.", text.Body)
+	})
+
+	t.Run("broken template keeps previous set", func(t *testing.T) {
+		writeTemplate(t, dir, "pt/follow_created.title.tmpl", "{{.Payload")
+		require.Error(t, registry.Reload())
+
+		text, err := registry.Render(followNotification(), "pt")
+		require.NoError(t, err)
+		assert.Equal(t, "Novo seguidor", text.Title)
+	})
+}
+
+func TestNewRegistry_MissingDefaultLocale(t *testing.T) {
+	_, err := localization.NewRegistry("", "de")
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package localization
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDelay batches the burst of events an editor or a config map
+// update produces into one reload.
+const reloadDelay = 250 * time.Millisecond
+
+// Watch reloads the templates whenever a file under the templates
+// directory changes, until ctx is done. A reload that fails to parse is
+// logged and the previous templates stay in use.
+func (r *Registry) Watch(ctx context.Context, log ports.Logger) error {
+	if r.dir == "" {
+		return errors.New("no templates directory to watch")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watchTree(watcher, r.dir); err != nil {
+		return err
+	}
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					_ = watcher.Add(event.Name)
+				}
+			}
+			reload = time.After(reloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Warn("Notification template watcher error", slog.String("error", err.Error()))
+		case <-reload:
+			reload = nil
+			if err := r.Reload(); err != nil {
+				log.Error("Failed to reload notification templates, keeping the previous ones",
+					slog.String("dir", r.dir),
+					slog.String("error", err.Error()),
+				)
+				continue
+			}
+			log.Info("Notification templates reloaded", slog.String("dir", r.dir))
+		}
+	}
+}
+
+// watchTree watches dir and its locale directories; fsnotify is not recursive.
+func watchTree(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	})
+}
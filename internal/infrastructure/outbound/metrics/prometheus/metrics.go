@@ -24,6 +24,14 @@ var (
 		[]string{"method", "status"},
 	)
 
+	grpcRateLimitDecisionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "notification_service_grpc_rate_limit_decisions_total",
+			Help: "Total number of rate limit decisions by result (allowed, limited, error)",
+		},
+		[]string{"method", "result"},
+	)
+
 	// Database metrics
 	databaseQueriesTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -51,6 +59,22 @@ var (
 		[]string{"operation", "status"},
 	)
 
+	suppressedNotificationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "notification_service_suppressed_notifications_total",
+			Help: "Total number of notifications dropped by spam protection",
+		},
+		[]string{"type", "reason"},
+	)
+
+	broadcastNotificationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "notification_service_broadcast_notifications_total",
+			Help: "Total number of notifications stored by broadcast fan-out",
+		},
+		[]string{"type"},
+	)
+
 	// Kafka metrics
 	kafkaMessagesTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -69,6 +93,41 @@ var (
 		[]string{"topic", "operation"},
 	)
 
+	// Delivery channel metrics
+	channelDeliveriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "notification_service_channel_deliveries_total",
+			Help: "Total number of channel delivery attempts by outcome",
+		},
+		[]string{"channel", "status"},
+	)
+
+	channelDeliveryDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "notification_service_channel_delivery_duration_seconds",
+			Help:    "Duration of channel send calls",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"channel"},
+	)
+
+	// Outbound client metrics
+	circuitBreakerState = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "notification_service_circuit_breaker_state",
+			Help: "Circuit breaker state (0 = closed, 1 = half-open, 2 = open)",
+		},
+		[]string{"name"},
+	)
+
+	cacheLookupsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "notification_service_cache_lookups_total",
+			Help: "Total number of cache lookups by result",
+		},
+		[]string{"cache", "result"},
+	)
+
 	// Connection metrics
 	activeConnections = promauto.NewGauge(
 		prometheus.GaugeOpts{
@@ -20,6 +20,10 @@ func (p *PrometheusMetricsProvider) RecordGRPCRequestDuration(method, status str
 	grpcRequestDuration.WithLabelValues(method, status).Observe(duration.Seconds())
 }
 
+func (p *PrometheusMetricsProvider) IncrementRateLimitDecisions(method, result string) {
+	grpcRateLimitDecisionsTotal.WithLabelValues(method, result).Inc()
+}
+
 func (p *PrometheusMetricsProvider) IncrementDatabaseQueries(queryType string, success bool) {
 	status := "failure"
 	if success {
@@ -40,6 +44,14 @@ func (p *PrometheusMetricsProvider) IncrementNotificationOperations(operation st
 	notificationOperationsTotal.WithLabelValues(operation, status).Inc()
 }
 
+func (p *PrometheusMetricsProvider) IncrementSuppressedNotifications(notificationType, reason string) {
+	suppressedNotificationsTotal.WithLabelValues(notificationType, reason).Inc()
+}
+
+func (p *PrometheusMetricsProvider) AddBroadcastNotifications(notificationType string, count int) {
+	broadcastNotificationsTotal.WithLabelValues(notificationType).Add(float64(count))
+}
+
 func (p *PrometheusMetricsProvider) IncrementKafkaMessages(topic, operation string, success bool) {
 	status := "failure"
 	if success {
@@ -56,6 +68,29 @@ func (p *PrometheusMetricsProvider) SetActiveConnections(count int) {
 	activeConnections.Set(float64(count))
 }
 
+func (p *PrometheusMetricsProvider) IncrementChannelDeliveries(channel, status string) {
+	channelDeliveriesTotal.WithLabelValues(channel, status).Inc()
+}
+
+func (p *PrometheusMetricsProvider) RecordChannelDeliveryDuration(channel string, duration time.Duration) {
+	channelDeliveryDuration.WithLabelValues(channel).Observe(duration.Seconds())
+}
+
+func (p *PrometheusMetricsProvider) SetCircuitBreakerState(name, state string) {
+	value := 0.0
+	switch state {
+	case "half_open":
+		value = 1
+	case "open":
+		value = 2
+	}
+	circuitBreakerState.WithLabelValues(name).Set(value)
+}
+
+func (p *PrometheusMetricsProvider) IncrementCacheLookups(cache, result string) {
+	cacheLookupsTotal.WithLabelValues(cache, result).Inc()
+}
+
 func (p *PrometheusMetricsProvider) SetServiceHealth(healthy bool) {
 	if healthy {
 		serviceHealth.Set(1)
@@ -0,0 +1,274 @@
+package notification_repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+const broadcastColumns = `id, type, payload, priority, user_ids, followers_of, status, cursor, total, processed, sent,
+		last_error, next_run_at, created_at, updated_at, completed_at`
+
+type BroadcastRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewBroadcastRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *BroadcastRepository {
+	return &BroadcastRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *BroadcastRepository) logQueryError(ctx context.Context, msg string, err error, attrs ...any) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		r.log.ErrorContext(ctx, msg, append([]any{
+			slog.String("pg_error_code", pgErr.Code),
+			slog.String("pg_error_message", pgErr.Message),
+			slog.String("pg_error_detail", pgErr.Detail),
+		}, attrs...)...)
+		return custom_errors.ErrDatabaseQuery
+	}
+	r.log.ErrorContext(ctx, msg, append([]any{slog.String("error", err.Error())}, attrs...)...)
+	return err
+}
+
+// scanBroadcast reads a row selected as broadcastColumns.
+func scanBroadcast(row pgx.Row, broadcast *model.Broadcast) error {
+	var typeStr, priorityStr, statusStr string
+	var followersOf *int64
+	err := row.Scan(
+		&broadcast.ID,
+		&typeStr,
+		&broadcast.Payload,
+		&priorityStr,
+		&broadcast.UserIDs,
+		&followersOf,
+		&statusStr,
+		&broadcast.Cursor,
+		&broadcast.Total,
+		&broadcast.Processed,
+		&broadcast.Sent,
+		&broadcast.LastError,
+		&broadcast.NextRunAt,
+		&broadcast.CreatedAt,
+		&broadcast.UpdatedAt,
+		&broadcast.CompletedAt,
+	)
+	broadcast.Type = events.EventType(typeStr)
+	broadcast.Priority = model.NotificationPriority(priorityStr)
+	broadcast.Status = model.BroadcastStatus(statusStr)
+	if followersOf != nil {
+		broadcast.FollowersOf = *followersOf
+	}
+	return err
+}
+
+func (r *BroadcastRepository) Create(ctx context.Context, broadcast *model.Broadcast) (id int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_broadcast", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_broadcast", time.Since(start))
+	}()
+
+	var followersOf *int64
+	if broadcast.FollowersOf > 0 {
+		followersOf = &broadcast.FollowersOf
+	}
+
+	query := `
+		INSERT INTO notification_broadcasts (type, payload, priority, user_ids, followers_of, total)
+		VALUES (@type, @payload, @priority, @user_ids, @followers_of, @total)
+		RETURNING ` + broadcastColumns
+
+	args := pgx.NamedArgs{
+		"type":         string(broadcast.Type),
+		"payload":      broadcast.Payload,
+		"priority":     string(broadcast.Priority),
+		"user_ids":     broadcast.UserIDs,
+		"followers_of": followersOf,
+		"total":        len(broadcast.UserIDs),
+	}
+
+	if err := scanBroadcast(r.db.QueryRow(ctx, query, args), broadcast); err != nil {
+		return 0, r.logQueryError(ctx, "Failed to create broadcast", err, slog.String("type", string(broadcast.Type)))
+	}
+
+	r.log.DebugContext(ctx, "Broadcast created",
+		slog.Int64("broadcast_id", broadcast.ID),
+		slog.String("type", string(broadcast.Type)),
+	)
+	return broadcast.ID, nil
+}
+
+func (r *BroadcastRepository) Get(ctx context.Context, id int64) (broadcast *model.Broadcast, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_broadcast", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_broadcast", time.Since(start))
+	}()
+
+	query := `SELECT ` + broadcastColumns + ` FROM notification_broadcasts WHERE id = @id`
+
+	broadcast = &model.Broadcast{}
+	if err := scanBroadcast(r.db.QueryRow(ctx, query, pgx.NamedArgs{"id": id}), broadcast); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrBroadcastNotFound
+		}
+		return nil, r.logQueryError(ctx, "Failed to get broadcast", err, slog.Int64("broadcast_id", id))
+	}
+	return broadcast, nil
+}
+
+// ClaimDue leases up to limit unfinished broadcasts by moving their next run
+// past the lease. Advance hands the broadcast back right away; a worker
+// that dies mid-chunk leaves it to be reclaimed once the lease runs out.
+func (r *BroadcastRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) (broadcasts []*model.Broadcast, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("claim_due_broadcasts", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("claim_due_broadcasts", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notification_broadcasts
+		SET status = 'running', next_run_at = @lease_until, updated_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM notification_broadcasts
+			WHERE status IN ('pending', 'running') AND next_run_at <= @now
+			ORDER BY next_run_at
+			LIMIT @limit
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + broadcastColumns
+
+	args := pgx.NamedArgs{
+		"now":         now,
+		"lease_until": now.Add(lease),
+		"limit":       limit,
+	}
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to claim due broadcasts", err)
+	}
+	defer rows.Close()
+
+	broadcasts = make([]*model.Broadcast, 0)
+	for rows.Next() {
+		var broadcast model.Broadcast
+		if err := scanBroadcast(rows, &broadcast); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan broadcast row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		broadcasts = append(broadcasts, &broadcast)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return broadcasts, nil
+}
+
+// Advance records a chunk whose notifications were sent and hands the
+// broadcast back for its next chunk. It only applies while the broadcast is
+// still at chunk.From: a worker whose lease ran out while another one redid
+// the chunk records nothing.
+func (r *BroadcastRepository) Advance(ctx context.Context, broadcastID int64, chunk *model.BroadcastChunk) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("advance_broadcast", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("advance_broadcast", time.Since(start))
+	}()
+
+	status := model.BroadcastStatusRunning
+	if chunk.Done {
+		status = model.BroadcastStatusCompleted
+	}
+
+	query := `
+		UPDATE notification_broadcasts
+		SET cursor = @cursor,
+			total = @total,
+			processed = processed + @processed,
+			sent = sent + @sent,
+			status = @status,
+			last_error = '',
+			next_run_at = NOW(),
+			updated_at = NOW(),
+			completed_at = CASE WHEN @status = 'completed' THEN NOW() END
+		WHERE id = @id AND cursor = @from AND status = 'running'
+	`
+
+	args := pgx.NamedArgs{
+		"id":        broadcastID,
+		"from":      chunk.From,
+		"cursor":    chunk.Cursor,
+		"total":     chunk.Total,
+		"processed": len(chunk.Notifications),
+		"sent":      chunk.Sent,
+		"status":    string(status),
+	}
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		return r.logQueryError(ctx, "Failed to record broadcast progress", err, slog.Int64("broadcast_id", broadcastID))
+	}
+	if result.RowsAffected() == 0 {
+		r.log.WarnContext(ctx, "Broadcast moved on while the chunk was sent, not recording it",
+			slog.Int64("broadcast_id", broadcastID),
+			slog.Int("cursor", chunk.From),
+		)
+		return nil
+	}
+
+	r.log.DebugContext(ctx, "Broadcast advanced",
+		slog.Int64("broadcast_id", broadcastID),
+		slog.Int("cursor", chunk.Cursor),
+		slog.Int("sent", chunk.Sent),
+		slog.Bool("done", chunk.Done),
+	)
+	return nil
+}
+
+// RecordFailure notes a failed chunk. With retryAt the broadcast is tried
+// again from the same cursor then; without it the broadcast is given up.
+func (r *BroadcastRepository) RecordFailure(ctx context.Context, broadcastID int64, lastError string, retryAt *time.Time) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("record_broadcast_failure", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("record_broadcast_failure", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notification_broadcasts
+		SET last_error = @last_error,
+			status = CASE WHEN @retry_at::timestamp IS NULL THEN 'failed' ELSE status END,
+			next_run_at = COALESCE(@retry_at, next_run_at),
+			completed_at = CASE WHEN @retry_at::timestamp IS NULL THEN NOW() END,
+			updated_at = NOW()
+		WHERE id = @id AND status IN ('pending', 'running')
+	`
+
+	args := pgx.NamedArgs{
+		"id":         broadcastID,
+		"last_error": lastError,
+		"retry_at":   retryAt,
+	}
+
+	if _, err := r.db.Exec(ctx, query, args); err != nil {
+		return r.logQueryError(ctx, "Failed to record broadcast failure", err, slog.Int64("broadcast_id", broadcastID))
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package notification_repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	notification_repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastRepository_Get_NotFound(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockRow := mocks.NewRow(t)
+	mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(pgx.ErrNoRows)
+	mockDB.On("QueryRow", mock.Anything, mock.Anything, pgx.NamedArgs{"id": int64(7)}).Return(mockRow)
+
+	repo := notification_repository_postgres.NewBroadcastRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	broadcast, err := repo.Get(context.Background(), 7)
+
+	assert.ErrorIs(t, err, model.ErrBroadcastNotFound)
+	assert.Nil(t, broadcast)
+}
+
+func TestBroadcastRepository_ClaimDue(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockRows := mocks.NewRows(t)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	now := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	mockDB.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, "status IN ('pending', 'running') AND next_run_at <= @now") &&
+				strings.Contains(query, "FOR UPDATE SKIP LOCKED") &&
+				strings.Contains(query, "next_run_at = @lease_until")
+		}),
+		pgx.NamedArgs{"now": now, "lease_until": now.Add(5 * time.Minute), "limit": 10},
+	).Return(mockRows, nil)
+
+	repo := notification_repository_postgres.NewBroadcastRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	broadcasts, err := repo.ClaimDue(context.Background(), now, 5*time.Minute, 10)
+
+	require.NoError(t, err)
+	assert.Empty(t, broadcasts)
+}
+
+func TestBroadcastRepository_Advance(t *testing.T) {
+	chunk := &model.BroadcastChunk{
+		From:          0,
+		Cursor:        2,
+		Total:         2,
+		Sent:          1,
+		Done:          true,
+		Notifications: []*model.Notification{{UserID: 1}, {UserID: 2}},
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(db *mocks.PgDB)
+		wantErr   bool
+	}{
+		{
+			name: "progress recorded",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "WHERE id = @id AND cursor = @from AND status = 'running'")
+					}),
+					pgx.NamedArgs{"id": int64(9), "from": 0, "cursor": 2, "total": 2, "processed": 2, "sent": 1, "status": "completed"},
+				).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+			},
+		},
+		{
+			name: "broadcast moved on",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewBroadcastRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			err := repo.Advance(context.Background(), 9, chunk)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestBroadcastRepository_RecordFailure(t *testing.T) {
+	retryAt := time.Date(2025, 6, 2, 8, 1, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		retryAt *time.Time
+	}{
+		{name: "retry later", retryAt: &retryAt},
+		{name: "give up", retryAt: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockDB.On("Exec", mock.Anything,
+				mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "WHERE id = @id AND status IN ('pending', 'running')")
+				}),
+				pgx.NamedArgs{"id": int64(9), "last_error": "unavailable", "retry_at": tt.retryAt},
+			).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+
+			repo := notification_repository_postgres.NewBroadcastRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			err := repo.RecordFailure(context.Background(), 9, "unavailable", tt.retryAt)
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package notification_repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// DeliveryRepository is the durable queue of channel deliveries.
+type DeliveryRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewDeliveryRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *DeliveryRepository {
+	return &DeliveryRepository{db: db, log: log, metrics: metrics}
+}
+
+// scanDelivery reads a row selected as id, notification_id, user_id, channel,
+// status, attempts, next_attempt_at, last_error, created_at, sent_at.
+func scanDelivery(row pgx.Row, delivery *model.Delivery) error {
+	var channelStr, statusStr string
+	var lastError *string
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.NotificationID,
+		&delivery.UserID,
+		&channelStr,
+		&statusStr,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&lastError,
+		&delivery.CreatedAt,
+		&delivery.SentAt,
+	)
+	delivery.Channel = model.Channel(channelStr)
+	delivery.Status = model.DeliveryStatus(statusStr)
+	if lastError != nil {
+		delivery.LastError = *lastError
+	}
+	return err
+}
+
+func (r *DeliveryRepository) logQueryError(ctx context.Context, msg string, err error, attrs ...any) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		r.log.ErrorContext(ctx, msg, append([]any{
+			slog.String("pg_error_code", pgErr.Code),
+			slog.String("pg_error_message", pgErr.Message),
+			slog.String("pg_error_detail", pgErr.Detail),
+		}, attrs...)...)
+		return custom_errors.ErrDatabaseQuery
+	}
+	r.log.ErrorContext(ctx, msg, append([]any{slog.String("error", err.Error())}, attrs...)...)
+	return err
+}
+
+// Enqueue inserts pending deliveries in one statement. A notification is
+// queued at most once per channel, so re-dispatching is a no-op.
+func (r *DeliveryRepository) Enqueue(ctx context.Context, deliveries []*model.Delivery) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("enqueue_deliveries", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("enqueue_deliveries", time.Since(start))
+	}()
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	notificationIDs := make([]int64, 0, len(deliveries))
+	userIDs := make([]int64, 0, len(deliveries))
+	channels := make([]string, 0, len(deliveries))
+	nextAttemptAts := make([]time.Time, 0, len(deliveries))
+	for _, d := range deliveries {
+		nextAttemptAt := d.NextAttemptAt
+		if nextAttemptAt.IsZero() {
+			nextAttemptAt = time.Now()
+		}
+		notificationIDs = append(notificationIDs, d.NotificationID)
+		userIDs = append(userIDs, d.UserID)
+		channels = append(channels, string(d.Channel))
+		nextAttemptAts = append(nextAttemptAts, nextAttemptAt)
+	}
+
+	query := `
+		INSERT INTO notification_deliveries (notification_id, user_id, channel, next_attempt_at)
+		SELECT * FROM unnest(@notification_ids::bigint[], @user_ids::bigint[], @channels::text[], @next_attempt_ats::timestamp[])
+		ON CONFLICT (notification_id, channel) DO NOTHING
+	`
+
+	args := pgx.NamedArgs{
+		"notification_ids": notificationIDs,
+		"user_ids":         userIDs,
+		"channels":         channels,
+		"next_attempt_ats": nextAttemptAts,
+	}
+
+	r.log.DebugContext(ctx, "Enqueuing deliveries", slog.Int("count", len(deliveries)))
+
+	if _, err := r.db.Exec(ctx, query, args); err != nil {
+		return r.logQueryError(ctx, "Failed to enqueue deliveries", err)
+	}
+
+	return nil
+}
+
+// ClaimPending leases up to limit deliveries that are due at now: they are
+// marked sending and hidden from other workers until the lease runs out. A
+// worker that dies mid-send leaves its deliveries to be reclaimed. Due
+// deliveries of more urgent notifications are claimed first. Only deliveries
+// for the given channels are claimed, so a worker never takes one it has no
+// adapter for.
+func (r *DeliveryRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int, channels []model.Channel) (deliveries []*model.Delivery, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("claim_pending_deliveries", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("claim_pending_deliveries", time.Since(start))
+	}()
+
+	channelStrs := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		channelStrs = append(channelStrs, string(channel))
+	}
+
+	query := `
+		UPDATE notification_deliveries
+		SET status = 'sending', attempts = attempts + 1, next_attempt_at = @lease_until, updated_at = @now
+		WHERE id IN (
+			SELECT d.id
+			FROM notification_deliveries d
+			JOIN notifications n ON n.id = d.notification_id
+			WHERE d.status IN ('pending', 'sending') AND d.next_attempt_at <= @now AND d.channel = ANY(@channels)
+			ORDER BY CASE n.priority WHEN 'critical' THEN 0 WHEN 'high' THEN 1 WHEN 'low' THEN 3 ELSE 2 END, d.next_attempt_at
+			LIMIT @limit
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		RETURNING id, notification_id, user_id, channel, status, attempts, next_attempt_at, last_error, created_at, sent_at
+	`
+
+	args := pgx.NamedArgs{
+		"now":         now,
+		"lease_until": now.Add(lease),
+		"limit":       limit,
+		"channels":    channelStrs,
+	}
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to claim pending deliveries", err)
+	}
+	defer rows.Close()
+
+	claimed := make([]*model.Delivery, 0)
+	for rows.Next() {
+		var delivery model.Delivery
+		if err := scanDelivery(rows, &delivery); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan delivery row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		claimed = append(claimed, &delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+func (r *DeliveryRepository) MarkSent(ctx context.Context, id int64) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("mark_delivery_sent", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("mark_delivery_sent", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notification_deliveries
+		SET status = 'sent', sent_at = NOW(), updated_at = NOW(), last_error = NULL
+		WHERE id = @id
+	`
+
+	return r.updateDelivery(ctx, "mark delivery sent", query, pgx.NamedArgs{"id": id}, id)
+}
+
+// MarkRetry returns a delivery to the queue to be attempted again at nextAttemptAt.
+func (r *DeliveryRepository) MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("mark_delivery_retry", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("mark_delivery_retry", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notification_deliveries
+		SET status = 'pending', next_attempt_at = @next_attempt_at, last_error = @last_error, updated_at = NOW()
+		WHERE id = @id
+	`
+
+	args := pgx.NamedArgs{
+		"id":              id,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	}
+
+	return r.updateDelivery(ctx, "mark delivery for retry", query, args, id)
+}
+
+func (r *DeliveryRepository) MarkFailed(ctx context.Context, id int64, lastError string) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("mark_delivery_failed", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("mark_delivery_failed", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notification_deliveries
+		SET status = 'failed', last_error = @last_error, updated_at = NOW()
+		WHERE id = @id
+	`
+
+	args := pgx.NamedArgs{
+		"id":         id,
+		"last_error": lastError,
+	}
+
+	return r.updateDelivery(ctx, "mark delivery failed", query, args, id)
+}
+
+// ListByNotification returns every channel delivery of a notification with
+// its current outcome.
+func (r *DeliveryRepository) ListByNotification(ctx context.Context, notificationID int64) (deliveries []*model.Delivery, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_notification_deliveries", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_notification_deliveries", time.Since(start))
+	}()
+
+	query := `
+		SELECT id, notification_id, user_id, channel, status, attempts, next_attempt_at, last_error, created_at, sent_at
+		FROM notification_deliveries
+		WHERE notification_id = @notification_id
+		ORDER BY id
+	`
+
+	rows, err := r.db.Query(ctx, query, pgx.NamedArgs{"notification_id": notificationID})
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to list notification deliveries", err, slog.Int64("notification_id", notificationID))
+	}
+	defer rows.Close()
+
+	deliveries = make([]*model.Delivery, 0)
+	for rows.Next() {
+		var delivery model.Delivery
+		if err := scanDelivery(rows, &delivery); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan delivery row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *DeliveryRepository) updateDelivery(ctx context.Context, action, query string, args pgx.NamedArgs, id int64) error {
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		return r.logQueryError(ctx, "Failed to "+action, err, slog.Int64("id", id))
+	}
+
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Delivery not found", slog.Int64("id", id))
+		return custom_errors.ErrNotificationNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package notification_repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	notification_repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockDeliveryRows(t *testing.T, deliveries []model.Delivery) *mocks.Rows {
+	mockRows := mocks.NewRows(t)
+	for range deliveries {
+		mockRows.On("Next").Return(true).Once()
+	}
+	mockRows.On("Next").Return(false).Once()
+
+	for _, d := range deliveries {
+		mockRows.On("Scan",
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*string"),
+			mock.AnythingOfType("*string"),
+			mock.AnythingOfType("*int"),
+			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**string"),
+			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**time.Time")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = d.ID
+				*args.Get(1).(*int64) = d.NotificationID
+				*args.Get(2).(*int64) = d.UserID
+				*args.Get(3).(*string) = string(d.Channel)
+				*args.Get(4).(*string) = string(d.Status)
+				*args.Get(5).(*int) = d.Attempts
+				*args.Get(6).(*time.Time) = d.NextAttemptAt
+			}).
+			Return(nil).
+			Once()
+	}
+	mockRows.On("Err").Return(nil).Maybe()
+	mockRows.On("Close").Return()
+	return mockRows
+}
+
+func TestDeliveryRepository_Enqueue(t *testing.T) {
+	tests := []struct {
+		name        string
+		deliveries  []*model.Delivery
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "enqueues deliveries in one statement",
+			deliveries: []*model.Delivery{
+				{NotificationID: 10, UserID: 1, Channel: model.ChannelEmail},
+				{NotificationID: 10, UserID: 1, Channel: model.ChannelPush},
+			},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "ON CONFLICT (notification_id, channel) DO NOTHING")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						channels, ok := args["channels"].([]string)
+						return ok && assert.ObjectsAreEqual([]string{"email", "push"}, channels)
+					})).Return(createSuccessCommandTag(), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "nothing to enqueue",
+			deliveries: nil,
+			mockSetup:  func(db *mocks.PgDB) {},
+			wantErr:    false,
+		},
+		{
+			name: "postgres specific error",
+			deliveries: []*model.Delivery{
+				{NotificationID: 10, UserID: 1, Channel: model.ChannelEmail},
+			},
+			mockSetup: func(db *mocks.PgDB) {
+				pgErr := &pgconn.PgError{
+					Code:    "23503",
+					Message: "foreign key violation",
+				}
+				db.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(pgconn.CommandTag{}, pgErr)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewDeliveryRepository(mockDB, log, metrics)
+			err := repo.Enqueue(context.Background(), tt.deliveries)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDeliveryRepository_ClaimPending(t *testing.T) {
+	now := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+	pending := model.Delivery{
+		ID:             3,
+		NotificationID: 10,
+		UserID:         1,
+		Channel:        model.ChannelEmail,
+		Status:         model.DeliveryStatusSending,
+		Attempts:       1,
+		NextAttemptAt:  now.Add(time.Minute),
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*testing.T, *mocks.PgDB)
+		want        []model.Delivery
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "leases pending deliveries",
+			mockSetup: func(t *testing.T, db *mocks.PgDB) {
+				rows := setupMockDeliveryRows(t, []model.Delivery{pending})
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "FOR UPDATE OF d SKIP LOCKED") &&
+							strings.Contains(query, "ORDER BY CASE n.priority") &&
+							strings.Contains(query, "d.channel = ANY(@channels)")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["now"] == now && args["lease_until"] == now.Add(time.Minute) && args["limit"] == 20 &&
+							assert.ObjectsAreEqual([]string{"email", "push"}, args["channels"])
+					})).Return(rows, nil)
+			},
+			want:    []model.Delivery{pending},
+			wantErr: false,
+		},
+		{
+			name: "generic error",
+			mockSetup: func(t *testing.T, db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil, errors.New("connection refused"))
+			},
+			wantErr:     true,
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(t, mockDB)
+
+			repo := notification_repository_postgres.NewDeliveryRepository(mockDB, log, metrics)
+			got, err := repo.ClaimPending(context.Background(), now, time.Minute, 20, []model.Channel{model.ChannelEmail, model.ChannelPush})
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.want))
+			for i, want := range tt.want {
+				assert.Equal(t, want.ID, got[i].ID)
+				assert.Equal(t, want.Channel, got[i].Channel)
+				assert.Equal(t, want.Status, got[i].Status)
+				assert.Equal(t, want.Attempts, got[i].Attempts)
+			}
+		})
+	}
+}
+
+func TestDeliveryRepository_MarkRetry(t *testing.T) {
+	next := time.Date(2025, 6, 16, 12, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "reschedules delivery",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "status = 'pending'")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["id"] == int64(3) && args["next_attempt_at"] == next && args["last_error"] == "timeout"
+					})).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "delivery not found",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewDeliveryRepository(mockDB, log, metrics)
+			err := repo.MarkRetry(context.Background(), 3, next, "timeout")
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
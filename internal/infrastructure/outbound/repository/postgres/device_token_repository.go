@@ -0,0 +1,167 @@
+package notification_repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+type DeviceTokenRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewDeviceTokenRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *DeviceTokenRepository {
+	return &DeviceTokenRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *DeviceTokenRepository) logQueryError(ctx context.Context, msg string, err error, attrs ...any) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		r.log.ErrorContext(ctx, msg, append([]any{
+			slog.String("pg_error_code", pgErr.Code),
+			slog.String("pg_error_message", pgErr.Message),
+			slog.String("pg_error_detail", pgErr.Detail),
+		}, attrs...)...)
+		return custom_errors.ErrDatabaseQuery
+	}
+	r.log.ErrorContext(ctx, msg, append([]any{slog.String("error", err.Error())}, attrs...)...)
+	return err
+}
+
+func (r *DeviceTokenRepository) Register(ctx context.Context, token *model.DeviceToken) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("register_device_token", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("register_device_token", time.Since(start))
+	}()
+
+	query := `
+		INSERT INTO device_tokens (user_id, token, platform, locale)
+		VALUES (@user_id, @token, @platform, @locale)
+		ON CONFLICT (token) DO UPDATE
+		SET user_id = EXCLUDED.user_id, platform = EXCLUDED.platform, locale = EXCLUDED.locale, updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	args := pgx.NamedArgs{
+		"user_id":  token.UserID,
+		"token":    token.Token,
+		"platform": string(token.Platform),
+		"locale":   token.Locale,
+	}
+
+	if err := r.db.QueryRow(ctx, query, args).Scan(&token.ID, &token.CreatedAt, &token.UpdatedAt); err != nil {
+		return r.logQueryError(ctx, "Failed to register device token", err, slog.Int64("user_id", token.UserID))
+	}
+
+	r.log.DebugContext(ctx, "Device token registered",
+		slog.Int64("user_id", token.UserID),
+		slog.String("platform", string(token.Platform)),
+	)
+	return nil
+}
+
+func (r *DeviceTokenRepository) Unregister(ctx context.Context, userID int64, token string) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("unregister_device_token", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("unregister_device_token", time.Since(start))
+	}()
+
+	query := `DELETE FROM device_tokens WHERE user_id = @user_id AND token = @token`
+
+	result, err := r.db.Exec(ctx, query, pgx.NamedArgs{"user_id": userID, "token": token})
+	if err != nil {
+		return r.logQueryError(ctx, "Failed to unregister device token", err, slog.Int64("user_id", userID))
+	}
+
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Device token not found", slog.Int64("user_id", userID))
+		return custom_errors.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (r *DeviceTokenRepository) ListByUser(ctx context.Context, userID int64) (tokens []*model.DeviceToken, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_device_tokens", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_device_tokens", time.Since(start))
+	}()
+
+	query := `
+		SELECT id, user_id, token, platform, locale, created_at, updated_at
+		FROM device_tokens
+		WHERE user_id = @user_id
+		ORDER BY updated_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to list device tokens", err, slog.Int64("user_id", userID))
+	}
+	defer rows.Close()
+
+	tokens = make([]*model.DeviceToken, 0)
+	for rows.Next() {
+		var t model.DeviceToken
+		var platform string
+		if err := rows.Scan(&t.ID, &t.UserID, &t.Token, &platform, &t.Locale, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan device token row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		t.Platform = model.DevicePlatform(platform)
+		tokens = append(tokens, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *DeviceTokenRepository) DeleteTokens(ctx context.Context, tokens []string) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_device_tokens", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_device_tokens", time.Since(start))
+	}()
+
+	if len(tokens) == 0 {
+		return 0, nil
+	}
+
+	query := `DELETE FROM device_tokens WHERE token = ANY(@tokens)`
+
+	result, err := r.db.Exec(ctx, query, pgx.NamedArgs{"tokens": tokens})
+	if err != nil {
+		return 0, r.logQueryError(ctx, "Failed to delete device tokens", err, slog.Int("count", len(tokens)))
+	}
+	return result.RowsAffected(), nil
+}
+
+func (r *DeviceTokenRepository) DeleteByUser(ctx context.Context, userID int64) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_user_device_tokens", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_user_device_tokens", time.Since(start))
+	}()
+
+	query := `DELETE FROM device_tokens WHERE user_id = @user_id`
+
+	result, err := r.db.Exec(ctx, query, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return 0, r.logQueryError(ctx, "Failed to delete user device tokens", err, slog.Int64("user_id", userID))
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,91 @@
+package notification_repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	notification_repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceTokenRepository_Register(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockRow := new(mocks.Row)
+	mockRow.On("Scan", mock.AnythingOfType("*int64"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*time.Time")).
+		Run(func(args mock.Arguments) { *args.Get(0).(*int64) = 5 }).
+		Return(nil)
+	mockDB.On("QueryRow",
+		mock.Anything,
+		mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, "ON CONFLICT (token) DO UPDATE") && strings.Contains(query, "user_id = EXCLUDED.user_id")
+		}),
+		mock.MatchedBy(func(args pgx.NamedArgs) bool {
+			return args["token"] == "fcm-token" && args["platform"] == "android" && args["locale"] == "en"
+		})).Return(mockRow)
+
+	repo := notification_repository_postgres.NewDeviceTokenRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	token := &model.DeviceToken{UserID: 1, Token: "fcm-token", Platform: model.DevicePlatformAndroid, Locale: "en"}
+
+	require.NoError(t, repo.Register(context.Background(), token))
+	assert.Equal(t, int64(5), token.ID)
+}
+
+func TestDeviceTokenRepository_DeleteTokens(t *testing.T) {
+	tests := []struct {
+		name        string
+		tokens      []string
+		mockSetup   func(*mocks.PgDB)
+		wantDeleted int64
+		wantErr     bool
+	}{
+		{
+			name:   "deletes the given tokens",
+			tokens: []string{"a", "b"},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "token = ANY(@tokens)")
+				}), pgx.NamedArgs{"tokens": []string{"a", "b"}}).Return(createSuccessCommandTag(), nil)
+			},
+			wantDeleted: 1,
+		},
+		{
+			name:      "nothing to delete",
+			tokens:    nil,
+			mockSetup: func(db *mocks.PgDB) {},
+		},
+		{
+			name:   "database error",
+			tokens: []string{"a"},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(createEmptyCommandTag(), errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewDeviceTokenRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			deleted, err := repo.DeleteTokens(context.Background(), tt.tokens)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
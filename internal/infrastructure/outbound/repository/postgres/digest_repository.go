@@ -0,0 +1,222 @@
+package notification_repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+type DigestRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewDigestRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *DigestRepository {
+	return &DigestRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *DigestRepository) logQueryError(ctx context.Context, msg string, err error, attrs ...any) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		r.log.ErrorContext(ctx, msg, append([]any{
+			slog.String("pg_error_code", pgErr.Code),
+			slog.String("pg_error_message", pgErr.Message),
+			slog.String("pg_error_detail", pgErr.Detail),
+		}, attrs...)...)
+		return custom_errors.ErrDatabaseQuery
+	}
+	r.log.ErrorContext(ctx, msg, append([]any{slog.String("error", err.Error())}, attrs...)...)
+	return err
+}
+
+// scanDigestSubscription reads a row selected as
+// user_id, frequency, next_run_at, last_sent_at, covered_until, updated_at.
+func scanDigestSubscription(row pgx.Row, subscription *model.DigestSubscription) error {
+	var frequency string
+	err := row.Scan(
+		&subscription.UserID,
+		&frequency,
+		&subscription.NextRunAt,
+		&subscription.LastSentAt,
+		&subscription.CoveredUntil,
+		&subscription.UpdatedAt,
+	)
+	subscription.Frequency = model.DigestFrequency(frequency)
+	return err
+}
+
+// Upsert sets the frequency and next run of a subscription. A new
+// subscription starts its watermark at the moment it is created, so the first
+// digest does not dig up the user's whole unread history. The watermark of an
+// existing subscription is kept, so switching frequency or turning the digest
+// off and on again does not resend anything.
+func (r *DigestRepository) Upsert(ctx context.Context, subscription *model.DigestSubscription) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("upsert_digest_subscription", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("upsert_digest_subscription", time.Since(start))
+	}()
+
+	query := `
+		INSERT INTO notification_digest_subscriptions (user_id, frequency, next_run_at, covered_until, updated_at)
+		VALUES (@user_id, @frequency, @next_run_at, NOW(), NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET frequency = EXCLUDED.frequency, next_run_at = EXCLUDED.next_run_at, updated_at = EXCLUDED.updated_at
+		RETURNING user_id, frequency, next_run_at, last_sent_at, covered_until, updated_at
+	`
+
+	args := pgx.NamedArgs{
+		"user_id":     subscription.UserID,
+		"frequency":   string(subscription.Frequency),
+		"next_run_at": subscription.NextRunAt,
+	}
+
+	if err := scanDigestSubscription(r.db.QueryRow(ctx, query, args), subscription); err != nil {
+		return r.logQueryError(ctx, "Failed to upsert digest subscription", err, slog.Int64("user_id", subscription.UserID))
+	}
+
+	r.log.DebugContext(ctx, "Digest subscription saved",
+		slog.Int64("user_id", subscription.UserID),
+		slog.String("frequency", string(subscription.Frequency)),
+		slog.Time("next_run_at", subscription.NextRunAt),
+	)
+	return nil
+}
+
+func (r *DigestRepository) Get(ctx context.Context, userID int64) (subscription *model.DigestSubscription, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_digest_subscription", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_digest_subscription", time.Since(start))
+	}()
+
+	query := `
+		SELECT user_id, frequency, next_run_at, last_sent_at, covered_until, updated_at
+		FROM notification_digest_subscriptions
+		WHERE user_id = @user_id
+	`
+
+	subscription = &model.DigestSubscription{}
+	if err := scanDigestSubscription(r.db.QueryRow(ctx, query, pgx.NamedArgs{"user_id": userID}), subscription); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, custom_errors.ErrNotificationNotFound
+		}
+		return nil, r.logQueryError(ctx, "Failed to get digest subscription", err, slog.Int64("user_id", userID))
+	}
+	return subscription, nil
+}
+
+// ClaimDue leases up to limit subscriptions whose digest is due at now by
+// moving their next run past the lease. A worker that dies mid-digest
+// leaves the subscription to be reclaimed once the lease runs out.
+func (r *DigestRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) (subscriptions []*model.DigestSubscription, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("claim_due_digests", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("claim_due_digests", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notification_digest_subscriptions
+		SET next_run_at = @lease_until
+		WHERE user_id IN (
+			SELECT user_id
+			FROM notification_digest_subscriptions
+			WHERE frequency <> 'off' AND next_run_at <= @now
+			ORDER BY next_run_at
+			LIMIT @limit
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING user_id, frequency, next_run_at, last_sent_at, covered_until, updated_at
+	`
+
+	args := pgx.NamedArgs{
+		"now":         now,
+		"lease_until": now.Add(lease),
+		"limit":       limit,
+	}
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to claim due digests", err)
+	}
+	defer rows.Close()
+
+	subscriptions = make([]*model.DigestSubscription, 0)
+	for rows.Next() {
+		var subscription model.DigestSubscription
+		if err := scanDigestSubscription(rows, &subscription); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan digest subscription row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		subscriptions = append(subscriptions, &subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// Advance records a finished digest run: the watermark moves to
+// coveredUntil, last_sent_at is set when a digest actually went out, and the
+// next run is scheduled. The watermark never moves backwards.
+func (r *DigestRepository) Advance(ctx context.Context, userID int64, coveredUntil time.Time, sentAt *time.Time, nextRunAt time.Time) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("advance_digest", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("advance_digest", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notification_digest_subscriptions
+		SET covered_until = GREATEST(covered_until, @covered_until),
+			last_sent_at = COALESCE(@sent_at, last_sent_at),
+			next_run_at = @next_run_at
+		WHERE user_id = @user_id
+	`
+
+	args := pgx.NamedArgs{
+		"user_id":       userID,
+		"covered_until": coveredUntil,
+		"sent_at":       sentAt,
+		"next_run_at":   nextRunAt,
+	}
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		return r.logQueryError(ctx, "Failed to advance digest", err, slog.Int64("user_id", userID))
+	}
+
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Digest subscription not found", slog.Int64("user_id", userID))
+		return custom_errors.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (r *DigestRepository) Delete(ctx context.Context, userID int64) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_digest_subscription", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_digest_subscription", time.Since(start))
+	}()
+
+	query := `DELETE FROM notification_digest_subscriptions WHERE user_id = @user_id`
+
+	result, err := r.db.Exec(ctx, query, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return 0, r.logQueryError(ctx, "Failed to delete digest subscription", err, slog.Int64("user_id", userID))
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,159 @@
+package notification_repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	notification_repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestRepository_ClaimDue(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockRows := mocks.NewRows(t)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	now := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	mockDB.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, "frequency <> 'off' AND next_run_at <= @now") &&
+				strings.Contains(query, "FOR UPDATE SKIP LOCKED") &&
+				strings.Contains(query, "SET next_run_at = @lease_until")
+		}),
+		pgx.NamedArgs{"now": now, "lease_until": now.Add(5 * time.Minute), "limit": 100},
+	).Return(mockRows, nil)
+
+	repo := notification_repository_postgres.NewDigestRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	subscriptions, err := repo.ClaimDue(context.Background(), now, 5*time.Minute, 100)
+
+	require.NoError(t, err)
+	assert.Empty(t, subscriptions)
+}
+
+func TestDigestRepository_Advance(t *testing.T) {
+	coveredUntil := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	nextRunAt := coveredUntil.Add(24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		sentAt    *time.Time
+		mockSetup func(*mocks.PgDB)
+		wantErr   error
+	}{
+		{
+			name:   "digest sent",
+			sentAt: &coveredUntil,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "GREATEST(covered_until, @covered_until)") &&
+							strings.Contains(query, "COALESCE(@sent_at, last_sent_at)")
+					}),
+					pgx.NamedArgs{"user_id": int64(1), "covered_until": coveredUntil, "sent_at": &coveredUntil, "next_run_at": nextRunAt},
+				).Return(createSuccessCommandTag(), nil)
+			},
+		},
+		{
+			name: "subscription not found",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(createEmptyCommandTag(), nil)
+			},
+			wantErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(createEmptyCommandTag(), errors.New("db error"))
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewDigestRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			err := repo.Advance(context.Background(), 1, coveredUntil, tt.sentAt, nextRunAt)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDigestRepository_Upsert(t *testing.T) {
+	nextRunAt := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	subscribedAt := nextRunAt.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		scanErr error
+		wantErr error
+	}{
+		{
+			name: "first subscribe starts the watermark now",
+		},
+		{
+			name:    "database error",
+			scanErr: errors.New("db error"),
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockRow := mocks.NewRow(t)
+			mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					*args.Get(0).(*int64) = 1
+					*args.Get(1).(*string) = "daily"
+					*args.Get(2).(*time.Time) = nextRunAt
+					*args.Get(4).(**time.Time) = &subscribedAt
+				}).
+				Return(tt.scanErr)
+			mockDB.On("QueryRow",
+				mock.Anything,
+				mock.MatchedBy(func(query string) bool {
+					insert, conflict, _ := strings.Cut(query, "ON CONFLICT")
+					return strings.Contains(insert, "covered_until") && strings.Contains(insert, "NOW(), NOW()") &&
+						!strings.Contains(conflict, "covered_until =")
+				}),
+				pgx.NamedArgs{"user_id": int64(1), "frequency": "daily", "next_run_at": nextRunAt},
+			).Return(mockRow)
+
+			repo := notification_repository_postgres.NewDigestRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			subscription := &model.DigestSubscription{UserID: 1, Frequency: model.DigestFrequencyDaily, NextRunAt: nextRunAt}
+			err := repo.Upsert(context.Background(), subscription)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, subscription.CoveredUntil)
+			assert.Equal(t, subscribedAt, *subscription.CoveredUntil)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package notification_repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+type PreferenceRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewPreferenceRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *PreferenceRepository {
+	return &PreferenceRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *PreferenceRepository) ListChannelPreferences(ctx context.Context, userID int64) (preferences []*model.ChannelPreference, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_channel_preferences", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_channel_preferences", time.Since(start))
+	}()
+
+	query := `
+		SELECT user_id, type, channel, enabled, updated_at
+		FROM notification_channel_preferences
+		WHERE user_id = @user_id
+		ORDER BY type, channel
+	`
+
+	rows, err := r.db.Query(ctx, query, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to list channel preferences",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+			)
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to list channel preferences", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	preferences = make([]*model.ChannelPreference, 0)
+	for rows.Next() {
+		var preference model.ChannelPreference
+		var typeStr, channelStr string
+		if err := rows.Scan(&preference.UserID, &typeStr, &channelStr, &preference.Enabled, &preference.UpdatedAt); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan channel preference row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		preference.Type = events.EventType(typeStr)
+		preference.Channel = model.Channel(channelStr)
+		preferences = append(preferences, &preference)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func (r *PreferenceRepository) UpsertChannelPreference(ctx context.Context, preference *model.ChannelPreference) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("upsert_channel_preference", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("upsert_channel_preference", time.Since(start))
+	}()
+
+	query := `
+		INSERT INTO notification_channel_preferences (user_id, type, channel, enabled, updated_at)
+		VALUES (@user_id, @type, @channel, @enabled, NOW())
+		ON CONFLICT (user_id, type, channel) DO UPDATE
+		SET enabled = EXCLUDED.enabled, updated_at = EXCLUDED.updated_at
+	`
+
+	args := pgx.NamedArgs{
+		"user_id": preference.UserID,
+		"type":    string(preference.Type),
+		"channel": string(preference.Channel),
+		"enabled": preference.Enabled,
+	}
+
+	if _, err := r.db.Exec(ctx, query, args); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to upsert channel preference",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", preference.UserID),
+			)
+			return custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to upsert channel preference", slog.String("error", err.Error()))
+		return err
+	}
+
+	r.log.DebugContext(ctx, "Channel preference saved",
+		slog.Int64("user_id", preference.UserID),
+		slog.String("type", string(preference.Type)),
+		slog.String("channel", string(preference.Channel)),
+		slog.Bool("enabled", preference.Enabled),
+	)
+	return nil
+}
+
+func (r *PreferenceRepository) DeleteChannelPreferences(ctx context.Context, userID int64) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_channel_preferences", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_channel_preferences", time.Since(start))
+	}()
+
+	query := `DELETE FROM notification_channel_preferences WHERE user_id = @user_id`
+
+	result, err := r.db.Exec(ctx, query, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to delete channel preferences",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to delete channel preferences", slog.String("error", err.Error()))
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
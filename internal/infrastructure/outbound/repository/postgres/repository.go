@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	model "pinstack-notification-service/internal/domain/models"
 	ports "pinstack-notification-service/internal/domain/ports/output"
+	"strconv"
 	"time"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
@@ -26,63 +27,139 @@ func NewNotificationRepository(db PgDB, log ports.Logger, metrics ports.MetricsP
 	return &NotificationRepository{db: db, log: log, metrics: metrics}
 }
 
-func (r *NotificationRepository) Create(ctx context.Context, notif *model.Notification) (id int64, err error) {
-	start := time.Now()
-	defer func() {
-		r.metrics.IncrementDatabaseQueries("create_notification", err == nil)
-		r.metrics.RecordDatabaseQueryDuration("create_notification", time.Since(start))
-	}()
+// scanNotification reads a row selected as
+// id, user_id, type, state, pinned_at, created_at, payload, priority.
+func scanNotification(row pgx.Row, notification *model.Notification) error {
+	var typeStr, stateStr, priorityStr string
+	err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&typeStr,
+		&stateStr,
+		&notification.PinnedAt,
+		&notification.CreatedAt,
+		&notification.Payload,
+		&priorityStr,
+	)
+	notification.Type = events.EventType(typeStr)
+	notification.State = model.NotificationState(stateStr)
+	notification.Priority = model.NotificationPriority(priorityStr)
+	notification.IsRead = notification.State != model.NotificationStateUnread
+	return err
+}
+
+// rowWithExtra scans the columns selected after the notification columns
+// into extra.
+type rowWithExtra struct {
+	pgx.Row
+	extra []any
+}
+
+func (r rowWithExtra) Scan(dest ...any) error {
+	return r.Row.Scan(append(dest, r.extra...)...)
+}
+
+// feedPriorityOrder is the ORDER BY term that puts urgent notifications
+// first when the filter asks for it.
+func feedPriorityOrder(filter *model.FeedFilter) string {
+	if filter == nil || !filter.PriorityFirst {
+		return ""
+	}
+	return "priority IN ('high', 'critical') DESC, "
+}
 
+const createNotificationQuery = `
+	INSERT INTO notifications (
+		user_id, 
+		type, 
+		state, 
+		deliver_at, 
+		delivered_at, 
+		expires_at, 
+		created_at, 
+		payload,
+		priority,
+		broadcast_id
+	) VALUES (
+		@user_id, 
+		@type, 
+		@state, 
+		@deliver_at, 
+		@delivered_at, 
+		@expires_at, 
+		@created_at, 
+		@payload,
+		@priority,
+		@broadcast_id
+	)
+	ON CONFLICT (broadcast_id, user_id) WHERE broadcast_id IS NOT NULL DO NOTHING
+	RETURNING id, user_id, type, state, pinned_at, created_at, payload, priority
+`
+
+// createNotificationArgs fills in the defaults for a new notification and
+// returns the arguments of createNotificationQuery.
+func createNotificationArgs(notif *model.Notification) pgx.NamedArgs {
 	createdAt := pgtype.Timestamptz{Time: time.Now(), Valid: true}
 	if !notif.CreatedAt.IsZero() {
 		createdAt.Time = notif.CreatedAt
 	}
 
-	args := pgx.NamedArgs{
-		"user_id":    notif.UserID,
-		"type":       string(notif.Type),
-		"is_read":    notif.IsRead,
-		"created_at": createdAt,
-		"payload":    notif.Payload,
+	state := notif.State
+	if state == "" {
+		state = model.NotificationStateUnread
 	}
 
-	query := `
-		INSERT INTO notifications (
-			user_id, 
-			type, 
-			is_read, 
-			created_at, 
-			payload
-		) VALUES (
-			@user_id, 
-			@type, 
-			@is_read, 
-			@created_at, 
-			@payload
-		) RETURNING id, user_id, type, is_read, created_at, payload
-	`
+	priority := notif.Priority
+	if priority == "" {
+		priority = model.NotificationPriorityNormal
+	}
+
+	// Scheduled notifications stay undelivered until the scheduler claims them.
+	var deliveredAt *pgtype.Timestamptz
+	if notif.DeliverAt == nil {
+		deliveredAt = &createdAt
+	}
+
+	var broadcastID *int64
+	if notif.BroadcastID > 0 {
+		broadcastID = &notif.BroadcastID
+	}
+
+	return pgx.NamedArgs{
+		"user_id":      notif.UserID,
+		"type":         string(notif.Type),
+		"state":        string(state),
+		"deliver_at":   notif.DeliverAt,
+		"delivered_at": deliveredAt,
+		"expires_at":   notif.ExpiresAt,
+		"created_at":   createdAt,
+		"payload":      notif.Payload,
+		"priority":     string(priority),
+		"broadcast_id": broadcastID,
+	}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, notif *model.Notification) (id int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_notification", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_notification", time.Since(start))
+	}()
 
-	r.log.Debug("Creating notification",
+	args := createNotificationArgs(notif)
+
+	r.log.DebugContext(ctx, "Creating notification",
 		slog.Int64("user_id", notif.UserID),
 		slog.String("type", string(notif.Type)),
 	)
 
 	var createdNotification model.Notification
-	var typeStr string
-	err = r.db.QueryRow(ctx, query, args).Scan(
-		&createdNotification.ID,
-		&createdNotification.UserID,
-		&typeStr,
-		&createdNotification.IsRead,
-		&createdNotification.CreatedAt,
-		&createdNotification.Payload,
-	)
-	createdNotification.Type = events.EventType(typeStr)
+	err = scanNotification(r.db.QueryRow(ctx, createNotificationQuery, args), &createdNotification)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to create notification",
+			r.log.ErrorContext(ctx, "Failed to create notification",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
@@ -92,15 +169,17 @@ func (r *NotificationRepository) Create(ctx context.Context, notif *model.Notifi
 			return 0, custom_errors.ErrDatabaseQuery
 		}
 
-		r.log.Error("Failed to create notification", slog.String("error", err.Error()))
+		r.log.ErrorContext(ctx, "Failed to create notification", slog.String("error", err.Error()))
 		return 0, err
 	}
 
 	// Update the passed notification object with the created data
 	notif.ID = createdNotification.ID
+	notif.State = createdNotification.State
+	notif.IsRead = createdNotification.IsRead
 	notif.CreatedAt = createdNotification.CreatedAt
 
-	r.log.Debug("Notification created successfully",
+	r.log.DebugContext(ctx, "Notification created successfully",
 		slog.Int64("id", createdNotification.ID),
 		slog.Int64("user_id", createdNotification.UserID),
 	)
@@ -108,6 +187,80 @@ func (r *NotificationRepository) Create(ctx context.Context, notif *model.Notifi
 	return createdNotification.ID, nil
 }
 
+// CreateMany inserts the notifications in one round-trip and sets their IDs
+// like Create does. The batch runs as a single implicit transaction, so
+// either all notifications are stored or none is. A broadcast notification
+// whose recipient already has one from the same broadcast is skipped and
+// keeps ID 0.
+func (r *NotificationRepository) CreateMany(ctx context.Context, notifs []*model.Notification) (ids []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_notifications", time.Since(start))
+	}()
+
+	if len(notifs) == 0 {
+		return []int64{}, nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, notif := range notifs {
+		batch.Queue(createNotificationQuery, createNotificationArgs(notif))
+	}
+
+	r.log.DebugContext(ctx, "Creating notifications", slog.Int("count", len(notifs)))
+
+	results := r.db.SendBatch(ctx, batch)
+	created := make([]model.Notification, len(notifs))
+	for i := range notifs {
+		err = scanNotification(results.QueryRow(), &created[i])
+		if errors.Is(err, pgx.ErrNoRows) && notifs[i].BroadcastID > 0 {
+			created[i] = model.Notification{}
+			err = nil
+		}
+		if err != nil {
+			break
+		}
+	}
+	// Close reads the end of the implicit transaction, so a failed commit
+	// only shows up here.
+	if closeErr := results.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to create notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int("count", len(notifs)),
+			)
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+
+		r.log.ErrorContext(ctx, "Failed to create notifications", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	ids = make([]int64, 0, len(notifs))
+	for i, notif := range notifs {
+		if created[i].ID == 0 {
+			ids = append(ids, 0)
+			continue
+		}
+		notif.ID = created[i].ID
+		notif.State = created[i].State
+		notif.IsRead = created[i].IsRead
+		notif.CreatedAt = created[i].CreatedAt
+		ids = append(ids, notif.ID)
+	}
+
+	r.log.DebugContext(ctx, "Notifications created successfully", slog.Int("count", len(ids)))
+	return ids, nil
+}
+
 func (r *NotificationRepository) GetByID(ctx context.Context, id int64) (notification *model.Notification, err error) {
 	start := time.Now()
 	defer func() {
@@ -116,38 +269,30 @@ func (r *NotificationRepository) GetByID(ctx context.Context, id int64) (notific
 	}()
 
 	query := `
-		SELECT id, user_id, type, is_read, created_at, payload 
+		SELECT id, user_id, type, state, pinned_at, created_at, payload, priority
 		FROM notifications 
-		WHERE id = @id
+		WHERE id = @id AND deleted_at IS NULL AND delivered_at IS NOT NULL
+			AND (expires_at IS NULL OR expires_at > NOW())
 	`
 
 	args := pgx.NamedArgs{
 		"id": id,
 	}
 
-	r.log.Debug("Getting notification by ID", slog.Int64("id", id))
+	r.log.DebugContext(ctx, "Getting notification by ID", slog.Int64("id", id))
 
 	var notificationData model.Notification
-	var typeStr string
-	err = r.db.QueryRow(ctx, query, args).Scan(
-		&notificationData.ID,
-		&notificationData.UserID,
-		&typeStr,
-		&notificationData.IsRead,
-		&notificationData.CreatedAt,
-		&notificationData.Payload,
-	)
-	notificationData.Type = events.EventType(typeStr)
+	err = scanNotification(r.db.QueryRow(ctx, query, args), &notificationData)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			r.log.Debug("Notification not found", slog.Int64("id", id))
+			r.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
 			return nil, custom_errors.ErrNotificationNotFound
 		}
 
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to get notification",
+			r.log.ErrorContext(ctx, "Failed to get notification",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
@@ -157,11 +302,11 @@ func (r *NotificationRepository) GetByID(ctx context.Context, id int64) (notific
 			return nil, custom_errors.ErrDatabaseQuery
 		}
 
-		r.log.Error("Failed to get notification", slog.String("error", err.Error()))
+		r.log.ErrorContext(ctx, "Failed to get notification", slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	r.log.Debug("Notification retrieved successfully",
+	r.log.DebugContext(ctx, "Notification retrieved successfully",
 		slog.Int64("id", notificationData.ID),
 		slog.Int64("user_id", notificationData.UserID),
 	)
@@ -169,21 +314,25 @@ func (r *NotificationRepository) GetByID(ctx context.Context, id int64) (notific
 	return &notificationData, nil
 }
 
-func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, limit int, offset int) (notifications []*model.Notification, totalCount int32, err error) {
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, filter *model.FeedFilter, limit int, offset int) (notifications []*model.Notification, totalCount int32, err error) {
 	start := time.Now()
 	defer func() {
 		r.metrics.IncrementDatabaseQueries("list_notifications_by_user", err == nil)
 		r.metrics.RecordDatabaseQueryDuration("list_notifications_by_user", time.Since(start))
 	}()
 
+	states := filter.StateStrings()
+
 	countQuery := `
 		SELECT COUNT(*)
 		FROM notifications 
-		WHERE user_id = @user_id
+		WHERE user_id = @user_id AND state = ANY(@states::text[]) AND deleted_at IS NULL AND delivered_at IS NOT NULL
+			AND (expires_at IS NULL OR expires_at > NOW())
 	`
 
 	countArgs := pgx.NamedArgs{
 		"user_id": userID,
+		"states":  states,
 	}
 
 	var totalCountVar int32
@@ -191,7 +340,7 @@ func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, l
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to count notifications",
+			r.log.ErrorContext(ctx, "Failed to count notifications",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
@@ -201,25 +350,27 @@ func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, l
 			return nil, 0, custom_errors.ErrDatabaseQuery
 		}
 
-		r.log.Error("Failed to count notifications", slog.String("error", err.Error()))
+		r.log.ErrorContext(ctx, "Failed to count notifications", slog.String("error", err.Error()))
 		return nil, 0, err
 	}
 
 	query := `
-		SELECT id, user_id, type, is_read, created_at, payload 
+		SELECT id, user_id, type, state, pinned_at, created_at, payload, priority
 		FROM notifications 
-		WHERE user_id = @user_id
-		ORDER BY created_at DESC
+		WHERE user_id = @user_id AND state = ANY(@states::text[]) AND deleted_at IS NULL AND delivered_at IS NOT NULL
+			AND (expires_at IS NULL OR expires_at > NOW())
+		ORDER BY pinned_at DESC NULLS LAST, ` + feedPriorityOrder(filter) + `created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
 
 	args := pgx.NamedArgs{
 		"user_id": userID,
+		"states":  states,
 		"limit":   limit,
 		"offset":  offset,
 	}
 
-	r.log.Debug("Listing notifications by user",
+	r.log.DebugContext(ctx, "Listing notifications by user",
 		slog.Int64("user_id", userID),
 		slog.Int("limit", limit),
 		slog.Int("offset", offset),
@@ -230,7 +381,7 @@ func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, l
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to list notifications",
+			r.log.ErrorContext(ctx, "Failed to list notifications",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
@@ -240,7 +391,7 @@ func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, l
 			return nil, 0, custom_errors.ErrDatabaseQuery
 		}
 
-		r.log.Error("Failed to list notifications", slog.String("error", err.Error()))
+		r.log.ErrorContext(ctx, "Failed to list notifications", slog.String("error", err.Error()))
 		return nil, 0, err
 	}
 	defer rows.Close()
@@ -248,19 +399,10 @@ func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, l
 	notificationsList := make([]*model.Notification, 0)
 	for rows.Next() {
 		var notification model.Notification
-		var typeStr string
-		err := rows.Scan(
-			&notification.ID,
-			&notification.UserID,
-			&typeStr,
-			&notification.IsRead,
-			&notification.CreatedAt,
-			&notification.Payload,
-		)
-		notification.Type = events.EventType(typeStr)
+		err := scanNotification(rows, &notification)
 
 		if err != nil {
-			r.log.Error("Failed to scan notification row", slog.String("error", err.Error()))
+			r.log.ErrorContext(ctx, "Failed to scan notification row", slog.String("error", err.Error()))
 			return nil, 0, custom_errors.ErrDatabaseQuery
 		}
 
@@ -268,11 +410,11 @@ func (r *NotificationRepository) ListByUser(ctx context.Context, userID int64, l
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("Error during rows iteration", slog.String("error", err.Error()))
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
 		return nil, 0, err
 	}
 
-	r.log.Debug("Retrieved notifications successfully",
+	r.log.DebugContext(ctx, "Retrieved notifications successfully",
 		slog.Int64("user_id", userID),
 		slog.Int("count", len(notificationsList)),
 		slog.Int("total_count", int(totalCountVar)),
@@ -290,21 +432,21 @@ func (r *NotificationRepository) MarkAsRead(ctx context.Context, id int64) (err
 
 	query := `
 		UPDATE notifications
-		SET is_read = true
-		WHERE id = @id
+		SET state = CASE WHEN state = 'unread' THEN 'read' ELSE state END
+		WHERE id = @id AND deleted_at IS NULL AND delivered_at IS NOT NULL
 	`
 
 	args := pgx.NamedArgs{
 		"id": id,
 	}
 
-	r.log.Debug("Marking notification as read", slog.Int64("id", id))
+	r.log.DebugContext(ctx, "Marking notification as read", slog.Int64("id", id))
 
 	result, err := r.db.Exec(ctx, query, args)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to mark notification as read",
+			r.log.ErrorContext(ctx, "Failed to mark notification as read",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
@@ -314,22 +456,22 @@ func (r *NotificationRepository) MarkAsRead(ctx context.Context, id int64) (err
 			return custom_errors.ErrDatabaseQuery
 		}
 
-		r.log.Error("Failed to mark notification as read", slog.String("error", err.Error()))
+		r.log.ErrorContext(ctx, "Failed to mark notification as read", slog.String("error", err.Error()))
 		return err
 	}
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		r.log.Debug("Notification not found", slog.Int64("id", id))
+		r.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
 		err = custom_errors.ErrNotificationNotFound
 		return
 	}
 
-	r.log.Debug("Notification marked as read successfully", slog.Int64("id", id))
+	r.log.DebugContext(ctx, "Notification marked as read successfully", slog.Int64("id", id))
 	return
 }
 
-func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID int64) (err error) {
+func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID int64) (updated int64, err error) {
 	start := time.Now()
 	defer func() {
 		r.metrics.IncrementDatabaseQueries("mark_all_notifications_as_read", err == nil)
@@ -338,132 +480,817 @@ func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID int64
 
 	query := `
 		UPDATE notifications
-		SET is_read = true
-		WHERE user_id = @user_id AND is_read = false
+		SET state = 'read'
+		WHERE user_id = @user_id AND state = 'unread' AND deleted_at IS NULL AND delivered_at IS NOT NULL
 	`
 
 	args := pgx.NamedArgs{
 		"user_id": userID,
 	}
 
-	r.log.Debug("Marking all notifications as read for user", slog.Int64("user_id", userID))
+	r.log.DebugContext(ctx, "Marking all notifications as read for user", slog.Int64("user_id", userID))
 
 	result, err := r.db.Exec(ctx, query, args)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to mark all notifications as read",
+			r.log.ErrorContext(ctx, "Failed to mark all notifications as read",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
 				slog.Int64("user_id", userID),
 			)
 
-			return custom_errors.ErrDatabaseQuery
+			return 0, custom_errors.ErrDatabaseQuery
 		}
 
-		r.log.Error("Failed to mark all notifications as read", slog.String("error", err.Error()))
-		return err
+		r.log.ErrorContext(ctx, "Failed to mark all notifications as read", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	updated = result.RowsAffected()
+	r.log.DebugContext(ctx, "Notifications marked as read successfully",
+		slog.Int64("user_id", userID),
+		slog.Int64("count", updated),
+	)
+
+	return updated, nil
+}
+
+func (r *NotificationRepository) MarkAllAsReadUpTo(ctx context.Context, userID int64, watermark *model.ReadWatermark) (updated int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("mark_notifications_as_read_up_to", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("mark_notifications_as_read_up_to", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notifications
+		SET state = 'read'
+		WHERE user_id = @user_id AND state = 'unread' AND deleted_at IS NULL AND delivered_at IS NOT NULL
+			AND (expires_at IS NULL OR expires_at > NOW())
+			AND (@read_before::timestamp IS NULL OR created_at <= @read_before::timestamp)
+			AND (@max_id::bigint IS NULL OR id <= @max_id::bigint)
+			AND (COALESCE(cardinality(@types::text[]), 0) = 0 OR type = ANY(@types::text[]))
+	`
+
+	args := pgx.NamedArgs{
+		"user_id":     userID,
+		"read_before": watermark.ReadBefore,
+		"max_id":      watermark.MaxID,
+		"types":       watermark.TypeStrings(),
+	}
+
+	r.log.DebugContext(ctx, "Marking notifications as read up to watermark",
+		slog.Int64("user_id", userID),
+		slog.Any("read_before", watermark.ReadBefore),
+		slog.Any("max_id", watermark.MaxID),
+		slog.Int("types_count", len(watermark.Types)),
+	)
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to mark notifications as read up to watermark",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to mark notifications as read up to watermark", slog.String("error", err.Error()))
+		return 0, err
 	}
 
 	rowsAffected := result.RowsAffected()
-	r.log.Debug("Notifications marked as read successfully",
+	r.log.DebugContext(ctx, "Notifications marked as read up to watermark",
 		slog.Int64("user_id", userID),
 		slog.Int64("count", rowsAffected),
 	)
+	return rowsAffected, nil
+}
+
+func (r *NotificationRepository) UpdateState(ctx context.Context, id int64, state model.NotificationState) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("update_notification_state", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("update_notification_state", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notifications
+		SET state = @state::text,
+			pinned_at = CASE WHEN @state::text = 'archived' THEN NULL ELSE pinned_at END
+		WHERE id = @id AND deleted_at IS NULL AND delivered_at IS NOT NULL
+	`
+
+	args := pgx.NamedArgs{
+		"id":    id,
+		"state": string(state),
+	}
+
+	r.log.DebugContext(ctx, "Updating notification state", slog.Int64("id", id), slog.String("state", string(state)))
 
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to update notification state",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("id", id),
+			)
+			return custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to update notification state", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
+		return custom_errors.ErrNotificationNotFound
+	}
+
+	r.log.DebugContext(ctx, "Notification state updated successfully", slog.Int64("id", id), slog.String("state", string(state)))
 	return nil
 }
 
-func (r *NotificationRepository) Delete(ctx context.Context, id int64) (err error) {
+func (r *NotificationRepository) SetPinned(ctx context.Context, id int64, pinned bool) (err error) {
 	start := time.Now()
 	defer func() {
-		r.metrics.IncrementDatabaseQueries("delete_notification", err == nil)
-		r.metrics.RecordDatabaseQueryDuration("delete_notification", time.Since(start))
+		r.metrics.IncrementDatabaseQueries("set_notification_pinned", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("set_notification_pinned", time.Since(start))
 	}()
 
 	query := `
-		DELETE FROM notifications
-		WHERE id = @id
+		UPDATE notifications
+		SET pinned_at = CASE WHEN @pinned::boolean THEN COALESCE(pinned_at, NOW()) ELSE NULL END
+		WHERE id = @id AND deleted_at IS NULL AND delivered_at IS NOT NULL
 	`
 
 	args := pgx.NamedArgs{
-		"id": id,
+		"id":     id,
+		"pinned": pinned,
 	}
 
-	r.log.Debug("Deleting notification", slog.Int64("id", id))
+	r.log.DebugContext(ctx, "Setting notification pinned flag", slog.Int64("id", id), slog.Bool("pinned", pinned))
 
 	result, err := r.db.Exec(ctx, query, args)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to delete notification",
+			r.log.ErrorContext(ctx, "Failed to set notification pinned flag",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
 				slog.Int64("id", id),
 			)
-
 			return custom_errors.ErrDatabaseQuery
 		}
+		r.log.ErrorContext(ctx, "Failed to set notification pinned flag", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
+		return custom_errors.ErrNotificationNotFound
+	}
+
+	r.log.DebugContext(ctx, "Notification pinned flag set successfully", slog.Int64("id", id), slog.Bool("pinned", pinned))
+	return nil
+}
+
+// ClaimDue marks up to limit scheduled notifications that are due at now as
+// delivered and returns them. Rows locked by another replica are skipped, so
+// several schedulers can run concurrently without delivering twice.
+func (r *NotificationRepository) ClaimDue(ctx context.Context, now time.Time, limit int) (notifications []*model.Notification, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("claim_due_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("claim_due_notifications", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notifications
+		SET delivered_at = @now
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+			WHERE delivered_at IS NULL AND deleted_at IS NULL AND deliver_at <= @now
+				AND (expires_at IS NULL OR expires_at > @now)
+			ORDER BY deliver_at
+			LIMIT @limit
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id, type, state, pinned_at, created_at, payload, priority
+	`
+
+	args := pgx.NamedArgs{
+		"now":   now,
+		"limit": limit,
+	}
+
+	r.log.DebugContext(ctx, "Claiming due notifications", slog.Time("now", now), slog.Int("limit", limit))
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to claim due notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+			)
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to claim due notifications", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	claimed := make([]*model.Notification, 0)
+	for rows.Next() {
+		var notification model.Notification
+		if err := scanNotification(rows, &notification); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan claimed notification row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		claimed = append(claimed, &notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	r.log.DebugContext(ctx, "Claimed due notifications", slog.Int("count", len(claimed)))
+	return claimed, nil
+}
+
+func (r *NotificationRepository) CancelScheduled(ctx context.Context, id int64) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("cancel_scheduled_notification", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("cancel_scheduled_notification", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notifications
+		SET deleted_at = NOW()
+		WHERE id = @id AND delivered_at IS NULL AND deleted_at IS NULL
+	`
+
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	r.log.DebugContext(ctx, "Cancelling scheduled notification", slog.Int64("id", id))
 
-		r.log.Error("Failed to delete notification", slog.String("error", err.Error()))
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to cancel scheduled notification",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("id", id),
+			)
+			return custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to cancel scheduled notification", slog.String("error", err.Error()))
 		return err
 	}
 
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
-		r.log.Debug("Notification not found", slog.Int64("id", id))
-		err = custom_errors.ErrNotificationNotFound
-		return
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Scheduled notification not found", slog.Int64("id", id))
+		return custom_errors.ErrNotificationNotFound
 	}
 
-	r.log.Debug("Notification deleted successfully", slog.Int64("id", id))
+	r.log.DebugContext(ctx, "Scheduled notification cancelled", slog.Int64("id", id))
 	return nil
 }
 
-func (r *NotificationRepository) CountUnread(ctx context.Context, userID int64) (count int, err error) {
+func (r *NotificationRepository) Delete(ctx context.Context, id int64) (err error) {
 	start := time.Now()
 	defer func() {
-		r.metrics.IncrementDatabaseQueries("count_unread_notifications", err == nil)
-		r.metrics.RecordDatabaseQueryDuration("count_unread_notifications", time.Since(start))
+		r.metrics.IncrementDatabaseQueries("delete_notification", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_notification", time.Since(start))
 	}()
 
 	query := `
-		SELECT COUNT(*)
-		FROM notifications
-		WHERE user_id = @user_id AND is_read = false
+		UPDATE notifications
+		SET deleted_at = NOW()
+		WHERE id = @id AND deleted_at IS NULL
 	`
 
 	args := pgx.NamedArgs{
-		"user_id": userID,
+		"id": id,
 	}
 
-	r.log.Debug("Counting unread notifications for user", slog.Int64("user_id", userID))
+	r.log.DebugContext(ctx, "Deleting notification", slog.Int64("id", id))
 
-	var countVar int
-	err = r.db.QueryRow(ctx, query, args).Scan(&countVar)
+	result, err := r.db.Exec(ctx, query, args)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			r.log.Error("Failed to count unread notifications",
+			r.log.ErrorContext(ctx, "Failed to delete notification",
 				slog.String("pg_error_code", pgErr.Code),
 				slog.String("pg_error_message", pgErr.Message),
 				slog.String("pg_error_detail", pgErr.Detail),
-				slog.Int64("user_id", userID),
+				slog.Int64("id", id),
 			)
 
-			return 0, custom_errors.ErrDatabaseQuery
+			return custom_errors.ErrDatabaseQuery
 		}
 
-		r.log.Error("Failed to count unread notifications", slog.String("error", err.Error()))
-		return 0, err
+		r.log.ErrorContext(ctx, "Failed to delete notification", slog.String("error", err.Error()))
+		return err
 	}
 
-	r.log.Debug("Counted unread notifications successfully",
-		slog.Int64("user_id", userID),
-		slog.Int("count", countVar),
-	)
+	rowsAffected := result.RowsAffected()
+	if rowsAffected == 0 {
+		r.log.DebugContext(ctx, "Notification not found", slog.Int64("id", id))
+		err = custom_errors.ErrNotificationNotFound
+		return
+	}
 
-	return countVar, nil
+	r.log.DebugContext(ctx, "Notification deleted successfully", slog.Int64("id", id))
+	return nil
+}
+
+func (r *NotificationRepository) Restore(ctx context.Context, id int64, deletedAfter time.Time) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("restore_notification", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("restore_notification", time.Since(start))
+	}()
+
+	query := `
+		UPDATE notifications
+		SET deleted_at = NULL
+		WHERE id = @id AND deleted_at IS NOT NULL AND deleted_at >= @deleted_after AND delivered_at IS NOT NULL
+	`
+
+	args := pgx.NamedArgs{
+		"id":            id,
+		"deleted_after": deletedAfter,
+	}
+
+	r.log.DebugContext(ctx, "Restoring notification", slog.Int64("id", id), slog.Time("deleted_after", deletedAfter))
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to restore notification",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("id", id),
+			)
+			return custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to restore notification", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Restorable notification not found", slog.Int64("id", id))
+		return custom_errors.ErrNotificationNotFound
+	}
+
+	r.log.DebugContext(ctx, "Notification restored successfully", slog.Int64("id", id))
+	return nil
+}
+
+func (r *NotificationRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (purged int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("purge_deleted_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("purge_deleted_notifications", time.Since(start))
+	}()
+
+	query := `
+		DELETE FROM notifications
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+			WHERE deleted_at IS NOT NULL AND deleted_at < @deleted_before
+			ORDER BY deleted_at
+			LIMIT @limit
+		)
+	`
+
+	args := pgx.NamedArgs{
+		"deleted_before": deletedBefore,
+		"limit":          limit,
+	}
+
+	r.log.DebugContext(ctx, "Purging deleted notifications",
+		slog.Time("deleted_before", deletedBefore),
+		slog.Int("limit", limit),
+	)
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to purge deleted notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to purge deleted notifications", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	rowsAffected := result.RowsAffected()
+	r.log.DebugContext(ctx, "Deleted notifications purged", slog.Int64("count", rowsAffected))
+	return rowsAffected, nil
+}
+
+func (r *NotificationRepository) PurgeExpired(ctx context.Context, expiredBefore time.Time, limit int) (purged int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("purge_expired_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("purge_expired_notifications", time.Since(start))
+	}()
+
+	query := `
+		DELETE FROM notifications
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+			WHERE expires_at IS NOT NULL AND expires_at < @expired_before
+			ORDER BY expires_at
+			LIMIT @limit
+		)
+	`
+
+	args := pgx.NamedArgs{
+		"expired_before": expiredBefore,
+		"limit":          limit,
+	}
+
+	r.log.DebugContext(ctx, "Purging expired notifications",
+		slog.Time("expired_before", expiredBefore),
+		slog.Int("limit", limit),
+	)
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to purge expired notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to purge expired notifications", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	rowsAffected := result.RowsAffected()
+	r.log.DebugContext(ctx, "Expired notifications purged", slog.Int64("count", rowsAffected))
+	return rowsAffected, nil
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID int64) (count int, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("count_unread_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("count_unread_notifications", time.Since(start))
+	}()
+
+	query := `
+		SELECT COUNT(*)
+		FROM notifications
+		WHERE user_id = @user_id AND state = 'unread' AND deleted_at IS NULL AND delivered_at IS NOT NULL
+			AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+	}
+
+	r.log.DebugContext(ctx, "Counting unread notifications for user", slog.Int64("user_id", userID))
+
+	var countVar int
+	err = r.db.QueryRow(ctx, query, args).Scan(&countVar)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to count unread notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+			)
+
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+
+		r.log.ErrorContext(ctx, "Failed to count unread notifications", slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	r.log.DebugContext(ctx, "Counted unread notifications successfully",
+		slog.Int64("user_id", userID),
+		slog.Int("count", countVar),
+	)
+
+	return countVar, nil
+}
+
+// ListUnreadDelivered returns the newest unread notifications of the given
+// types delivered in (after, until], up to limit, and how many there are in
+// all. A nil after has no lower bound. Urgent notifications are left out:
+// they were sent right away, not batched.
+func (r *NotificationRepository) ListUnreadDelivered(ctx context.Context, userID int64, after *time.Time, until time.Time, types *model.DigestTypes, limit int) (notifications []*model.Notification, total int, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_unread_delivered_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_unread_delivered_notifications", time.Since(start))
+	}()
+
+	query := `
+		SELECT id, user_id, type, state, pinned_at, created_at, payload, priority, COUNT(*) OVER () AS total
+		FROM notifications
+		WHERE user_id = @user_id AND state = 'unread' AND deleted_at IS NULL
+			AND delivered_at <= @until AND (@after::timestamp IS NULL OR delivered_at > @after::timestamp)
+			AND (expires_at IS NULL OR expires_at > @until)
+			AND priority NOT IN ('high', 'critical')
+			AND (type = ANY(@types::text[])) <> @exclude_types
+		ORDER BY delivered_at DESC, id DESC
+		LIMIT @limit
+	`
+
+	args := pgx.NamedArgs{
+		"user_id":       userID,
+		"after":         after,
+		"until":         until,
+		"types":         types.TypeStrings(),
+		"exclude_types": types.Exclude,
+		"limit":         limit,
+	}
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to list unread delivered notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+			)
+
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+
+		r.log.ErrorContext(ctx, "Failed to list unread delivered notifications", slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	notifications = make([]*model.Notification, 0)
+	for rows.Next() {
+		var notification model.Notification
+		if err := scanNotification(rowWithExtra{Row: rows, extra: []any{&total}}, &notification); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan notification row", slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		notifications = append(notifications, &notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+// DeleteByUser hard-deletes up to limit notifications of the user, whatever
+// their state. Their deliveries go with them.
+func (r *NotificationRepository) DeleteByUser(ctx context.Context, userID int64, limit int) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_user_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_user_notifications", time.Since(start))
+	}()
+
+	query := `
+		DELETE FROM notifications
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+			WHERE user_id = @user_id
+			LIMIT @limit
+		)
+	`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"limit":   limit,
+	}
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to delete user notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to delete user notifications", slog.String("error", err.Error()), slog.Int64("user_id", userID))
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// DeleteByActor hard-deletes up to limit notifications, in anyone's feed,
+// whose payload names actorID as its actor (see Notification.ActorID). The
+// expression matches idx_notifications_actor.
+func (r *NotificationRepository) DeleteByActor(ctx context.Context, actorID int64, limit int) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_actor_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_actor_notifications", time.Since(start))
+	}()
+
+	query := `
+		DELETE FROM notifications
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+			WHERE COALESCE(payload->>'actor_id', payload->>'follower_id') = @actor_id
+			LIMIT @limit
+		)
+	`
+
+	args := pgx.NamedArgs{
+		"actor_id": strconv.FormatInt(actorID, 10),
+		"limit":    limit,
+	}
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to delete actor notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("actor_id", actorID),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to delete actor notifications", slog.String("error", err.Error()), slog.Int64("actor_id", actorID))
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// CountFromActorSince counts the notifications of a type that a user got
+// from an actor since the given time, including removed ones, so that
+// removing a notification does not make room for another.
+func (r *NotificationRepository) CountFromActorSince(ctx context.Context, userID, actorID int64, notificationType events.EventType, since time.Time) (count int, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("count_actor_notifications", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("count_actor_notifications", time.Since(start))
+	}()
+
+	query := `
+		SELECT COUNT(*)
+		FROM notifications
+		WHERE user_id = @user_id
+			AND COALESCE(payload->>'actor_id', payload->>'follower_id') = @actor_id
+			AND type = @type
+			AND created_at >= @since
+	`
+
+	args := pgx.NamedArgs{
+		"user_id":  userID,
+		"actor_id": strconv.FormatInt(actorID, 10),
+		"type":     string(notificationType),
+		"since":    since,
+	}
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&count)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to count actor notifications",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+				slog.Int64("actor_id", actorID),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to count actor notifications", slog.String("error", err.Error()), slog.Int64("user_id", userID))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *NotificationRepository) CreateSuppression(ctx context.Context, suppression *model.NotificationSuppression) (id int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_notification_suppression", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_notification_suppression", time.Since(start))
+	}()
+
+	query := `
+		INSERT INTO notification_suppressions (user_id, actor_id, type, reason, created_at)
+		VALUES (@user_id, @actor_id, @type, @reason, @created_at)
+		RETURNING id
+	`
+
+	args := pgx.NamedArgs{
+		"user_id":    suppression.UserID,
+		"actor_id":   suppression.ActorID,
+		"type":       string(suppression.Type),
+		"reason":     string(suppression.Reason),
+		"created_at": suppression.CreatedAt,
+	}
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to create notification suppression",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", suppression.UserID),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to create notification suppression", slog.String("error", err.Error()), slog.Int64("user_id", suppression.UserID))
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// DeleteSuppressionsByUser removes up to limit suppression records where the
+// user is either the recipient or the actor.
+func (r *NotificationRepository) DeleteSuppressionsByUser(ctx context.Context, userID int64, limit int) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_user_suppressions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_user_suppressions", time.Since(start))
+	}()
+
+	query := `
+		DELETE FROM notification_suppressions
+		WHERE id IN (
+			SELECT id
+			FROM notification_suppressions
+			WHERE user_id = @user_id OR actor_id = @user_id
+			LIMIT @limit
+		)
+	`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"limit":   limit,
+	}
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.ErrorContext(ctx, "Failed to delete user suppressions",
+				slog.String("pg_error_code", pgErr.Code),
+				slog.String("pg_error_message", pgErr.Message),
+				slog.String("pg_error_detail", pgErr.Detail),
+				slog.Int64("user_id", userID),
+			)
+			return 0, custom_errors.ErrDatabaseQuery
+		}
+		r.log.ErrorContext(ctx, "Failed to delete user suppressions", slog.String("error", err.Error()), slog.Int64("user_id", userID))
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
 }
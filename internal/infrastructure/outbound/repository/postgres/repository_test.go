@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -43,21 +44,23 @@ func setupMockNotificationRows(t *testing.T, notifications []model.Notification)
 			mock.AnythingOfType("*int64"),
 			mock.AnythingOfType("*int64"),
 			mock.AnythingOfType("*string"),
-			mock.AnythingOfType("*bool"),
+			mock.AnythingOfType("*string"),
+			mock.AnythingOfType("**time.Time"),
 			mock.AnythingOfType("*time.Time"),
-			mock.AnythingOfType("*json.RawMessage")).
+			mock.IsType(new(json.RawMessage)),
+			mock.AnythingOfType("*string")).
 			Run(func(args mock.Arguments) {
 				idArg := args.Get(0).(*int64)
 				userIDArg := args.Get(1).(*int64)
 				typeArg := args.Get(2).(*string)
-				isReadArg := args.Get(3).(*bool)
-				createdAtArg := args.Get(4).(*time.Time)
-				payloadArg := args.Get(5).(*json.RawMessage)
+				stateArg := args.Get(3).(*string)
+				createdAtArg := args.Get(5).(*time.Time)
+				payloadArg := args.Get(6).(*json.RawMessage)
 
 				*idArg = notif.ID
 				*userIDArg = notif.UserID
 				*typeArg = string(notif.Type)
-				*isReadArg = notif.IsRead
+				*stateArg = string(notif.State)
 				*createdAtArg = notif.CreatedAt
 				*payloadArg = notif.Payload
 			}).
@@ -96,21 +99,23 @@ func TestNotificationRepository_Create(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).
 					Run(func(args mock.Arguments) {
 						idArg := args.Get(0).(*int64)
 						userIDArg := args.Get(1).(*int64)
 						typeArg := args.Get(2).(*string)
-						isReadArg := args.Get(3).(*bool)
-						createdAtArg := args.Get(4).(*time.Time)
-						payloadArg := args.Get(5).(*json.RawMessage)
+						stateArg := args.Get(3).(*string)
+						createdAtArg := args.Get(5).(*time.Time)
+						payloadArg := args.Get(6).(*json.RawMessage)
 
 						*idArg = 1
 						*userIDArg = 1
 						*typeArg = "relation"
-						*isReadArg = false
+						*stateArg = string(model.NotificationStateUnread)
 						*createdAtArg = createdAt
 						*payloadArg = payload
 					}).
@@ -139,9 +144,11 @@ func TestNotificationRepository_Create(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).
 					Return(errors.New("db error"))
 
 				db.On("QueryRow",
@@ -173,9 +180,11 @@ func TestNotificationRepository_Create(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).
 					Return(pgErr)
 
 				db.On("QueryRow",
@@ -217,6 +226,115 @@ func TestNotificationRepository_Create(t *testing.T) {
 	}
 }
 
+func TestNotificationRepository_CreateMany(t *testing.T) {
+	createdAt := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+	pgErr := &pgconn.PgError{Code: "23503", Message: "violates foreign key constraint"}
+
+	scannedRow := func(id int64) *mocks.Row {
+		row := new(mocks.Row)
+		row.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = id
+				*args.Get(3).(*string) = string(model.NotificationStateUnread)
+				*args.Get(5).(*time.Time) = createdAt
+			}).
+			Return(nil)
+		return row
+	}
+
+	tests := []struct {
+		name        string
+		broadcastID int64
+		mockSetup   func(*mocks.PgDB, *mocks.BatchResults)
+		expectedIDs []int64
+		expectedErr error
+	}{
+		{
+			name: "all notifications stored in one batch",
+			mockSetup: func(db *mocks.PgDB, results *mocks.BatchResults) {
+				db.On("SendBatch", mock.Anything, mock.MatchedBy(func(b *pgx.Batch) bool {
+					return b.Len() == 2 && strings.Contains(b.QueuedQueries[0].SQL, "INSERT INTO notifications")
+				})).Return(results)
+				results.On("QueryRow").Return(scannedRow(10)).Once()
+				results.On("QueryRow").Return(scannedRow(11)).Once()
+				results.On("Close").Return(nil)
+			},
+			expectedIDs: []int64{10, 11},
+		},
+		{
+			name:        "broadcast recipient already notified is skipped",
+			broadcastID: 3,
+			mockSetup: func(db *mocks.PgDB, results *mocks.BatchResults) {
+				conflictRow := new(mocks.Row)
+				conflictRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+
+				db.On("SendBatch", mock.Anything, mock.MatchedBy(func(b *pgx.Batch) bool {
+					return strings.Contains(b.QueuedQueries[0].SQL, "ON CONFLICT (broadcast_id, user_id)") &&
+						*b.QueuedQueries[0].Arguments[0].(pgx.NamedArgs)["broadcast_id"].(*int64) == 3
+				})).Return(results)
+				results.On("QueryRow").Return(conflictRow).Once()
+				results.On("QueryRow").Return(scannedRow(11)).Once()
+				results.On("Close").Return(nil)
+			},
+			expectedIDs: []int64{0, 11},
+		},
+		{
+			name: "insert error fails the batch",
+			mockSetup: func(db *mocks.PgDB, results *mocks.BatchResults) {
+				failedRow := new(mocks.Row)
+				failedRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgErr)
+
+				db.On("SendBatch", mock.Anything, mock.Anything).Return(results)
+				results.On("QueryRow").Return(scannedRow(10)).Once()
+				results.On("QueryRow").Return(failedRow).Once()
+				results.On("Close").Return(pgErr)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name: "commit error fails the batch",
+			mockSetup: func(db *mocks.PgDB, results *mocks.BatchResults) {
+				db.On("SendBatch", mock.Anything, mock.Anything).Return(results)
+				results.On("QueryRow").Return(scannedRow(10)).Once()
+				results.On("QueryRow").Return(scannedRow(11)).Once()
+				results.On("Close").Return(pgErr)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockResults := mocks.NewBatchResults(t)
+			tt.mockSetup(mockDB, mockResults)
+
+			notifications := []*model.Notification{
+				{UserID: 1, Type: "new_pin", CreatedAt: createdAt, BroadcastID: tt.broadcastID},
+				{UserID: 2, Type: "new_pin", CreatedAt: createdAt, BroadcastID: tt.broadcastID},
+			}
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			ids, err := repo.CreateMany(context.Background(), notifications)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, ids)
+				assert.Zero(t, notifications[0].ID)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, ids)
+			for i, notification := range notifications {
+				assert.Equal(t, tt.expectedIDs[i], notification.ID)
+			}
+		})
+	}
+}
+
 func TestNotificationRepository_GetByID(t *testing.T) {
 	createdAt := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
 	payload := json.RawMessage(`{"event":"new_follower","follower_id":42}`)
@@ -238,21 +356,23 @@ func TestNotificationRepository_GetByID(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).
 					Run(func(args mock.Arguments) {
 						idArg := args.Get(0).(*int64)
 						userIDArg := args.Get(1).(*int64)
 						typeArg := args.Get(2).(*string)
-						isReadArg := args.Get(3).(*bool)
-						createdAtArg := args.Get(4).(*time.Time)
-						payloadArg := args.Get(5).(*json.RawMessage)
+						stateArg := args.Get(3).(*string)
+						createdAtArg := args.Get(5).(*time.Time)
+						payloadArg := args.Get(6).(*json.RawMessage)
 
 						*idArg = 1
 						*userIDArg = 2
 						*typeArg = "relation"
-						*isReadArg = false
+						*stateArg = string(model.NotificationStateUnread)
 						*createdAtArg = createdAt
 						*payloadArg = payload
 					}).
@@ -282,9 +402,11 @@ func TestNotificationRepository_GetByID(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).
 					Return(pgx.ErrNoRows)
 
 				db.On("QueryRow",
@@ -305,9 +427,11 @@ func TestNotificationRepository_GetByID(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).
 					Return(errors.New("db error"))
 
 				db.On("QueryRow",
@@ -332,9 +456,11 @@ func TestNotificationRepository_GetByID(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).
 					Return(pgErr)
 
 				db.On("QueryRow",
@@ -390,6 +516,7 @@ func TestNotificationRepository_ListByUser(t *testing.T) {
 		ID:        1,
 		UserID:    5,
 		Type:      "relation",
+		State:     model.NotificationStateUnread,
 		IsRead:    false,
 		CreatedAt: createdAt1,
 		Payload:   payload1,
@@ -399,6 +526,7 @@ func TestNotificationRepository_ListByUser(t *testing.T) {
 		ID:        2,
 		UserID:    5,
 		Type:      "relation",
+		State:     model.NotificationStateRead,
 		IsRead:    true,
 		CreatedAt: createdAt2,
 		Payload:   payload2,
@@ -407,6 +535,7 @@ func TestNotificationRepository_ListByUser(t *testing.T) {
 	tests := []struct {
 		name        string
 		userID      int64
+		filter      *model.FeedFilter
 		limit       int
 		offset      int
 		mockSetup   func(*mocks.PgDB)
@@ -492,6 +621,33 @@ func TestNotificationRepository_ListByUser(t *testing.T) {
 			wantErr:    false,
 			checkQuery: true,
 		},
+		{
+			name:   "urgent notifications first",
+			userID: 5,
+			filter: &model.FeedFilter{PriorityFirst: true},
+			limit:  10,
+			offset: 0,
+			mockSetup: func(db *mocks.PgDB) {
+				mockCountRow := new(mocks.Row)
+				mockCountRow.On("Scan", mock.AnythingOfType("*int32")).
+					Run(func(args mock.Arguments) {
+						totalPtr := args[0].(*int32)
+						*totalPtr = 1
+					}).Return(nil)
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockCountRow)
+
+				rows := setupMockNotificationRows(t, []model.Notification{notif1})
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "ORDER BY pinned_at DESC NULLS LAST, priority IN ('high', 'critical') DESC, created_at DESC")
+					}),
+					mock.Anything).Return(rows, nil)
+			},
+			want:      []*model.Notification{&notif1},
+			wantTotal: 1,
+			wantErr:   false,
+		},
 		{
 			name:   "empty notifications list",
 			userID: 5,
@@ -553,9 +709,11 @@ func TestNotificationRepository_ListByUser(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*string"),
-					mock.AnythingOfType("*bool"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("**time.Time"),
 					mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*json.RawMessage")).Return(errors.New("scan error"))
+					mock.IsType(new(json.RawMessage)),
+					mock.AnythingOfType("*string")).Return(errors.New("scan error"))
 				mockRows.On("Close").Return()
 				db.On("Query",
 					mock.Anything,
@@ -580,7 +738,7 @@ func TestNotificationRepository_ListByUser(t *testing.T) {
 			}
 
 			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
-			got, gotTotal, err := repo.ListByUser(context.Background(), tt.userID, tt.limit, tt.offset)
+			got, gotTotal, err := repo.ListByUser(context.Background(), tt.userID, tt.filter, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -694,11 +852,181 @@ func TestNotificationRepository_MarkAsRead(t *testing.T) {
 	}
 }
 
+func TestNotificationRepository_UpdateState(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		state       model.NotificationState
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:  "successful archive",
+			id:    1,
+			state: model.NotificationStateArchived,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["id"] == int64(1) && args["state"] == "archived"
+					})).Return(createSuccessCommandTag(), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "successful mark as unread",
+			id:    1,
+			state: model.NotificationStateUnread,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["state"] == "unread"
+					})).Return(createSuccessCommandTag(), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "notification not found",
+			id:    999,
+			state: model.NotificationStateRead,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(createEmptyCommandTag(), nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name:  "postgres specific error",
+			id:    1,
+			state: model.NotificationStateRead,
+			mockSetup: func(db *mocks.PgDB) {
+				pgErr := &pgconn.PgError{
+					Code:    "23514",
+					Message: "new row violates check constraint \"chk_notifications_state\"",
+				}
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, pgErr)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			err := repo.UpdateState(context.Background(), tt.id, tt.state)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_SetPinned(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		pinned      bool
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:   "successful pin",
+			id:     1,
+			pinned: true,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["id"] == int64(1) && args["pinned"] == true
+					})).Return(createSuccessCommandTag(), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "notification not found",
+			id:     999,
+			pinned: false,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(createEmptyCommandTag(), nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name:   "database error",
+			id:     1,
+			pinned: true,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			err := repo.SetPinned(context.Background(), tt.id, tt.pinned)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestNotificationRepository_MarkAllAsRead(t *testing.T) {
 	tests := []struct {
 		name        string
 		userID      int64
 		mockSetup   func(*mocks.PgDB)
+		want        int64
 		wantErr     bool
 		expectedErr error
 	}{
@@ -713,6 +1041,7 @@ func TestNotificationRepository_MarkAllAsRead(t *testing.T) {
 					mock.AnythingOfType("string"),
 					mock.Anything).Return(commandTag, nil)
 			},
+			want:    3,
 			wantErr: false,
 		},
 		{
@@ -769,7 +1098,7 @@ func TestNotificationRepository_MarkAllAsRead(t *testing.T) {
 			}
 
 			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
-			err := repo.MarkAllAsRead(context.Background(), tt.userID)
+			got, err := repo.MarkAllAsRead(context.Background(), tt.userID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -778,45 +1107,62 @@ func TestNotificationRepository_MarkAllAsRead(t *testing.T) {
 				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
 			}
 		})
 	}
 }
 
-func TestNotificationRepository_Delete(t *testing.T) {
+func TestNotificationRepository_MarkAllAsReadUpTo(t *testing.T) {
+	readBefore := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+	maxID := int64(42)
+
 	tests := []struct {
-		name        string
-		id          int64
-		mockSetup   func(*mocks.PgDB)
-		wantErr     bool
-		expectedErr error
+		name          string
+		userID        int64
+		watermark     *model.ReadWatermark
+		mockSetup     func(*mocks.PgDB)
+		wantErr       bool
+		expectedErr   error
+		expectedCount int64
 	}{
 		{
-			name: "successful delete",
-			id:   1,
+			name:      "successful mark as read up to timestamp",
+			userID:    5,
+			watermark: &model.ReadWatermark{ReadBefore: &readBefore},
 			mockSetup: func(db *mocks.PgDB) {
 				db.On("Exec",
 					mock.Anything,
-					mock.AnythingOfType("string"),
-					mock.Anything).Return(createSuccessCommandTag(), nil)
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "expires_at IS NULL OR expires_at > NOW()")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(5) && args["read_before"] == &readBefore
+					})).Return(pgconn.NewCommandTag("UPDATE 2"), nil)
 			},
-			wantErr: false,
+			wantErr:       false,
+			expectedCount: 2,
 		},
 		{
-			name: "notification not found",
-			id:   999,
+			name:      "successful mark as read up to max ID with types",
+			userID:    5,
+			watermark: &model.ReadWatermark{MaxID: &maxID, Types: []events.EventType{events.EventTypeFollowCreated}},
 			mockSetup: func(db *mocks.PgDB) {
 				db.On("Exec",
 					mock.Anything,
 					mock.AnythingOfType("string"),
-					mock.Anything).Return(createEmptyCommandTag(), nil)
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						types, ok := args["types"].([]string)
+						return ok && len(types) == 1 && types[0] == "follow_created" && args["max_id"] == &maxID
+					})).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
 			},
-			wantErr:     true,
-			expectedErr: custom_errors.ErrNotificationNotFound,
+			wantErr:       false,
+			expectedCount: 0,
 		},
 		{
-			name: "database error",
-			id:   1,
+			name:      "database error",
+			userID:    5,
+			watermark: &model.ReadWatermark{MaxID: &maxID},
 			mockSetup: func(db *mocks.PgDB) {
 				db.On("Exec",
 					mock.Anything,
@@ -827,8 +1173,9 @@ func TestNotificationRepository_Delete(t *testing.T) {
 			expectedErr: errors.New("db error"),
 		},
 		{
-			name: "postgres specific error",
-			id:   1,
+			name:      "postgres specific error",
+			userID:    5,
+			watermark: &model.ReadWatermark{MaxID: &maxID},
 			mockSetup: func(db *mocks.PgDB) {
 				pgErr := &pgconn.PgError{
 					Code:    "42P01",
@@ -855,18 +1202,685 @@ func TestNotificationRepository_Delete(t *testing.T) {
 			}
 
 			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
-			err := repo.Delete(context.Background(), tt.id)
+			count, err := repo.MarkAllAsReadUpTo(context.Background(), tt.userID, tt.watermark)
 
 			if tt.wantErr {
 				assert.Error(t, err)
-				if tt.expectedErr != nil {
-					if errors.Is(err, custom_errors.ErrNotificationNotFound) || errors.Is(err, custom_errors.ErrDatabaseQuery) {
-						assert.ErrorIs(t, err, tt.expectedErr)
-					}
+				assert.Equal(t, int64(0), count)
+				if tt.expectedErr != nil && errors.Is(err, custom_errors.ErrDatabaseQuery) {
+					assert.ErrorIs(t, err, tt.expectedErr)
 				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
 			}
 		})
 	}
 }
+
+func TestNotificationRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "successful delete",
+			id:   1,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(createSuccessCommandTag(), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "notification not found",
+			id:   999,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(createEmptyCommandTag(), nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name: "database error",
+			id:   1,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+			},
+			wantErr:     true,
+			expectedErr: errors.New("db error"),
+		},
+		{
+			name: "postgres specific error",
+			id:   1,
+			mockSetup: func(db *mocks.PgDB) {
+				pgErr := &pgconn.PgError{
+					Code:    "42P01",
+					Message: "relation \"notifications\" does not exist",
+				}
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, pgErr)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			err := repo.Delete(context.Background(), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					if errors.Is(err, custom_errors.ErrNotificationNotFound) || errors.Is(err, custom_errors.ErrDatabaseQuery) {
+						assert.ErrorIs(t, err, tt.expectedErr)
+					}
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_Restore(t *testing.T) {
+	deletedAfter := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		id          int64
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "successful restore",
+			id:   1,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["id"] == int64(1) && args["deleted_after"] == deletedAfter
+					})).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "no restorable tombstone",
+			id:   999,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name: "postgres specific error",
+			id:   1,
+			mockSetup: func(db *mocks.PgDB) {
+				pgErr := &pgconn.PgError{
+					Code:    "42P01",
+					Message: "relation \"notifications\" does not exist",
+				}
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, pgErr)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			err := repo.Restore(context.Background(), tt.id, deletedAfter)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_PurgeDeleted(t *testing.T) {
+	deletedBefore := time.Date(2025, 5, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		limit          int
+		mockSetup      func(*mocks.PgDB)
+		wantErr        bool
+		expectedErr    error
+		expectedPurged int64
+	}{
+		{
+			name:  "successful purge",
+			limit: 500,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["deleted_before"] == deletedBefore && args["limit"] == 500
+					})).Return(pgconn.NewCommandTag("DELETE 42"), nil)
+			},
+			wantErr:        false,
+			expectedPurged: 42,
+		},
+		{
+			name:  "nothing to purge",
+			limit: 500,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(createEmptyCommandTag(), nil)
+			},
+			wantErr:        false,
+			expectedPurged: 0,
+		},
+		{
+			name:  "postgres specific error",
+			limit: 500,
+			mockSetup: func(db *mocks.PgDB) {
+				pgErr := &pgconn.PgError{
+					Code:    "57014",
+					Message: "canceling statement due to statement timeout",
+				}
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, pgErr)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			purged, err := repo.PurgeDeleted(context.Background(), deletedBefore, tt.limit)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPurged, purged)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_ClaimDue(t *testing.T) {
+	now := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+	due := model.Notification{
+		ID:        7,
+		UserID:    5,
+		Type:      "follow_created",
+		State:     model.NotificationStateUnread,
+		CreatedAt: now.Add(-time.Minute),
+		Payload:   json.RawMessage(`{"follower_id":42}`),
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*testing.T, *mocks.PgDB)
+		want        []model.Notification
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "claims due notifications",
+			mockSetup: func(t *testing.T, db *mocks.PgDB) {
+				rows := setupMockNotificationRows(t, []model.Notification{due})
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "FOR UPDATE SKIP LOCKED")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["now"] == now && args["limit"] == 50
+					})).Return(rows, nil)
+			},
+			want:    []model.Notification{due},
+			wantErr: false,
+		},
+		{
+			name: "nothing due",
+			mockSetup: func(t *testing.T, db *mocks.PgDB) {
+				rows := setupMockNotificationRows(t, []model.Notification{})
+				db.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(rows, nil)
+			},
+			want:    []model.Notification{},
+			wantErr: false,
+		},
+		{
+			name: "postgres specific error",
+			mockSetup: func(t *testing.T, db *mocks.PgDB) {
+				pgErr := &pgconn.PgError{
+					Code:    "40P01",
+					Message: "deadlock detected",
+				}
+				db.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil, pgErr)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(t, mockDB)
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			got, err := repo.ClaimDue(context.Background(), now, 50)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.want))
+			for i, want := range tt.want {
+				assert.Equal(t, want.ID, got[i].ID)
+				assert.Equal(t, want.UserID, got[i].UserID)
+				assert.Equal(t, want.State, got[i].State)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_CancelScheduled(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "successful cancel",
+			id:   1,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "delivered_at IS NULL")
+					}),
+					mock.Anything).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "no pending notification",
+			id:   999,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrNotificationNotFound,
+		},
+		{
+			name: "database error",
+			id:   1,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			err := repo.CancelScheduled(context.Background(), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_PurgeExpired(t *testing.T) {
+	expiredBefore := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockSetup      func(*mocks.PgDB)
+		wantErr        bool
+		expectedErr    error
+		expectedPurged int64
+	}{
+		{
+			name: "successful purge",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "expires_at < @expired_before")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["expired_before"] == expiredBefore && args["limit"] == 100
+					})).Return(pgconn.NewCommandTag("DELETE 3"), nil)
+			},
+			expectedPurged: 3,
+		},
+		{
+			name: "postgres specific error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.AnythingOfType("string"),
+					mock.Anything).Return(pgconn.CommandTag{}, &pgconn.PgError{Code: "57014", Message: "canceling statement due to statement timeout"})
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, log, metrics)
+			purged, err := repo.PurgeExpired(context.Background(), expiredBefore, 100)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPurged, purged)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_DeleteByActor(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockSetup       func(*mocks.PgDB)
+		wantErr         bool
+		expectedErr     error
+		expectedDeleted int64
+	}{
+		{
+			name: "actor id is matched as payload text",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "COALESCE(payload->>'actor_id', payload->>'follower_id') = @actor_id")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["actor_id"] == "42" && args["limit"] == 100
+					})).Return(pgconn.NewCommandTag("DELETE 7"), nil)
+			},
+			expectedDeleted: 7,
+		},
+		{
+			name: "postgres specific error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+					Return(pgconn.CommandTag{}, &pgconn.PgError{Code: "57014", Message: "canceling statement due to statement timeout"})
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			deleted, err := repo.DeleteByActor(context.Background(), 42, 100)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedDeleted, deleted)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_CountFromActorSince(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		mockSetup     func(*mocks.PgDB)
+		wantErr       bool
+		expectedErr   error
+		expectedCount int
+	}{
+		{
+			name: "counts by recipient, actor and type",
+			mockSetup: func(db *mocks.PgDB) {
+				row := mocks.NewRow(t)
+				row.On("Scan", mock.AnythingOfType("*int")).Run(func(args mock.Arguments) {
+					*args.Get(0).(*int) = 2
+				}).Return(nil)
+				db.On("QueryRow",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "COALESCE(payload->>'actor_id', payload->>'follower_id') = @actor_id") &&
+							!strings.Contains(query, "deleted_at")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(1) && args["actor_id"] == "42" &&
+							args["type"] == "follow_created" && args["since"] == since
+					})).Return(row)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "postgres specific error",
+			mockSetup: func(db *mocks.PgDB) {
+				row := mocks.NewRow(t)
+				row.On("Scan", mock.Anything).Return(&pgconn.PgError{Code: "57014", Message: "canceling statement due to statement timeout"})
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(row)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			count, err := repo.CountFromActorSince(context.Background(), 1, 42, events.EventTypeFollowCreated, since)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_ListUnreadDelivered(t *testing.T) {
+	after := time.Date(2025, 6, 16, 8, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 6, 17, 8, 0, 0, 0, time.UTC)
+
+	// every row carries the count of the whole window after the notification columns.
+	setupRows := func(t *testing.T, count, total int) *mocks.Rows {
+		rows := mocks.NewRows(t)
+		for i := 0; i < count; i++ {
+			rows.On("Next").Return(true).Once()
+			rows.On("Scan",
+				mock.AnythingOfType("*int64"),
+				mock.AnythingOfType("*int64"),
+				mock.AnythingOfType("*string"),
+				mock.AnythingOfType("*string"),
+				mock.AnythingOfType("**time.Time"),
+				mock.AnythingOfType("*time.Time"),
+				mock.IsType(new(json.RawMessage)),
+				mock.AnythingOfType("*string"),
+				mock.AnythingOfType("*int")).
+				Run(func(args mock.Arguments) {
+					*args.Get(0).(*int64) = int64(10 - i)
+					*args.Get(8).(*int) = total
+				}).
+				Return(nil).
+				Once()
+		}
+		rows.On("Next").Return(false).Once()
+		rows.On("Err").Return(nil).Maybe()
+		rows.On("Close").Return()
+		return rows
+	}
+
+	tests := []struct {
+		name      string
+		types     *model.DigestTypes
+		count     int
+		total     int
+		wantTypes []string
+	}{
+		{
+			name:      "whole window fits",
+			types:     &model.DigestTypes{Exclude: true},
+			count:     2,
+			total:     2,
+			wantTypes: []string{},
+		},
+		{
+			name:      "more than the limit lists the newest and counts them all",
+			types:     &model.DigestTypes{Types: []events.EventType{events.EventTypeFollowCreated}, Exclude: true},
+			count:     2,
+			total:     25,
+			wantTypes: []string{"follow_created"},
+		},
+		{
+			name:      "nothing in the window",
+			types:     &model.DigestTypes{Exclude: true},
+			wantTypes: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockDB.On("Query",
+				mock.Anything,
+				mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "COUNT(*) OVER ()") && strings.Contains(query, "ORDER BY delivered_at DESC, id DESC")
+				}),
+				mock.MatchedBy(func(args pgx.NamedArgs) bool {
+					return args["user_id"] == int64(1) && args["after"] == &after && args["until"] == until && args["limit"] == 2 &&
+						assert.ObjectsAreEqual(tt.wantTypes, args["types"]) && args["exclude_types"] == tt.types.Exclude
+				})).Return(setupRows(t, tt.count, tt.total), nil)
+
+			repo := notification_repository_postgres.NewNotificationRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, total, err := repo.ListUnreadDelivered(context.Background(), 1, &after, until, tt.types, 2)
+
+			require.NoError(t, err)
+			assert.Len(t, got, tt.count)
+			assert.Equal(t, tt.total, total)
+		})
+	}
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, &pgconn.PgError{Code: "42P01"})
+
+		repo := notification_repository_postgres.NewNotificationRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+		got, _, err := repo.ListUnreadDelivered(context.Background(), 1, &after, until, &model.DigestTypes{Exclude: true}, 2)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		assert.Nil(t, got)
+	})
+}
@@ -0,0 +1,205 @@
+package notification_repository_postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// exportFetchSize is how many rows are fetched from the server-side cursor
+// at a time, so a large history is never held in memory.
+const exportFetchSize = 500
+
+// exportQueries select (key, row as JSON) for one record kind of a user,
+// ordered by key. Keys are ids, so a cursor stays valid while the user
+// changes their data; the single digest subscription row has key 1.
+var exportQueries = map[model.ExportRecordKind]string{
+	model.ExportRecordChannelPreference: `
+		SELECT id AS key, to_jsonb(p) AS data
+		FROM notification_channel_preferences p
+		WHERE user_id = @user_id AND id > @after_key
+		ORDER BY id
+	`,
+	model.ExportRecordDigestSubscription: `
+		SELECT 1::bigint AS key, to_jsonb(s) AS data
+		FROM notification_digest_subscriptions s
+		WHERE user_id = @user_id AND @after_key::bigint < 1
+	`,
+	model.ExportRecordDeviceToken: `
+		SELECT id AS key, to_jsonb(t) AS data
+		FROM device_tokens t
+		WHERE user_id = @user_id AND id > @after_key
+		ORDER BY id
+	`,
+	model.ExportRecordNotification: `
+		SELECT id AS key, to_jsonb(n) AS data
+		FROM notifications n
+		WHERE user_id = @user_id AND id > @after_key
+		ORDER BY id
+	`,
+	model.ExportRecordDelivery: `
+		SELECT id AS key, to_jsonb(d) AS data
+		FROM notification_deliveries d
+		WHERE user_id = @user_id AND id > @after_key
+		ORDER BY id
+	`,
+}
+
+type UserDataExportRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewUserDataExportRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *UserDataExportRepository {
+	return &UserDataExportRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *UserDataExportRepository) logQueryError(ctx context.Context, msg string, err error, attrs ...any) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		r.log.ErrorContext(ctx, msg, append([]any{
+			slog.String("pg_error_code", pgErr.Code),
+			slog.String("pg_error_message", pgErr.Message),
+			slog.String("pg_error_detail", pgErr.Detail),
+		}, attrs...)...)
+		return custom_errors.ErrDatabaseQuery
+	}
+	r.log.ErrorContext(ctx, msg, append([]any{slog.String("error", err.Error())}, attrs...)...)
+	return err
+}
+
+// ExportUserData reads every kind through a server-side cursor inside one
+// read-only repeatable-read transaction, so the export is consistent even
+// while the user keeps receiving notifications.
+func (r *UserDataExportRepository) ExportUserData(ctx context.Context, userID int64, from *model.ExportCursor, limit int, fn func(*model.ExportRecord) error) (next *model.ExportCursor, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("export_user_data", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("export_user_data", time.Since(start))
+	}()
+
+	first := slices.Index(model.ExportKinds, from.Kind)
+	if first < 0 {
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to begin export transaction", err, slog.Int64("user_id", userID))
+	}
+	// Nothing is written; rolling back just ends the snapshot.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY`); err != nil {
+		return nil, r.logQueryError(ctx, "Failed to start export snapshot", err, slog.Int64("user_id", userID))
+	}
+
+	// With a limit, one record past the page is read but not exported: it
+	// tells whether another page follows.
+	var last *model.ExportRecord
+	exported := 0
+	more := false
+	emit := func(record *model.ExportRecord) error {
+		if limit > 0 && exported == limit {
+			more = true
+			return nil
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+		last = record
+		exported++
+		return nil
+	}
+
+	for i, kind := range model.ExportKinds[first:] {
+		afterKey := int64(0)
+		if i == 0 {
+			afterKey = from.AfterKey
+		}
+
+		remaining := 0
+		if limit > 0 {
+			remaining = limit + 1 - exported
+		}
+
+		if err := r.exportKind(ctx, tx, userID, kind, afterKey, remaining, emit); err != nil {
+			return nil, err
+		}
+		if more {
+			return &model.ExportCursor{Kind: last.Kind, AfterKey: last.Key}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *UserDataExportRepository) exportKind(ctx context.Context, tx pgx.Tx, userID int64, kind model.ExportRecordKind, afterKey int64, limit int, fn func(*model.ExportRecord) error) error {
+	args := pgx.NamedArgs{
+		"user_id":   userID,
+		"after_key": afterKey,
+	}
+	if _, err := tx.Exec(ctx, `DECLARE user_data_export NO SCROLL CURSOR FOR `+exportQueries[kind], args); err != nil {
+		return r.logQueryError(ctx, "Failed to open export cursor", err, slog.Int64("user_id", userID), slog.String("kind", string(kind)))
+	}
+
+	read := 0
+	for {
+		fetch := exportFetchSize
+		if limit > 0 {
+			fetch = min(fetch, limit-read)
+		}
+		if fetch == 0 {
+			break
+		}
+
+		fetched, err := r.fetch(ctx, tx, kind, fetch, fn)
+		read += fetched
+		if err != nil {
+			return err
+		}
+		if fetched < fetch {
+			break
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `CLOSE user_data_export`); err != nil {
+		return r.logQueryError(ctx, "Failed to close export cursor", err, slog.Int64("user_id", userID))
+	}
+	return nil
+}
+
+func (r *UserDataExportRepository) fetch(ctx context.Context, tx pgx.Tx, kind model.ExportRecordKind, count int, fn func(*model.ExportRecord) error) (fetched int, err error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`FETCH FORWARD %d FROM user_data_export`, count))
+	if err != nil {
+		return 0, r.logQueryError(ctx, "Failed to fetch export rows", err, slog.String("kind", string(kind)))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record := &model.ExportRecord{Kind: kind}
+		if err := rows.Scan(&record.Key, &record.Data); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan export row", slog.String("kind", string(kind)), slog.String("error", err.Error()))
+			return fetched, custom_errors.ErrDatabaseQuery
+		}
+		if err := fn(record); err != nil {
+			return fetched, err
+		}
+		fetched++
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return fetched, err
+	}
+	return fetched, nil
+}
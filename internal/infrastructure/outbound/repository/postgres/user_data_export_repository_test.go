@@ -0,0 +1,140 @@
+package notification_repository_postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	notification_repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// exportTx serves the export cursor from keys per table, the way Postgres
+// would for DECLARE ... / FETCH FORWARD n / CLOSE.
+type exportTx struct {
+	pgx.Tx
+	keys    map[string][]int64
+	pending []int64
+}
+
+func (tx *exportTx) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if strings.HasPrefix(sql, "DECLARE") {
+		tx.pending = nil
+		after := args[0].(pgx.NamedArgs)["after_key"].(int64)
+		for table, keys := range tx.keys {
+			if !strings.Contains(sql, "FROM "+table+" ") {
+				continue
+			}
+			for _, key := range keys {
+				if key > after {
+					tx.pending = append(tx.pending, key)
+				}
+			}
+		}
+	}
+	return pgconn.NewCommandTag(""), nil
+}
+
+func (tx *exportTx) Query(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+	var count int
+	if _, err := fmt.Sscanf(sql, "FETCH FORWARD %d FROM user_data_export", &count); err != nil {
+		return nil, err
+	}
+	count = min(count, len(tx.pending))
+	rows := &exportRows{keys: tx.pending[:count]}
+	tx.pending = tx.pending[count:]
+	return rows, nil
+}
+
+func (tx *exportTx) Rollback(context.Context) error { return nil }
+
+type exportRows struct {
+	pgx.Rows
+	keys []int64
+	key  int64
+}
+
+func (r *exportRows) Next() bool {
+	if len(r.keys) == 0 {
+		return false
+	}
+	r.key, r.keys = r.keys[0], r.keys[1:]
+	return true
+}
+
+func (r *exportRows) Scan(dest ...any) error {
+	*dest[0].(*int64) = r.key
+	*dest[1].(*json.RawMessage) = json.RawMessage(`{}`)
+	return nil
+}
+
+func (r *exportRows) Err() error { return nil }
+func (r *exportRows) Close()     {}
+
+func TestUserDataExportRepository_ExportUserData(t *testing.T) {
+	keys := map[string][]int64{
+		"notification_channel_preferences": {1, 2},
+		"device_tokens":                    {5},
+	}
+
+	tests := []struct {
+		name     string
+		from     *model.ExportCursor
+		limit    int
+		wantKeys []int64
+		wantNext *model.ExportCursor
+	}{
+		{
+			name:     "everything without a limit",
+			from:     &model.ExportCursor{Kind: model.ExportRecordChannelPreference},
+			wantKeys: []int64{1, 2, 5},
+		},
+		{
+			name:     "page ends before the last record",
+			from:     &model.ExportCursor{Kind: model.ExportRecordChannelPreference},
+			limit:    2,
+			wantKeys: []int64{1, 2},
+			wantNext: &model.ExportCursor{Kind: model.ExportRecordChannelPreference, AfterKey: 2},
+		},
+		{
+			name:     "page ends exactly on the last record",
+			from:     &model.ExportCursor{Kind: model.ExportRecordChannelPreference},
+			limit:    3,
+			wantKeys: []int64{1, 2, 5},
+		},
+		{
+			name:     "last page from a cursor",
+			from:     &model.ExportCursor{Kind: model.ExportRecordChannelPreference, AfterKey: 2},
+			limit:    1,
+			wantKeys: []int64{5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockDB.On("Begin", mock.Anything).Return(&exportTx{keys: keys}, nil)
+
+			repo := notification_repository_postgres.NewUserDataExportRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			var got []int64
+			next, err := repo.ExportUserData(context.Background(), 1, tt.from, tt.limit, func(record *model.ExportRecord) error {
+				got = append(got, record.Key)
+				return nil
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKeys, got)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
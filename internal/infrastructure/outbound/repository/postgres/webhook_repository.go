@@ -0,0 +1,471 @@
+package notification_repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-notification-service/internal/domain/models"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+type WebhookRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewWebhookRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *WebhookRepository {
+	return &WebhookRepository{db: db, log: log, metrics: metrics}
+}
+
+const webhookSubscriptionColumns = `id, owner, url, secret, events, notification_types, enabled, consecutive_failures, disabled_at, created_at`
+
+// scanWebhookSubscription reads a row selected as webhookSubscriptionColumns.
+func scanWebhookSubscription(row pgx.Row, subscription *model.WebhookSubscription) error {
+	var eventStrs, typeStrs []string
+	err := row.Scan(
+		&subscription.ID,
+		&subscription.Owner,
+		&subscription.URL,
+		&subscription.Secret,
+		&eventStrs,
+		&typeStrs,
+		&subscription.Enabled,
+		&subscription.ConsecutiveFailures,
+		&subscription.DisabledAt,
+		&subscription.CreatedAt,
+	)
+	subscription.Events = make([]model.WebhookEvent, 0, len(eventStrs))
+	for _, e := range eventStrs {
+		subscription.Events = append(subscription.Events, model.WebhookEvent(e))
+	}
+	subscription.NotificationTypes = make([]events.EventType, 0, len(typeStrs))
+	for _, t := range typeStrs {
+		subscription.NotificationTypes = append(subscription.NotificationTypes, events.EventType(t))
+	}
+	return err
+}
+
+func (r *WebhookRepository) logQueryError(ctx context.Context, msg string, err error, attrs ...any) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		r.log.ErrorContext(ctx, msg, append([]any{
+			slog.String("pg_error_code", pgErr.Code),
+			slog.String("pg_error_message", pgErr.Message),
+			slog.String("pg_error_detail", pgErr.Detail),
+		}, attrs...)...)
+		return custom_errors.ErrDatabaseQuery
+	}
+	r.log.ErrorContext(ctx, msg, append([]any{slog.String("error", err.Error())}, attrs...)...)
+	return err
+}
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) (id int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_webhook_subscription", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_webhook_subscription", time.Since(start))
+	}()
+
+	eventStrs := make([]string, 0, len(subscription.Events))
+	for _, e := range subscription.Events {
+		eventStrs = append(eventStrs, string(e))
+	}
+	typeStrs := make([]string, 0, len(subscription.NotificationTypes))
+	for _, t := range subscription.NotificationTypes {
+		typeStrs = append(typeStrs, string(t))
+	}
+
+	query := `
+		INSERT INTO webhook_subscriptions (owner, url, secret, events, notification_types)
+		VALUES (@owner, @url, @secret, @events, @notification_types)
+		RETURNING id, created_at
+	`
+
+	args := pgx.NamedArgs{
+		"owner":              subscription.Owner,
+		"url":                subscription.URL,
+		"secret":             subscription.Secret,
+		"events":             eventStrs,
+		"notification_types": typeStrs,
+	}
+
+	if err := r.db.QueryRow(ctx, query, args).Scan(&subscription.ID, &subscription.CreatedAt); err != nil {
+		return 0, r.logQueryError(ctx, "Failed to create webhook subscription", err, slog.String("owner", subscription.Owner))
+	}
+
+	subscription.Enabled = true
+	r.log.DebugContext(ctx, "Webhook subscription created", slog.Int64("id", subscription.ID), slog.String("owner", subscription.Owner))
+	return subscription.ID, nil
+}
+
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id int64) (subscription *model.WebhookSubscription, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_webhook_subscription", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_webhook_subscription", time.Since(start))
+	}()
+
+	query := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions WHERE id = @id`
+
+	var s model.WebhookSubscription
+	if err := scanWebhookSubscription(r.db.QueryRow(ctx, query, pgx.NamedArgs{"id": id}), &s); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrWebhookSubscriptionNotFound
+		}
+		return nil, r.logQueryError(ctx, "Failed to get webhook subscription", err, slog.Int64("id", id))
+	}
+	return &s, nil
+}
+
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context, owner string) (subscriptions []*model.WebhookSubscription, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_webhook_subscriptions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_webhook_subscriptions", time.Since(start))
+	}()
+
+	query := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions WHERE owner = @owner ORDER BY id`
+	return r.querySubscriptions(ctx, query, pgx.NamedArgs{"owner": owner})
+}
+
+func (r *WebhookRepository) ListEnabledSubscriptions(ctx context.Context) (subscriptions []*model.WebhookSubscription, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_enabled_webhook_subscriptions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_enabled_webhook_subscriptions", time.Since(start))
+	}()
+
+	query := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions WHERE enabled ORDER BY id`
+	return r.querySubscriptions(ctx, query, pgx.NamedArgs{})
+}
+
+func (r *WebhookRepository) querySubscriptions(ctx context.Context, query string, args pgx.NamedArgs) ([]*model.WebhookSubscription, error) {
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to list webhook subscriptions", err)
+	}
+	defer rows.Close()
+
+	subscriptions := make([]*model.WebhookSubscription, 0)
+	for rows.Next() {
+		var s model.WebhookSubscription
+		if err := scanWebhookSubscription(rows, &s); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan webhook subscription row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		subscriptions = append(subscriptions, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id int64) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_webhook_subscription", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_webhook_subscription", time.Since(start))
+	}()
+
+	query := `DELETE FROM webhook_subscriptions WHERE id = @id`
+	return r.exec(ctx, "delete webhook subscription", query, pgx.NamedArgs{"id": id}, id)
+}
+
+func (r *WebhookRepository) EnableSubscription(ctx context.Context, id int64) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("enable_webhook_subscription", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("enable_webhook_subscription", time.Since(start))
+	}()
+
+	query := `
+		UPDATE webhook_subscriptions
+		SET enabled = TRUE, consecutive_failures = 0, disabled_at = NULL, updated_at = NOW()
+		WHERE id = @id
+	`
+	return r.exec(ctx, "enable webhook subscription", query, pgx.NamedArgs{"id": id}, id)
+}
+
+func (r *WebhookRepository) RecordSubscriptionResult(ctx context.Context, id int64, success bool, disableAfter int) (disabled bool, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("record_webhook_subscription_result", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("record_webhook_subscription_result", time.Since(start))
+	}()
+
+	query := `
+		UPDATE webhook_subscriptions
+		SET consecutive_failures = CASE WHEN @success THEN 0 ELSE consecutive_failures + 1 END,
+			enabled = enabled AND (@success OR consecutive_failures + 1 < @disable_after),
+			disabled_at = CASE
+				WHEN enabled AND NOT @success AND consecutive_failures + 1 >= @disable_after THEN NOW()
+				ELSE disabled_at
+			END,
+			updated_at = NOW()
+		WHERE id = @id
+		RETURNING NOT enabled
+	`
+
+	args := pgx.NamedArgs{
+		"id":            id,
+		"success":       success,
+		"disable_after": disableAfter,
+	}
+
+	if err := r.db.QueryRow(ctx, query, args).Scan(&disabled); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, model.ErrWebhookSubscriptionNotFound
+		}
+		return false, r.logQueryError(ctx, "Failed to record webhook subscription result", err, slog.Int64("id", id))
+	}
+	return disabled, nil
+}
+
+// EnqueueDeliveries queues callbacks in one statement; idempotency keys are
+// generated by the database.
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("enqueue_webhook_deliveries", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("enqueue_webhook_deliveries", time.Since(start))
+	}()
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	subscriptionIDs := make([]int64, 0, len(deliveries))
+	eventStrs := make([]string, 0, len(deliveries))
+	payloads := make([]string, 0, len(deliveries))
+	for _, d := range deliveries {
+		subscriptionIDs = append(subscriptionIDs, d.SubscriptionID)
+		eventStrs = append(eventStrs, string(d.Event))
+		payloads = append(payloads, string(d.Payload))
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (subscription_id, event, payload)
+		SELECT * FROM unnest(@subscription_ids::bigint[], @events::text[], @payloads::jsonb[])
+	`
+
+	args := pgx.NamedArgs{
+		"subscription_ids": subscriptionIDs,
+		"events":           eventStrs,
+		"payloads":         payloads,
+	}
+
+	if _, err := r.db.Exec(ctx, query, args); err != nil {
+		return r.logQueryError(ctx, "Failed to enqueue webhook deliveries", err)
+	}
+	return nil
+}
+
+// ClaimPendingDeliveries leases due callbacks of enabled subscriptions, the
+// same way DeliveryRepository.ClaimPending does for channel deliveries.
+// Callbacks of a disabled subscription wait until it is re-enabled.
+func (r *WebhookRepository) ClaimPendingDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) (deliveries []*model.WebhookDelivery, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("claim_pending_webhook_deliveries", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("claim_pending_webhook_deliveries", time.Since(start))
+	}()
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'sending', attempts = attempts + 1, next_attempt_at = @lease_until, updated_at = @now
+		WHERE id IN (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhook_subscriptions s ON s.id = d.subscription_id
+			WHERE d.status IN ('pending', 'sending') AND d.next_attempt_at <= @now AND s.enabled
+			ORDER BY d.next_attempt_at
+			LIMIT @limit
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		RETURNING id, subscription_id, event, idempotency_key::text, payload, status, attempts, next_attempt_at, created_at
+	`
+
+	args := pgx.NamedArgs{
+		"now":         now,
+		"lease_until": now.Add(lease),
+		"limit":       limit,
+	}
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to claim pending webhook deliveries", err)
+	}
+	defer rows.Close()
+
+	claimed := make([]*model.WebhookDelivery, 0)
+	for rows.Next() {
+		var d model.WebhookDelivery
+		var eventStr, statusStr string
+		if err := rows.Scan(&d.ID, &d.SubscriptionID, &eventStr, &d.IdempotencyKey, &d.Payload, &statusStr, &d.Attempts, &d.NextAttemptAt, &d.CreatedAt); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan webhook delivery row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		d.Event = model.WebhookEvent(eventStr)
+		d.Status = model.DeliveryStatus(statusStr)
+		claimed = append(claimed, &d)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func (r *WebhookRepository) MarkDeliverySent(ctx context.Context, id int64) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("mark_webhook_delivery_sent", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("mark_webhook_delivery_sent", time.Since(start))
+	}()
+
+	query := `UPDATE webhook_deliveries SET status = 'sent', sent_at = NOW(), updated_at = NOW() WHERE id = @id`
+	return r.exec(ctx, "mark webhook delivery sent", query, pgx.NamedArgs{"id": id}, id)
+}
+
+func (r *WebhookRepository) MarkDeliveryRetry(ctx context.Context, id int64, nextAttemptAt time.Time) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("mark_webhook_delivery_retry", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("mark_webhook_delivery_retry", time.Since(start))
+	}()
+
+	query := `UPDATE webhook_deliveries SET status = 'pending', next_attempt_at = @next_attempt_at, updated_at = NOW() WHERE id = @id`
+	return r.exec(ctx, "mark webhook delivery for retry", query, pgx.NamedArgs{"id": id, "next_attempt_at": nextAttemptAt}, id)
+}
+
+func (r *WebhookRepository) MarkDeliveryFailed(ctx context.Context, id int64) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("mark_webhook_delivery_failed", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("mark_webhook_delivery_failed", time.Since(start))
+	}()
+
+	query := `UPDATE webhook_deliveries SET status = 'failed', updated_at = NOW() WHERE id = @id`
+	return r.exec(ctx, "mark webhook delivery failed", query, pgx.NamedArgs{"id": id}, id)
+}
+
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, attempt *model.WebhookAttempt) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("record_webhook_attempt", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("record_webhook_attempt", time.Since(start))
+	}()
+
+	query := `
+		INSERT INTO webhook_attempts (delivery_id, subscription_id, attempt, response_status, error, duration_ms, attempted_at)
+		VALUES (@delivery_id, @subscription_id, @attempt, @response_status, NULLIF(@error, ''), @duration_ms, @attempted_at)
+	`
+
+	args := pgx.NamedArgs{
+		"delivery_id":     attempt.DeliveryID,
+		"subscription_id": attempt.SubscriptionID,
+		"attempt":         attempt.Attempt,
+		"response_status": attempt.ResponseStatus,
+		"error":           attempt.Error,
+		"duration_ms":     attempt.Duration.Milliseconds(),
+		"attempted_at":    attempt.AttemptedAt,
+	}
+
+	if _, err := r.db.Exec(ctx, query, args); err != nil {
+		return r.logQueryError(ctx, "Failed to record webhook attempt", err, slog.Int64("delivery_id", attempt.DeliveryID))
+	}
+	return nil
+}
+
+// ListAttempts returns the most recent attempts for a subscription, newest first.
+func (r *WebhookRepository) ListAttempts(ctx context.Context, subscriptionID int64, limit int) (attempts []*model.WebhookAttempt, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_webhook_attempts", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_webhook_attempts", time.Since(start))
+	}()
+
+	query := `
+		SELECT a.id, a.delivery_id, a.subscription_id, d.event, d.idempotency_key::text, a.attempt,
+			a.response_status, COALESCE(a.error, ''), a.duration_ms, a.attempted_at
+		FROM webhook_attempts a
+		JOIN webhook_deliveries d ON d.id = a.delivery_id
+		WHERE a.subscription_id = @subscription_id
+		ORDER BY a.attempted_at DESC, a.id DESC
+		LIMIT @limit
+	`
+
+	args := pgx.NamedArgs{
+		"subscription_id": subscriptionID,
+		"limit":           limit,
+	}
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, r.logQueryError(ctx, "Failed to list webhook attempts", err, slog.Int64("subscription_id", subscriptionID))
+	}
+	defer rows.Close()
+
+	attempts = make([]*model.WebhookAttempt, 0)
+	for rows.Next() {
+		var a model.WebhookAttempt
+		var eventStr string
+		var durationMs int64
+		if err := rows.Scan(&a.ID, &a.DeliveryID, &a.SubscriptionID, &eventStr, &a.IdempotencyKey, &a.Attempt,
+			&a.ResponseStatus, &a.Error, &durationMs, &a.AttemptedAt); err != nil {
+			r.log.ErrorContext(ctx, "Failed to scan webhook attempt row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		a.Event = model.WebhookEvent(eventStr)
+		a.Duration = time.Duration(durationMs) * time.Millisecond
+		attempts = append(attempts, &a)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.ErrorContext(ctx, "Error during rows iteration", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return attempts, nil
+}
+
+func (r *WebhookRepository) exec(ctx context.Context, action, query string, args pgx.NamedArgs, id int64) error {
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		return r.logQueryError(ctx, "Failed to "+action, err, slog.Int64("id", id))
+	}
+
+	if result.RowsAffected() == 0 {
+		r.log.DebugContext(ctx, "Webhook record not found", slog.Int64("id", id))
+		return model.ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+// DeleteSubscriptionsByOwner removes every subscription of owner together
+// with its queued deliveries and attempt log.
+func (r *WebhookRepository) DeleteSubscriptionsByOwner(ctx context.Context, owner string) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_owner_webhook_subscriptions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_owner_webhook_subscriptions", time.Since(start))
+	}()
+
+	query := `DELETE FROM webhook_subscriptions WHERE owner = @owner`
+
+	result, err := r.db.Exec(ctx, query, pgx.NamedArgs{"owner": owner})
+	if err != nil {
+		return 0, r.logQueryError(ctx, "Failed to delete webhook subscriptions", err, slog.String("owner", owner))
+	}
+	return result.RowsAffected(), nil
+}
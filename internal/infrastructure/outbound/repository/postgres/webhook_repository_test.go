@@ -0,0 +1,158 @@
+package notification_repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	model "pinstack-notification-service/internal/domain/models"
+	"pinstack-notification-service/internal/infrastructure/logger"
+	"pinstack-notification-service/internal/infrastructure/outbound/metrics/prometheus"
+	notification_repository_postgres "pinstack-notification-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-notification-service/mocks"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepository_RecordSubscriptionResult(t *testing.T) {
+	tests := []struct {
+		name         string
+		success      bool
+		mockSetup    func(*mocks.PgDB)
+		wantDisabled bool
+		expectedErr  error
+	}{
+		{
+			name:    "failure that reaches the threshold disables the subscription",
+			success: false,
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := new(mocks.Row)
+				mockRow.On("Scan", mock.AnythingOfType("*bool")).
+					Run(func(args mock.Arguments) { *args.Get(0).(*bool) = true }).
+					Return(nil)
+				db.On("QueryRow",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "consecutive_failures + 1 < @disable_after")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["success"] == false && args["disable_after"] == 5 && args["id"] == int64(7)
+					})).Return(mockRow)
+			},
+			wantDisabled: true,
+		},
+		{
+			name:    "success keeps the subscription enabled",
+			success: true,
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := new(mocks.Row)
+				mockRow.On("Scan", mock.AnythingOfType("*bool")).Return(nil)
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			wantDisabled: false,
+		},
+		{
+			name:    "subscription not found",
+			success: false,
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := new(mocks.Row)
+				mockRow.On("Scan", mock.AnythingOfType("*bool")).Return(pgx.ErrNoRows)
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			expectedErr: model.ErrWebhookSubscriptionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewWebhookRepository(mockDB, log, metrics)
+			disabled, err := repo.RecordSubscriptionResult(context.Background(), 7, tt.success, 5)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDisabled, disabled)
+		})
+	}
+}
+
+func TestWebhookRepository_EnqueueDeliveries(t *testing.T) {
+	tests := []struct {
+		name       string
+		deliveries []*model.WebhookDelivery
+		mockSetup  func(*mocks.PgDB)
+		wantErr    bool
+	}{
+		{
+			name: "enqueues deliveries in one statement",
+			deliveries: []*model.WebhookDelivery{
+				{SubscriptionID: 1, Event: model.WebhookEventNotificationCreated, Payload: []byte(`{"a":1}`)},
+				{SubscriptionID: 2, Event: model.WebhookEventNotificationCreated, Payload: []byte(`{"a":1}`)},
+			},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "unnest(@subscription_ids::bigint[]")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						ids, ok := args["subscription_ids"].([]int64)
+						return ok && assert.ObjectsAreEqual([]int64{1, 2}, ids)
+					})).Return(createSuccessCommandTag(), nil)
+			},
+		},
+		{
+			name:       "nothing to enqueue",
+			deliveries: nil,
+			mockSetup:  func(db *mocks.PgDB) {},
+		},
+		{
+			name: "database error",
+			deliveries: []*model.WebhookDelivery{
+				{SubscriptionID: 1, Event: model.WebhookEventNotificationRead, Payload: []byte(`{}`)},
+			},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(createEmptyCommandTag(), errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+			tt.mockSetup(mockDB)
+
+			repo := notification_repository_postgres.NewWebhookRepository(mockDB, log, metrics)
+			err := repo.EnqueueDeliveries(context.Background(), tt.deliveries)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookRepository_EnableSubscription_NotFound(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(createEmptyCommandTag(), nil)
+
+	repo := notification_repository_postgres.NewWebhookRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	err := repo.EnableSubscription(context.Background(), 7)
+
+	assert.ErrorIs(t, err, model.ErrWebhookSubscriptionNotFound)
+}
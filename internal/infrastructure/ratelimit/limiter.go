@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Rule is a token bucket: Burst calls at once, refilled at Rate calls per
+// second.
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+// Decision is the outcome of one Allow call. RetryAfter is set when the call
+// was not allowed and tells when the next token is available.
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// Limiter takes a token from the bucket identified by key.
+type Limiter interface {
+	Allow(ctx context.Context, key string, rule Rule) (Decision, error)
+}
+
+// retryAfter is how long it takes to refill a bucket from tokens to one
+// token.
+func retryAfter(tokens float64, rule Rule) time.Duration {
+	return time.Duration((1 - tokens) / rule.Rate * float64(time.Second))
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiters(t *testing.T) {
+	backends := []struct {
+		name string
+		// setup returns the limiter and a function that moves its clock.
+		setup func(t *testing.T) (Limiter, func(time.Duration))
+	}{
+		{
+			name: "memory",
+			setup: func(t *testing.T) (Limiter, func(time.Duration)) {
+				now := time.Unix(1_700_000_000, 0)
+				l := NewMemoryLimiter()
+				l.now = func() time.Time { return now }
+				return l, func(d time.Duration) { now = now.Add(d) }
+			},
+		},
+		{
+			name: "redis",
+			setup: func(t *testing.T) (Limiter, func(time.Duration)) {
+				server := miniredis.RunT(t)
+				now := time.Unix(1_700_000_000, 0)
+				server.SetTime(now)
+				client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+				t.Cleanup(func() { _ = client.Close() })
+				return NewRedisLimiter(client, "ratelimit:"), func(d time.Duration) {
+					now = now.Add(d)
+					server.SetTime(now)
+				}
+			},
+		},
+	}
+
+	rule := Rule{Rate: 2, Burst: 3}
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, l Limiter, advance func(time.Duration))
+	}{
+		{
+			name: "burst is allowed, then limited with retry time",
+			run: func(t *testing.T, l Limiter, advance func(time.Duration)) {
+				for range rule.Burst {
+					d, err := l.Allow(context.Background(), "user:1", rule)
+					require.NoError(t, err)
+					assert.True(t, d.Allowed)
+				}
+				d, err := l.Allow(context.Background(), "user:1", rule)
+				require.NoError(t, err)
+				assert.False(t, d.Allowed)
+				assert.Equal(t, 500*time.Millisecond, d.RetryAfter)
+			},
+		},
+		{
+			name: "tokens refill over time",
+			run: func(t *testing.T, l Limiter, advance func(time.Duration)) {
+				for range rule.Burst {
+					_, err := l.Allow(context.Background(), "user:1", rule)
+					require.NoError(t, err)
+				}
+				advance(500 * time.Millisecond)
+				d, err := l.Allow(context.Background(), "user:1", rule)
+				require.NoError(t, err)
+				assert.True(t, d.Allowed)
+				d, err = l.Allow(context.Background(), "user:1", rule)
+				require.NoError(t, err)
+				assert.False(t, d.Allowed)
+			},
+		},
+		{
+			name: "keys have separate buckets",
+			run: func(t *testing.T, l Limiter, advance func(time.Duration)) {
+				for range rule.Burst {
+					_, err := l.Allow(context.Background(), "user:1", rule)
+					require.NoError(t, err)
+				}
+				d, err := l.Allow(context.Background(), "user:2", rule)
+				require.NoError(t, err)
+				assert.True(t, d.Allowed)
+			},
+		},
+	}
+
+	for _, backend := range backends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				l, advance := backend.setup(t)
+				tt.run(t, l, advance)
+			})
+		}
+	}
+}
+
+func TestMemoryLimiterSweepsFullBuckets(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	l := NewMemoryLimiter()
+	l.now = func() time.Time { return now }
+
+	_, err := l.Allow(context.Background(), "user:1", Rule{Rate: 1, Burst: 1})
+	require.NoError(t, err)
+	now = now.Add(sweepInterval)
+	_, err = l.Allow(context.Background(), "user:2", Rule{Rate: 1, Burst: 1})
+	require.NoError(t, err)
+
+	assert.NotContains(t, l.buckets, "user:1")
+	assert.Contains(t, l.buckets, "user:2")
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript refills and takes from the bucket in one step, using the
+// Redis clock so that replicas with skewed clocks share the same buckets.
+// It returns whether the call is allowed and, if not, the milliseconds until
+// the next token.
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, retry}
+`)
+
+// RedisLimiter keeps the buckets in Redis, so the limits hold across
+// replicas. Buckets expire once they would have refilled completely.
+type RedisLimiter struct {
+	client    redis.Scripter
+	keyPrefix string
+}
+
+func NewRedisLimiter(client redis.Scripter, keyPrefix string) *RedisLimiter {
+	return &RedisLimiter{client: client, keyPrefix: keyPrefix}
+}
+
+func (l *RedisLimiter) Allow(ctx context.Context, key string, rule Rule) (Decision, error) {
+	result, err := tokenBucketScript.Run(ctx, l.client, []string{l.keyPrefix + key}, rule.Rate, rule.Burst).Int64Slice()
+	if err != nil {
+		return Decision{}, fmt.Errorf("rate limit script: %w", err)
+	}
+	if len(result) != 2 {
+		return Decision{}, fmt.Errorf("rate limit script: unexpected result %v", result)
+	}
+	if result[0] == 1 {
+		return Decision{Allowed: true}, nil
+	}
+	return Decision{RetryAfter: time.Duration(result[1]) * time.Millisecond}, nil
+}
@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	ports "pinstack-notification-service/internal/domain/ports/output"
+	"pinstack-notification-service/internal/infrastructure/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "pinstack-notification-service"
+
+// Tracer is the tracer used for spans the service starts itself.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End ends span, marking it failed when err is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Setup installs the global tracer provider and W3C trace context
+// propagation. The returned function flushes pending spans and must be
+// called on shutdown. With tracing disabled spans are not recorded, but
+// incoming trace context is still passed on to downstream calls.
+func Setup(ctx context.Context, cfg config.TracingConfig, log ports.Logger) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		log.Info("Tracing disabled")
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, cfg, log)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.Info("Tracing enabled",
+		slog.String("service_name", cfg.ServiceName),
+		slog.String("otlp_endpoint", cfg.OTLPEndpoint),
+		slog.Float64("sample_ratio", cfg.SampleRatio),
+	)
+	return provider.Shutdown, nil
+}
+
+// newExporter exports over OTLP/gRPC and falls back to stdout when no
+// collector is configured or the exporter cannot be created.
+func newExporter(ctx context.Context, cfg config.TracingConfig, log ports.Logger) (sdktrace.SpanExporter, error) {
+	if cfg.OTLPEndpoint != "" {
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err == nil {
+			return exporter, nil
+		}
+		log.Warn("Failed to create OTLP trace exporter, falling back to stdout",
+			slog.String("otlp_endpoint", cfg.OTLPEndpoint),
+			slog.String("error", err.Error()),
+		)
+	}
+	return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+}
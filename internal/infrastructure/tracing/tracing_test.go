@@ -0,0 +1,77 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"pinstack-notification-service/internal/infrastructure/tracing"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	return recorder
+}
+
+func TestKafkaSpans_PropagateTraceThroughHeaders(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	topic := "relation-events"
+	headers := []kafka.Header{{Key: "event_type", Value: []byte("follow_created")}}
+	_, producerSpan := tracing.StartProducerSpan(context.Background(), topic, &headers)
+	producerSpan.End()
+
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 17},
+		Headers:        headers,
+	}
+	ctx, consumerSpan := tracing.StartConsumerSpan(context.Background(), msg)
+	tracing.End(consumerSpan, errors.New("handler failed"))
+
+	assert.Equal(t, "follow_created", string(headers[0].Value))
+	assert.NotEmpty(t, tracing.KafkaHeaderCarrier{Headers: &headers}.Get("traceparent"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, trace.SpanKindProducer, spans[0].SpanKind())
+	assert.Equal(t, trace.SpanKindConsumer, spans[1].SpanKind())
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, spans[1].SpanContext().TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+}
+
+func TestPgxTracer_RecordsQuerySpans(t *testing.T) {
+	recorder := setupRecorder(t)
+	tracer := tracing.PgxTracer{}
+
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: `
+		SELECT id, user_id
+		FROM notifications
+		WHERE id = @id
+	`})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: errors.New("connection reset")})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "postgres SELECT", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("db.query.text", "SELECT id, user_id FROM notifications WHERE id = @id"))
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+}
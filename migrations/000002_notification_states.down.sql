@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_notifications_user_pinned;
+DROP INDEX IF EXISTS idx_notifications_user_state;
+
+ALTER TABLE notifications ADD COLUMN is_read BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE notifications SET is_read = TRUE WHERE state <> 'unread';
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS chk_notifications_state;
+ALTER TABLE notifications DROP COLUMN pinned_at;
+ALTER TABLE notifications DROP COLUMN state;
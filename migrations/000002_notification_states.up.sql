@@ -0,0 +1,10 @@
+ALTER TABLE notifications ADD COLUMN state TEXT NOT NULL DEFAULT 'unread';
+ALTER TABLE notifications ADD COLUMN pinned_at TIMESTAMP;
+
+UPDATE notifications SET state = 'read' WHERE is_read = TRUE;
+
+ALTER TABLE notifications ADD CONSTRAINT chk_notifications_state CHECK (state IN ('unread', 'read', 'archived'));
+ALTER TABLE notifications DROP COLUMN is_read;
+
+CREATE INDEX idx_notifications_user_state ON notifications(user_id, state);
+CREATE INDEX idx_notifications_user_pinned ON notifications(user_id, pinned_at DESC) WHERE pinned_at IS NOT NULL;
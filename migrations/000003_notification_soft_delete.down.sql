@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_notifications_deleted_at;
+DROP INDEX IF EXISTS idx_notifications_user_state;
+
+DELETE FROM notifications WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE notifications DROP COLUMN deleted_at;
+
+CREATE INDEX idx_notifications_user_state ON notifications(user_id, state);
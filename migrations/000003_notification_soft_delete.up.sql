@@ -0,0 +1,5 @@
+ALTER TABLE notifications ADD COLUMN deleted_at TIMESTAMP;
+
+DROP INDEX IF EXISTS idx_notifications_user_state;
+CREATE INDEX idx_notifications_user_state ON notifications(user_id, state) WHERE deleted_at IS NULL;
+CREATE INDEX idx_notifications_deleted_at ON notifications(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_notifications_due;
+
+DELETE FROM notifications WHERE delivered_at IS NULL;
+
+ALTER TABLE notifications DROP COLUMN delivered_at;
+ALTER TABLE notifications DROP COLUMN deliver_at;
@@ -0,0 +1,6 @@
+ALTER TABLE notifications ADD COLUMN deliver_at TIMESTAMP;
+ALTER TABLE notifications ADD COLUMN delivered_at TIMESTAMP;
+
+UPDATE notifications SET delivered_at = created_at;
+
+CREATE INDEX idx_notifications_due ON notifications(deliver_at) WHERE delivered_at IS NULL AND deleted_at IS NULL;
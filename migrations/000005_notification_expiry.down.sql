@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_notifications_expires_at;
+
+ALTER TABLE notifications DROP COLUMN expires_at;
@@ -0,0 +1,3 @@
+ALTER TABLE notifications ADD COLUMN expires_at TIMESTAMP;
+
+CREATE INDEX idx_notifications_expires_at ON notifications(expires_at) WHERE expires_at IS NOT NULL;
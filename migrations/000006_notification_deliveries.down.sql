@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS notification_channel_preferences;
+DROP TABLE IF EXISTS notification_deliveries;
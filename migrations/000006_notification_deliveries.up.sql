@@ -0,0 +1,25 @@
+CREATE TABLE notification_deliveries (
+   id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+   notification_id bigint NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+   user_id bigint NOT NULL,
+   channel TEXT NOT NULL,
+   status TEXT NOT NULL DEFAULT 'pending',
+   attempts INT NOT NULL DEFAULT 0,
+   next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   last_error TEXT,
+   created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   sent_at TIMESTAMP,
+   UNIQUE (notification_id, channel)
+);
+
+CREATE INDEX idx_notification_deliveries_pending ON notification_deliveries(next_attempt_at) WHERE status IN ('pending', 'sending');
+
+CREATE TABLE notification_channel_preferences (
+   user_id bigint NOT NULL,
+   type TEXT NOT NULL DEFAULT '',
+   channel TEXT NOT NULL,
+   enabled BOOLEAN NOT NULL,
+   updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   PRIMARY KEY (user_id, type, channel)
+);
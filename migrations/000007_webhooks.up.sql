@@ -0,0 +1,44 @@
+CREATE TABLE webhook_subscriptions (
+   id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+   owner TEXT NOT NULL,
+   url TEXT NOT NULL,
+   secret TEXT NOT NULL,
+   events TEXT[] NOT NULL DEFAULT '{}',
+   notification_types TEXT[] NOT NULL DEFAULT '{}',
+   enabled BOOLEAN NOT NULL DEFAULT TRUE,
+   consecutive_failures INT NOT NULL DEFAULT 0,
+   disabled_at TIMESTAMP,
+   created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_subscriptions_owner ON webhook_subscriptions(owner);
+
+CREATE TABLE webhook_deliveries (
+   id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+   subscription_id bigint NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+   event TEXT NOT NULL,
+   idempotency_key UUID NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+   payload JSONB NOT NULL,
+   status TEXT NOT NULL DEFAULT 'pending',
+   attempts INT NOT NULL DEFAULT 0,
+   next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   sent_at TIMESTAMP
+);
+
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status IN ('pending', 'sending');
+
+CREATE TABLE webhook_attempts (
+   id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+   delivery_id bigint NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+   subscription_id bigint NOT NULL,
+   attempt INT NOT NULL,
+   response_status INT NOT NULL DEFAULT 0,
+   error TEXT,
+   duration_ms INT NOT NULL,
+   attempted_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_attempts_subscription ON webhook_attempts(subscription_id, attempted_at DESC);
@@ -0,0 +1,11 @@
+CREATE TABLE device_tokens (
+   id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+   user_id bigint NOT NULL,
+   token TEXT NOT NULL UNIQUE,
+   platform TEXT NOT NULL,
+   locale TEXT NOT NULL DEFAULT '',
+   created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_device_tokens_user_id ON device_tokens(user_id);
@@ -0,0 +1,12 @@
+CREATE TABLE notification_digest_subscriptions (
+   user_id bigint PRIMARY KEY,
+   frequency TEXT NOT NULL,
+   next_run_at TIMESTAMP NOT NULL,
+   last_sent_at TIMESTAMP,
+   covered_until TIMESTAMP,
+   updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_digest_subscriptions_due ON notification_digest_subscriptions(next_run_at) WHERE frequency <> 'off';
+
+CREATE INDEX idx_notifications_user_delivered_unread ON notifications(user_id, delivered_at) WHERE state = 'unread' AND deleted_at IS NULL;
@@ -0,0 +1,2 @@
+CREATE INDEX idx_notifications_actor ON notifications ((COALESCE(payload->>'actor_id', payload->>'follower_id')))
+   WHERE COALESCE(payload->>'actor_id', payload->>'follower_id') IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_notifications_user_actor_type;
+DROP TABLE IF EXISTS notification_suppressions;
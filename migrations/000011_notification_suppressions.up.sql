@@ -0,0 +1,14 @@
+CREATE TABLE notification_suppressions (
+   id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+   user_id bigint NOT NULL,
+   actor_id bigint NOT NULL,
+   type TEXT NOT NULL,
+   reason TEXT NOT NULL,
+   created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_suppressions_user ON notification_suppressions(user_id, created_at);
+CREATE INDEX idx_notification_suppressions_actor ON notification_suppressions(actor_id);
+
+CREATE INDEX idx_notifications_user_actor_type ON notifications (user_id, (COALESCE(payload->>'actor_id', payload->>'follower_id')), type, created_at)
+   WHERE COALESCE(payload->>'actor_id', payload->>'follower_id') IS NOT NULL;
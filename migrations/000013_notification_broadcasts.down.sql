@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_notifications_broadcast_user;
+ALTER TABLE notifications DROP COLUMN IF EXISTS broadcast_id;
+DROP TABLE IF EXISTS notification_broadcasts;
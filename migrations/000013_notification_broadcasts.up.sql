@@ -0,0 +1,24 @@
+CREATE TABLE notification_broadcasts (
+   id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+   type TEXT NOT NULL,
+   payload JSONB,
+   priority TEXT NOT NULL DEFAULT '',
+   user_ids bigint[],
+   followers_of bigint,
+   status TEXT NOT NULL DEFAULT 'pending',
+   cursor INTEGER NOT NULL DEFAULT 0,
+   total INTEGER NOT NULL DEFAULT 0,
+   processed INTEGER NOT NULL DEFAULT 0,
+   sent INTEGER NOT NULL DEFAULT 0,
+   last_error TEXT NOT NULL DEFAULT '',
+   next_run_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+   completed_at TIMESTAMP
+);
+
+CREATE INDEX idx_notification_broadcasts_due ON notification_broadcasts(next_run_at) WHERE status IN ('pending', 'running');
+
+ALTER TABLE notifications ADD COLUMN broadcast_id bigint;
+
+CREATE UNIQUE INDEX idx_notifications_broadcast_user ON notifications(broadcast_id, user_id) WHERE broadcast_id IS NOT NULL;
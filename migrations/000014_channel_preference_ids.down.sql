@@ -0,0 +1 @@
+ALTER TABLE notification_channel_preferences DROP COLUMN IF EXISTS id;
@@ -0,0 +1 @@
+ALTER TABLE notification_channel_preferences ADD COLUMN id bigint GENERATED ALWAYS AS IDENTITY;
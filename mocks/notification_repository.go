@@ -5,7 +5,9 @@ package mocks
 import (
 	context "context"
 	model "pinstack-notification-service/internal/domain/models"
+	time "time"
 
+	events "github.com/soloda1/pinstack-proto-definitions/events"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,23 +24,839 @@ func (_m *NotificationRepository) EXPECT() *NotificationRepository_Expecter {
 	return &NotificationRepository_Expecter{mock: &_m.Mock}
 }
 
+// CancelScheduled provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) CancelScheduled(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelScheduled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_CancelScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduled'
+type NotificationRepository_CancelScheduled_Call struct {
+	*mock.Call
+}
+
+// CancelScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *NotificationRepository_Expecter) CancelScheduled(ctx interface{}, id interface{}) *NotificationRepository_CancelScheduled_Call {
+	return &NotificationRepository_CancelScheduled_Call{Call: _e.mock.On("CancelScheduled", ctx, id)}
+}
+
+func (_c *NotificationRepository_CancelScheduled_Call) Run(run func(ctx context.Context, id int64)) *NotificationRepository_CancelScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CancelScheduled_Call) Return(_a0 error) *NotificationRepository_CancelScheduled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_CancelScheduled_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationRepository_CancelScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimDue provides a mock function with given fields: ctx, now, limit
+func (_m *NotificationRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*model.Notification, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []*model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*model.Notification, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*model.Notification); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type NotificationRepository_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *NotificationRepository_Expecter) ClaimDue(ctx interface{}, now interface{}, limit interface{}) *NotificationRepository_ClaimDue_Call {
+	return &NotificationRepository_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now, limit)}
+}
+
+func (_c *NotificationRepository_ClaimDue_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *NotificationRepository_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ClaimDue_Call) Return(_a0 []*model.Notification, _a1 error) *NotificationRepository_ClaimDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_ClaimDue_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*model.Notification, error)) *NotificationRepository_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFromActorSince provides a mock function with given fields: ctx, userID, actorID, notificationType, since
+func (_m *NotificationRepository) CountFromActorSince(ctx context.Context, userID int64, actorID int64, notificationType events.EventType, since time.Time) (int, error) {
+	ret := _m.Called(ctx, userID, actorID, notificationType, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFromActorSince")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, events.EventType, time.Time) (int, error)); ok {
+		return rf(ctx, userID, actorID, notificationType, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, events.EventType, time.Time) int); ok {
+		r0 = rf(ctx, userID, actorID, notificationType, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, events.EventType, time.Time) error); ok {
+		r1 = rf(ctx, userID, actorID, notificationType, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CountFromActorSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFromActorSince'
+type NotificationRepository_CountFromActorSince_Call struct {
+	*mock.Call
+}
+
+// CountFromActorSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - actorID int64
+//   - notificationType events.EventType
+//   - since time.Time
+func (_e *NotificationRepository_Expecter) CountFromActorSince(ctx interface{}, userID interface{}, actorID interface{}, notificationType interface{}, since interface{}) *NotificationRepository_CountFromActorSince_Call {
+	return &NotificationRepository_CountFromActorSince_Call{Call: _e.mock.On("CountFromActorSince", ctx, userID, actorID, notificationType, since)}
+}
+
+func (_c *NotificationRepository_CountFromActorSince_Call) Run(run func(ctx context.Context, userID int64, actorID int64, notificationType events.EventType, since time.Time)) *NotificationRepository_CountFromActorSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(events.EventType), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CountFromActorSince_Call) Return(_a0 int, _a1 error) *NotificationRepository_CountFromActorSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CountFromActorSince_Call) RunAndReturn(run func(context.Context, int64, int64, events.EventType, time.Time) (int, error)) *NotificationRepository_CountFromActorSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountUnread provides a mock function with given fields: ctx, userID
 func (_m *NotificationRepository) CountUnread(ctx context.Context, userID int64) (int, error) {
 	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountUnread")
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type NotificationRepository_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *NotificationRepository_Expecter) CountUnread(ctx interface{}, userID interface{}) *NotificationRepository_CountUnread_Call {
+	return &NotificationRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", ctx, userID)}
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Run(run func(ctx context.Context, userID int64)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Return(_a0 int, _a1 error) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) RunAndReturn(run func(context.Context, int64) (int, error)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, notif
+func (_m *NotificationRepository) Create(ctx context.Context, notif *model.Notification) (int64, error) {
+	ret := _m.Called(ctx, notif)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Notification) (int64, error)); ok {
+		return rf(ctx, notif)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Notification) int64); ok {
+		r0 = rf(ctx, notif)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Notification) error); ok {
+		r1 = rf(ctx, notif)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type NotificationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notif *model.Notification
+func (_e *NotificationRepository_Expecter) Create(ctx interface{}, notif interface{}) *NotificationRepository_Create_Call {
+	return &NotificationRepository_Create_Call{Call: _e.mock.On("Create", ctx, notif)}
+}
+
+func (_c *NotificationRepository_Create_Call) Run(run func(ctx context.Context, notif *model.Notification)) *NotificationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_Create_Call) Return(_a0 int64, _a1 error) *NotificationRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_Create_Call) RunAndReturn(run func(context.Context, *model.Notification) (int64, error)) *NotificationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMany provides a mock function with given fields: ctx, notifs
+func (_m *NotificationRepository) CreateMany(ctx context.Context, notifs []*model.Notification) ([]int64, error) {
+	ret := _m.Called(ctx, notifs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMany")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Notification) ([]int64, error)); ok {
+		return rf(ctx, notifs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Notification) []int64); ok {
+		r0 = rf(ctx, notifs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.Notification) error); ok {
+		r1 = rf(ctx, notifs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CreateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMany'
+type NotificationRepository_CreateMany_Call struct {
+	*mock.Call
+}
+
+// CreateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notifs []*model.Notification
+func (_e *NotificationRepository_Expecter) CreateMany(ctx interface{}, notifs interface{}) *NotificationRepository_CreateMany_Call {
+	return &NotificationRepository_CreateMany_Call{Call: _e.mock.On("CreateMany", ctx, notifs)}
+}
+
+func (_c *NotificationRepository_CreateMany_Call) Run(run func(ctx context.Context, notifs []*model.Notification)) *NotificationRepository_CreateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*model.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CreateMany_Call) Return(_a0 []int64, _a1 error) *NotificationRepository_CreateMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CreateMany_Call) RunAndReturn(run func(context.Context, []*model.Notification) ([]int64, error)) *NotificationRepository_CreateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSuppression provides a mock function with given fields: ctx, suppression
+func (_m *NotificationRepository) CreateSuppression(ctx context.Context, suppression *model.NotificationSuppression) (int64, error) {
+	ret := _m.Called(ctx, suppression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSuppression")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.NotificationSuppression) (int64, error)); ok {
+		return rf(ctx, suppression)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.NotificationSuppression) int64); ok {
+		r0 = rf(ctx, suppression)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.NotificationSuppression) error); ok {
+		r1 = rf(ctx, suppression)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CreateSuppression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSuppression'
+type NotificationRepository_CreateSuppression_Call struct {
+	*mock.Call
+}
+
+// CreateSuppression is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suppression *model.NotificationSuppression
+func (_e *NotificationRepository_Expecter) CreateSuppression(ctx interface{}, suppression interface{}) *NotificationRepository_CreateSuppression_Call {
+	return &NotificationRepository_CreateSuppression_Call{Call: _e.mock.On("CreateSuppression", ctx, suppression)}
+}
+
+func (_c *NotificationRepository_CreateSuppression_Call) Run(run func(ctx context.Context, suppression *model.NotificationSuppression)) *NotificationRepository_CreateSuppression_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.NotificationSuppression))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CreateSuppression_Call) Return(_a0 int64, _a1 error) *NotificationRepository_CreateSuppression_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CreateSuppression_Call) RunAndReturn(run func(context.Context, *model.NotificationSuppression) (int64, error)) *NotificationRepository_CreateSuppression_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NotificationRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *NotificationRepository_Expecter) Delete(ctx interface{}, id interface{}) *NotificationRepository_Delete_Call {
+	return &NotificationRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *NotificationRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *NotificationRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_Delete_Call) Return(_a0 error) *NotificationRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByActor provides a mock function with given fields: ctx, actorID, limit
+func (_m *NotificationRepository) DeleteByActor(ctx context.Context, actorID int64, limit int) (int64, error) {
+	ret := _m.Called(ctx, actorID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByActor")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return rf(ctx, actorID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = rf(ctx, actorID, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, actorID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_DeleteByActor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByActor'
+type NotificationRepository_DeleteByActor_Call struct {
+	*mock.Call
+}
+
+// DeleteByActor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actorID int64
+//   - limit int
+func (_e *NotificationRepository_Expecter) DeleteByActor(ctx interface{}, actorID interface{}, limit interface{}) *NotificationRepository_DeleteByActor_Call {
+	return &NotificationRepository_DeleteByActor_Call{Call: _e.mock.On("DeleteByActor", ctx, actorID, limit)}
+}
+
+func (_c *NotificationRepository_DeleteByActor_Call) Run(run func(ctx context.Context, actorID int64, limit int)) *NotificationRepository_DeleteByActor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteByActor_Call) Return(_a0 int64, _a1 error) *NotificationRepository_DeleteByActor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteByActor_Call) RunAndReturn(run func(context.Context, int64, int) (int64, error)) *NotificationRepository_DeleteByActor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUser provides a mock function with given fields: ctx, userID, limit
+func (_m *NotificationRepository) DeleteByUser(ctx context.Context, userID int64, limit int) (int64, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_DeleteByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUser'
+type NotificationRepository_DeleteByUser_Call struct {
+	*mock.Call
+}
+
+// DeleteByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - limit int
+func (_e *NotificationRepository_Expecter) DeleteByUser(ctx interface{}, userID interface{}, limit interface{}) *NotificationRepository_DeleteByUser_Call {
+	return &NotificationRepository_DeleteByUser_Call{Call: _e.mock.On("DeleteByUser", ctx, userID, limit)}
+}
+
+func (_c *NotificationRepository_DeleteByUser_Call) Run(run func(ctx context.Context, userID int64, limit int)) *NotificationRepository_DeleteByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteByUser_Call) Return(_a0 int64, _a1 error) *NotificationRepository_DeleteByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteByUser_Call) RunAndReturn(run func(context.Context, int64, int) (int64, error)) *NotificationRepository_DeleteByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSuppressionsByUser provides a mock function with given fields: ctx, userID, limit
+func (_m *NotificationRepository) DeleteSuppressionsByUser(ctx context.Context, userID int64, limit int) (int64, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSuppressionsByUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_DeleteSuppressionsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSuppressionsByUser'
+type NotificationRepository_DeleteSuppressionsByUser_Call struct {
+	*mock.Call
+}
+
+// DeleteSuppressionsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - limit int
+func (_e *NotificationRepository_Expecter) DeleteSuppressionsByUser(ctx interface{}, userID interface{}, limit interface{}) *NotificationRepository_DeleteSuppressionsByUser_Call {
+	return &NotificationRepository_DeleteSuppressionsByUser_Call{Call: _e.mock.On("DeleteSuppressionsByUser", ctx, userID, limit)}
+}
+
+func (_c *NotificationRepository_DeleteSuppressionsByUser_Call) Run(run func(ctx context.Context, userID int64, limit int)) *NotificationRepository_DeleteSuppressionsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteSuppressionsByUser_Call) Return(_a0 int64, _a1 error) *NotificationRepository_DeleteSuppressionsByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteSuppressionsByUser_Call) RunAndReturn(run func(context.Context, int64, int) (int64, error)) *NotificationRepository_DeleteSuppressionsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) GetByID(ctx context.Context, id int64) (*model.Notification, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.Notification, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.Notification); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type NotificationRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *NotificationRepository_Expecter) GetByID(ctx interface{}, id interface{}) *NotificationRepository_GetByID_Call {
+	return &NotificationRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *NotificationRepository_GetByID_Call) Run(run func(ctx context.Context, id int64)) *NotificationRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_GetByID_Call) Return(_a0 *model.Notification, _a1 error) *NotificationRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64) (*model.Notification, error)) *NotificationRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUser provides a mock function with given fields: ctx, userID, filter, limit, offset
+func (_m *NotificationRepository) ListByUser(ctx context.Context, userID int64, filter *model.FeedFilter, limit int, offset int) ([]*model.Notification, int32, error) {
+	ret := _m.Called(ctx, userID, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUser")
+	}
+
+	var r0 []*model.Notification
+	var r1 int32
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.FeedFilter, int, int) ([]*model.Notification, int32, error)); ok {
+		return rf(ctx, userID, filter, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.FeedFilter, int, int) []*model.Notification); ok {
+		r0 = rf(ctx, userID, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *model.FeedFilter, int, int) int32); ok {
+		r1 = rf(ctx, userID, filter, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int32)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, *model.FeedFilter, int, int) error); ok {
+		r2 = rf(ctx, userID, filter, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NotificationRepository_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type NotificationRepository_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - filter *model.FeedFilter
+//   - limit int
+//   - offset int
+func (_e *NotificationRepository_Expecter) ListByUser(ctx interface{}, userID interface{}, filter interface{}, limit interface{}, offset interface{}) *NotificationRepository_ListByUser_Call {
+	return &NotificationRepository_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userID, filter, limit, offset)}
+}
+
+func (_c *NotificationRepository_ListByUser_Call) Run(run func(ctx context.Context, userID int64, filter *model.FeedFilter, limit int, offset int)) *NotificationRepository_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*model.FeedFilter), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ListByUser_Call) Return(_a0 []*model.Notification, _a1 int32, _a2 error) *NotificationRepository_ListByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *NotificationRepository_ListByUser_Call) RunAndReturn(run func(context.Context, int64, *model.FeedFilter, int, int) ([]*model.Notification, int32, error)) *NotificationRepository_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnreadDelivered provides a mock function with given fields: ctx, userID, after, until, types, limit
+func (_m *NotificationRepository) ListUnreadDelivered(ctx context.Context, userID int64, after *time.Time, until time.Time, types *model.DigestTypes, limit int) ([]*model.Notification, int, error) {
+	ret := _m.Called(ctx, userID, after, until, types, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnreadDelivered")
+	}
+
+	var r0 []*model.Notification
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *time.Time, time.Time, *model.DigestTypes, int) ([]*model.Notification, int, error)); ok {
+		return rf(ctx, userID, after, until, types, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *time.Time, time.Time, *model.DigestTypes, int) []*model.Notification); ok {
+		r0 = rf(ctx, userID, after, until, types, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *time.Time, time.Time, *model.DigestTypes, int) int); ok {
+		r1 = rf(ctx, userID, after, until, types, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, *time.Time, time.Time, *model.DigestTypes, int) error); ok {
+		r2 = rf(ctx, userID, after, until, types, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NotificationRepository_ListUnreadDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnreadDelivered'
+type NotificationRepository_ListUnreadDelivered_Call struct {
+	*mock.Call
+}
+
+// ListUnreadDelivered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - after *time.Time
+//   - until time.Time
+//   - types *model.DigestTypes
+//   - limit int
+func (_e *NotificationRepository_Expecter) ListUnreadDelivered(ctx interface{}, userID interface{}, after interface{}, until interface{}, types interface{}, limit interface{}) *NotificationRepository_ListUnreadDelivered_Call {
+	return &NotificationRepository_ListUnreadDelivered_Call{Call: _e.mock.On("ListUnreadDelivered", ctx, userID, after, until, types, limit)}
+}
+
+func (_c *NotificationRepository_ListUnreadDelivered_Call) Run(run func(ctx context.Context, userID int64, after *time.Time, until time.Time, types *model.DigestTypes, limit int)) *NotificationRepository_ListUnreadDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*time.Time), args[3].(time.Time), args[4].(*model.DigestTypes), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ListUnreadDelivered_Call) Return(_a0 []*model.Notification, _a1 int, _a2 error) *NotificationRepository_ListUnreadDelivered_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *NotificationRepository_ListUnreadDelivered_Call) RunAndReturn(run func(context.Context, int64, *time.Time, time.Time, *model.DigestTypes, int) ([]*model.Notification, int, error)) *NotificationRepository_ListUnreadDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllAsRead provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) MarkAllAsRead(ctx context.Context, userID int64) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllAsRead")
 	}
 
-	var r0 int
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
 		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
 		r0 = rf(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Get(0).(int64)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
@@ -50,56 +868,56 @@ func (_m *NotificationRepository) CountUnread(ctx context.Context, userID int64)
 	return r0, r1
 }
 
-// NotificationRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
-type NotificationRepository_CountUnread_Call struct {
+// NotificationRepository_MarkAllAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllAsRead'
+type NotificationRepository_MarkAllAsRead_Call struct {
 	*mock.Call
 }
 
-// CountUnread is a helper method to define mock.On call
+// MarkAllAsRead is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-func (_e *NotificationRepository_Expecter) CountUnread(ctx interface{}, userID interface{}) *NotificationRepository_CountUnread_Call {
-	return &NotificationRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", ctx, userID)}
+func (_e *NotificationRepository_Expecter) MarkAllAsRead(ctx interface{}, userID interface{}) *NotificationRepository_MarkAllAsRead_Call {
+	return &NotificationRepository_MarkAllAsRead_Call{Call: _e.mock.On("MarkAllAsRead", ctx, userID)}
 }
 
-func (_c *NotificationRepository_CountUnread_Call) Run(run func(ctx context.Context, userID int64)) *NotificationRepository_CountUnread_Call {
+func (_c *NotificationRepository_MarkAllAsRead_Call) Run(run func(ctx context.Context, userID int64)) *NotificationRepository_MarkAllAsRead_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_CountUnread_Call) Return(_a0 int, _a1 error) *NotificationRepository_CountUnread_Call {
+func (_c *NotificationRepository_MarkAllAsRead_Call) Return(_a0 int64, _a1 error) *NotificationRepository_MarkAllAsRead_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *NotificationRepository_CountUnread_Call) RunAndReturn(run func(context.Context, int64) (int, error)) *NotificationRepository_CountUnread_Call {
+func (_c *NotificationRepository_MarkAllAsRead_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *NotificationRepository_MarkAllAsRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function with given fields: ctx, notif
-func (_m *NotificationRepository) Create(ctx context.Context, notif *model.Notification) (int64, error) {
-	ret := _m.Called(ctx, notif)
+// MarkAllAsReadUpTo provides a mock function with given fields: ctx, userID, watermark
+func (_m *NotificationRepository) MarkAllAsReadUpTo(ctx context.Context, userID int64, watermark *model.ReadWatermark) (int64, error) {
+	ret := _m.Called(ctx, userID, watermark)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for MarkAllAsReadUpTo")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Notification) (int64, error)); ok {
-		return rf(ctx, notif)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.ReadWatermark) (int64, error)); ok {
+		return rf(ctx, userID, watermark)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Notification) int64); ok {
-		r0 = rf(ctx, notif)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.ReadWatermark) int64); ok {
+		r0 = rf(ctx, userID, watermark)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.Notification) error); ok {
-		r1 = rf(ctx, notif)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *model.ReadWatermark) error); ok {
+		r1 = rf(ctx, userID, watermark)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,41 +925,42 @@ func (_m *NotificationRepository) Create(ctx context.Context, notif *model.Notif
 	return r0, r1
 }
 
-// NotificationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type NotificationRepository_Create_Call struct {
+// NotificationRepository_MarkAllAsReadUpTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllAsReadUpTo'
+type NotificationRepository_MarkAllAsReadUpTo_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// MarkAllAsReadUpTo is a helper method to define mock.On call
 //   - ctx context.Context
-//   - notif *model.Notification
-func (_e *NotificationRepository_Expecter) Create(ctx interface{}, notif interface{}) *NotificationRepository_Create_Call {
-	return &NotificationRepository_Create_Call{Call: _e.mock.On("Create", ctx, notif)}
+//   - userID int64
+//   - watermark *model.ReadWatermark
+func (_e *NotificationRepository_Expecter) MarkAllAsReadUpTo(ctx interface{}, userID interface{}, watermark interface{}) *NotificationRepository_MarkAllAsReadUpTo_Call {
+	return &NotificationRepository_MarkAllAsReadUpTo_Call{Call: _e.mock.On("MarkAllAsReadUpTo", ctx, userID, watermark)}
 }
 
-func (_c *NotificationRepository_Create_Call) Run(run func(ctx context.Context, notif *model.Notification)) *NotificationRepository_Create_Call {
+func (_c *NotificationRepository_MarkAllAsReadUpTo_Call) Run(run func(ctx context.Context, userID int64, watermark *model.ReadWatermark)) *NotificationRepository_MarkAllAsReadUpTo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Notification))
+		run(args[0].(context.Context), args[1].(int64), args[2].(*model.ReadWatermark))
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_Create_Call) Return(_a0 int64, _a1 error) *NotificationRepository_Create_Call {
+func (_c *NotificationRepository_MarkAllAsReadUpTo_Call) Return(_a0 int64, _a1 error) *NotificationRepository_MarkAllAsReadUpTo_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *NotificationRepository_Create_Call) RunAndReturn(run func(context.Context, *model.Notification) (int64, error)) *NotificationRepository_Create_Call {
+func (_c *NotificationRepository_MarkAllAsReadUpTo_Call) RunAndReturn(run func(context.Context, int64, *model.ReadWatermark) (int64, error)) *NotificationRepository_MarkAllAsReadUpTo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *NotificationRepository) Delete(ctx context.Context, id int64) error {
+// MarkAsRead provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) MarkAsRead(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for MarkAsRead")
 	}
 
 	var r0 error
@@ -154,58 +973,56 @@ func (_m *NotificationRepository) Delete(ctx context.Context, id int64) error {
 	return r0
 }
 
-// NotificationRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type NotificationRepository_Delete_Call struct {
+// NotificationRepository_MarkAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsRead'
+type NotificationRepository_MarkAsRead_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// MarkAsRead is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *NotificationRepository_Expecter) Delete(ctx interface{}, id interface{}) *NotificationRepository_Delete_Call {
-	return &NotificationRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+func (_e *NotificationRepository_Expecter) MarkAsRead(ctx interface{}, id interface{}) *NotificationRepository_MarkAsRead_Call {
+	return &NotificationRepository_MarkAsRead_Call{Call: _e.mock.On("MarkAsRead", ctx, id)}
 }
 
-func (_c *NotificationRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *NotificationRepository_Delete_Call {
+func (_c *NotificationRepository_MarkAsRead_Call) Run(run func(ctx context.Context, id int64)) *NotificationRepository_MarkAsRead_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_Delete_Call) Return(_a0 error) *NotificationRepository_Delete_Call {
+func (_c *NotificationRepository_MarkAsRead_Call) Return(_a0 error) *NotificationRepository_MarkAsRead_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *NotificationRepository_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationRepository_Delete_Call {
+func (_c *NotificationRepository_MarkAsRead_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationRepository_MarkAsRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *NotificationRepository) GetByID(ctx context.Context, id int64) (*model.Notification, error) {
-	ret := _m.Called(ctx, id)
+// PurgeDeleted provides a mock function with given fields: ctx, deletedBefore, limit
+func (_m *NotificationRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for PurgeDeleted")
 	}
 
-	var r0 *model.Notification
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.Notification, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return rf(ctx, deletedBefore, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.Notification); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = rf(ctx, deletedBefore, limit)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Notification)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, deletedBefore, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -213,114 +1030,153 @@ func (_m *NotificationRepository) GetByID(ctx context.Context, id int64) (*model
 	return r0, r1
 }
 
-// NotificationRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type NotificationRepository_GetByID_Call struct {
+// NotificationRepository_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type NotificationRepository_PurgeDeleted_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// PurgeDeleted is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *NotificationRepository_Expecter) GetByID(ctx interface{}, id interface{}) *NotificationRepository_GetByID_Call {
-	return &NotificationRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - deletedBefore time.Time
+//   - limit int
+func (_e *NotificationRepository_Expecter) PurgeDeleted(ctx interface{}, deletedBefore interface{}, limit interface{}) *NotificationRepository_PurgeDeleted_Call {
+	return &NotificationRepository_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", ctx, deletedBefore, limit)}
 }
 
-func (_c *NotificationRepository_GetByID_Call) Run(run func(ctx context.Context, id int64)) *NotificationRepository_GetByID_Call {
+func (_c *NotificationRepository_PurgeDeleted_Call) Run(run func(ctx context.Context, deletedBefore time.Time, limit int)) *NotificationRepository_PurgeDeleted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_GetByID_Call) Return(_a0 *model.Notification, _a1 error) *NotificationRepository_GetByID_Call {
+func (_c *NotificationRepository_PurgeDeleted_Call) Return(_a0 int64, _a1 error) *NotificationRepository_PurgeDeleted_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *NotificationRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64) (*model.Notification, error)) *NotificationRepository_GetByID_Call {
+func (_c *NotificationRepository_PurgeDeleted_Call) RunAndReturn(run func(context.Context, time.Time, int) (int64, error)) *NotificationRepository_PurgeDeleted_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByUser provides a mock function with given fields: ctx, userID, limit, offset
-func (_m *NotificationRepository) ListByUser(ctx context.Context, userID int64, limit int, offset int) ([]*model.Notification, int32, error) {
-	ret := _m.Called(ctx, userID, limit, offset)
+// PurgeExpired provides a mock function with given fields: ctx, expiredBefore, limit
+func (_m *NotificationRepository) PurgeExpired(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, expiredBefore, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByUser")
+		panic("no return value specified for PurgeExpired")
 	}
 
-	var r0 []*model.Notification
-	var r1 int32
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*model.Notification, int32, error)); ok {
-		return rf(ctx, userID, limit, offset)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return rf(ctx, expiredBefore, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) []*model.Notification); ok {
-		r0 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = rf(ctx, expiredBefore, limit)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Notification)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int) int32); ok {
-		r1 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, expiredBefore, limit)
 	} else {
-		r1 = ret.Get(1).(int32)
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_PurgeExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpired'
+type NotificationRepository_PurgeExpired_Call struct {
+	*mock.Call
+}
+
+// PurgeExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore time.Time
+//   - limit int
+func (_e *NotificationRepository_Expecter) PurgeExpired(ctx interface{}, expiredBefore interface{}, limit interface{}) *NotificationRepository_PurgeExpired_Call {
+	return &NotificationRepository_PurgeExpired_Call{Call: _e.mock.On("PurgeExpired", ctx, expiredBefore, limit)}
+}
+
+func (_c *NotificationRepository_PurgeExpired_Call) Run(run func(ctx context.Context, expiredBefore time.Time, limit int)) *NotificationRepository_PurgeExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_PurgeExpired_Call) Return(_a0 int64, _a1 error) *NotificationRepository_PurgeExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_PurgeExpired_Call) RunAndReturn(run func(context.Context, time.Time, int) (int64, error)) *NotificationRepository_PurgeExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id, deletedAfter
+func (_m *NotificationRepository) Restore(ctx context.Context, id int64, deletedAfter time.Time) error {
+	ret := _m.Called(ctx, id, deletedAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = rf(ctx, userID, limit, offset)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, deletedAfter)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1, r2
+	return r0
 }
 
-// NotificationRepository_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
-type NotificationRepository_ListByUser_Call struct {
+// NotificationRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type NotificationRepository_Restore_Call struct {
 	*mock.Call
 }
 
-// ListByUser is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - limit int
-//   - offset int
-func (_e *NotificationRepository_Expecter) ListByUser(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *NotificationRepository_ListByUser_Call {
-	return &NotificationRepository_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userID, limit, offset)}
+//   - id int64
+//   - deletedAfter time.Time
+func (_e *NotificationRepository_Expecter) Restore(ctx interface{}, id interface{}, deletedAfter interface{}) *NotificationRepository_Restore_Call {
+	return &NotificationRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id, deletedAfter)}
 }
 
-func (_c *NotificationRepository_ListByUser_Call) Run(run func(ctx context.Context, userID int64, limit int, offset int)) *NotificationRepository_ListByUser_Call {
+func (_c *NotificationRepository_Restore_Call) Run(run func(ctx context.Context, id int64, deletedAfter time.Time)) *NotificationRepository_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_ListByUser_Call) Return(_a0 []*model.Notification, _a1 int32, _a2 error) *NotificationRepository_ListByUser_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *NotificationRepository_Restore_Call) Return(_a0 error) *NotificationRepository_Restore_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *NotificationRepository_ListByUser_Call) RunAndReturn(run func(context.Context, int64, int, int) ([]*model.Notification, int32, error)) *NotificationRepository_ListByUser_Call {
+func (_c *NotificationRepository_Restore_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *NotificationRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkAllAsRead provides a mock function with given fields: ctx, userID
-func (_m *NotificationRepository) MarkAllAsRead(ctx context.Context, userID int64) error {
-	ret := _m.Called(ctx, userID)
+// SetPinned provides a mock function with given fields: ctx, id, pinned
+func (_m *NotificationRepository) SetPinned(ctx context.Context, id int64, pinned bool) error {
+	ret := _m.Called(ctx, id, pinned)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkAllAsRead")
+		panic("no return value specified for SetPinned")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = rf(ctx, id, pinned)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -328,46 +1184,47 @@ func (_m *NotificationRepository) MarkAllAsRead(ctx context.Context, userID int6
 	return r0
 }
 
-// NotificationRepository_MarkAllAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllAsRead'
-type NotificationRepository_MarkAllAsRead_Call struct {
+// NotificationRepository_SetPinned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPinned'
+type NotificationRepository_SetPinned_Call struct {
 	*mock.Call
 }
 
-// MarkAllAsRead is a helper method to define mock.On call
+// SetPinned is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-func (_e *NotificationRepository_Expecter) MarkAllAsRead(ctx interface{}, userID interface{}) *NotificationRepository_MarkAllAsRead_Call {
-	return &NotificationRepository_MarkAllAsRead_Call{Call: _e.mock.On("MarkAllAsRead", ctx, userID)}
+//   - id int64
+//   - pinned bool
+func (_e *NotificationRepository_Expecter) SetPinned(ctx interface{}, id interface{}, pinned interface{}) *NotificationRepository_SetPinned_Call {
+	return &NotificationRepository_SetPinned_Call{Call: _e.mock.On("SetPinned", ctx, id, pinned)}
 }
 
-func (_c *NotificationRepository_MarkAllAsRead_Call) Run(run func(ctx context.Context, userID int64)) *NotificationRepository_MarkAllAsRead_Call {
+func (_c *NotificationRepository_SetPinned_Call) Run(run func(ctx context.Context, id int64, pinned bool)) *NotificationRepository_SetPinned_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(bool))
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_MarkAllAsRead_Call) Return(_a0 error) *NotificationRepository_MarkAllAsRead_Call {
+func (_c *NotificationRepository_SetPinned_Call) Return(_a0 error) *NotificationRepository_SetPinned_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *NotificationRepository_MarkAllAsRead_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationRepository_MarkAllAsRead_Call {
+func (_c *NotificationRepository_SetPinned_Call) RunAndReturn(run func(context.Context, int64, bool) error) *NotificationRepository_SetPinned_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkAsRead provides a mock function with given fields: ctx, id
-func (_m *NotificationRepository) MarkAsRead(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// UpdateState provides a mock function with given fields: ctx, id, state
+func (_m *NotificationRepository) UpdateState(ctx context.Context, id int64, state model.NotificationState) error {
+	ret := _m.Called(ctx, id, state)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkAsRead")
+		panic("no return value specified for UpdateState")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.NotificationState) error); ok {
+		r0 = rf(ctx, id, state)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -375,31 +1232,32 @@ func (_m *NotificationRepository) MarkAsRead(ctx context.Context, id int64) erro
 	return r0
 }
 
-// NotificationRepository_MarkAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsRead'
-type NotificationRepository_MarkAsRead_Call struct {
+// NotificationRepository_UpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateState'
+type NotificationRepository_UpdateState_Call struct {
 	*mock.Call
 }
 
-// MarkAsRead is a helper method to define mock.On call
+// UpdateState is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *NotificationRepository_Expecter) MarkAsRead(ctx interface{}, id interface{}) *NotificationRepository_MarkAsRead_Call {
-	return &NotificationRepository_MarkAsRead_Call{Call: _e.mock.On("MarkAsRead", ctx, id)}
+//   - state model.NotificationState
+func (_e *NotificationRepository_Expecter) UpdateState(ctx interface{}, id interface{}, state interface{}) *NotificationRepository_UpdateState_Call {
+	return &NotificationRepository_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, id, state)}
 }
 
-func (_c *NotificationRepository_MarkAsRead_Call) Run(run func(ctx context.Context, id int64)) *NotificationRepository_MarkAsRead_Call {
+func (_c *NotificationRepository_UpdateState_Call) Run(run func(ctx context.Context, id int64, state model.NotificationState)) *NotificationRepository_UpdateState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.NotificationState))
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_MarkAsRead_Call) Return(_a0 error) *NotificationRepository_MarkAsRead_Call {
+func (_c *NotificationRepository_UpdateState_Call) Return(_a0 error) *NotificationRepository_UpdateState_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *NotificationRepository_MarkAsRead_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationRepository_MarkAsRead_Call {
+func (_c *NotificationRepository_UpdateState_Call) RunAndReturn(run func(context.Context, int64, model.NotificationState) error) *NotificationRepository_UpdateState_Call {
 	_c.Call.Return(run)
 	return _c
 }
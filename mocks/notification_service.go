@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 	model "pinstack-notification-service/internal/domain/models"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -22,6 +23,110 @@ func (_m *NotificationService) EXPECT() *NotificationService_Expecter {
 	return &NotificationService_Expecter{mock: &_m.Mock}
 }
 
+// CancelScheduledNotification provides a mock function with given fields: ctx, id
+func (_m *NotificationService) CancelScheduledNotification(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelScheduledNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_CancelScheduledNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledNotification'
+type NotificationService_CancelScheduledNotification_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *NotificationService_Expecter) CancelScheduledNotification(ctx interface{}, id interface{}) *NotificationService_CancelScheduledNotification_Call {
+	return &NotificationService_CancelScheduledNotification_Call{Call: _e.mock.On("CancelScheduledNotification", ctx, id)}
+}
+
+func (_c *NotificationService_CancelScheduledNotification_Call) Run(run func(ctx context.Context, id int64)) *NotificationService_CancelScheduledNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationService_CancelScheduledNotification_Call) Return(_a0 error) *NotificationService_CancelScheduledNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_CancelScheduledNotification_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationService_CancelScheduledNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliverDueNotifications provides a mock function with given fields: ctx, batchSize
+func (_m *NotificationService) DeliverDueNotifications(ctx context.Context, batchSize int) (int, error) {
+	ret := _m.Called(ctx, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverDueNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, batchSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_DeliverDueNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverDueNotifications'
+type NotificationService_DeliverDueNotifications_Call struct {
+	*mock.Call
+}
+
+// DeliverDueNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchSize int
+func (_e *NotificationService_Expecter) DeliverDueNotifications(ctx interface{}, batchSize interface{}) *NotificationService_DeliverDueNotifications_Call {
+	return &NotificationService_DeliverDueNotifications_Call{Call: _e.mock.On("DeliverDueNotifications", ctx, batchSize)}
+}
+
+func (_c *NotificationService_DeliverDueNotifications_Call) Run(run func(ctx context.Context, batchSize int)) *NotificationService_DeliverDueNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationService_DeliverDueNotifications_Call) Return(_a0 int, _a1 error) *NotificationService_DeliverDueNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_DeliverDueNotifications_Call) RunAndReturn(run func(context.Context, int) (int, error)) *NotificationService_DeliverDueNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNotificationDetails provides a mock function with given fields: ctx, id
 func (_m *NotificationService) GetNotificationDetails(ctx context.Context, id int64) (*model.Notification, error) {
 	ret := _m.Called(ctx, id)
@@ -138,9 +243,9 @@ func (_c *NotificationService_GetUnreadCount_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// GetUserNotificationFeed provides a mock function with given fields: ctx, userID, limit, page
-func (_m *NotificationService) GetUserNotificationFeed(ctx context.Context, userID int64, limit int, page int) ([]*model.Notification, int32, error) {
-	ret := _m.Called(ctx, userID, limit, page)
+// GetUserNotificationFeed provides a mock function with given fields: ctx, userID, filter, limit, page
+func (_m *NotificationService) GetUserNotificationFeed(ctx context.Context, userID int64, filter *model.FeedFilter, limit int, page int) ([]*model.Notification, int32, error) {
+	ret := _m.Called(ctx, userID, filter, limit, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserNotificationFeed")
@@ -149,25 +254,25 @@ func (_m *NotificationService) GetUserNotificationFeed(ctx context.Context, user
 	var r0 []*model.Notification
 	var r1 int32
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*model.Notification, int32, error)); ok {
-		return rf(ctx, userID, limit, page)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.FeedFilter, int, int) ([]*model.Notification, int32, error)); ok {
+		return rf(ctx, userID, filter, limit, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) []*model.Notification); ok {
-		r0 = rf(ctx, userID, limit, page)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.FeedFilter, int, int) []*model.Notification); ok {
+		r0 = rf(ctx, userID, filter, limit, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Notification)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int) int32); ok {
-		r1 = rf(ctx, userID, limit, page)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *model.FeedFilter, int, int) int32); ok {
+		r1 = rf(ctx, userID, filter, limit, page)
 	} else {
 		r1 = ret.Get(1).(int32)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = rf(ctx, userID, limit, page)
+	if rf, ok := ret.Get(2).(func(context.Context, int64, *model.FeedFilter, int, int) error); ok {
+		r2 = rf(ctx, userID, filter, limit, page)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -183,15 +288,16 @@ type NotificationService_GetUserNotificationFeed_Call struct {
 // GetUserNotificationFeed is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
+//   - filter *model.FeedFilter
 //   - limit int
 //   - page int
-func (_e *NotificationService_Expecter) GetUserNotificationFeed(ctx interface{}, userID interface{}, limit interface{}, page interface{}) *NotificationService_GetUserNotificationFeed_Call {
-	return &NotificationService_GetUserNotificationFeed_Call{Call: _e.mock.On("GetUserNotificationFeed", ctx, userID, limit, page)}
+func (_e *NotificationService_Expecter) GetUserNotificationFeed(ctx interface{}, userID interface{}, filter interface{}, limit interface{}, page interface{}) *NotificationService_GetUserNotificationFeed_Call {
+	return &NotificationService_GetUserNotificationFeed_Call{Call: _e.mock.On("GetUserNotificationFeed", ctx, userID, filter, limit, page)}
 }
 
-func (_c *NotificationService_GetUserNotificationFeed_Call) Run(run func(ctx context.Context, userID int64, limit int, page int)) *NotificationService_GetUserNotificationFeed_Call {
+func (_c *NotificationService_GetUserNotificationFeed_Call) Run(run func(ctx context.Context, userID int64, filter *model.FeedFilter, limit int, page int)) *NotificationService_GetUserNotificationFeed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(int64), args[2].(*model.FeedFilter), args[3].(int), args[4].(int))
 	})
 	return _c
 }
@@ -201,7 +307,156 @@ func (_c *NotificationService_GetUserNotificationFeed_Call) Return(_a0 []*model.
 	return _c
 }
 
-func (_c *NotificationService_GetUserNotificationFeed_Call) RunAndReturn(run func(context.Context, int64, int, int) ([]*model.Notification, int32, error)) *NotificationService_GetUserNotificationFeed_Call {
+func (_c *NotificationService_GetUserNotificationFeed_Call) RunAndReturn(run func(context.Context, int64, *model.FeedFilter, int, int) ([]*model.Notification, int32, error)) *NotificationService_GetUserNotificationFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LocalizeNotifications provides a mock function with given fields: notifications, locale
+func (_m *NotificationService) LocalizeNotifications(notifications []*model.Notification, locale string) {
+	_m.Called(notifications, locale)
+}
+
+// NotificationService_LocalizeNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalizeNotifications'
+type NotificationService_LocalizeNotifications_Call struct {
+	*mock.Call
+}
+
+// LocalizeNotifications is a helper method to define mock.On call
+//   - notifications []*model.Notification
+//   - locale string
+func (_e *NotificationService_Expecter) LocalizeNotifications(notifications interface{}, locale interface{}) *NotificationService_LocalizeNotifications_Call {
+	return &NotificationService_LocalizeNotifications_Call{Call: _e.mock.On("LocalizeNotifications", notifications, locale)}
+}
+
+func (_c *NotificationService_LocalizeNotifications_Call) Run(run func(notifications []*model.Notification, locale string)) *NotificationService_LocalizeNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*model.Notification), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationService_LocalizeNotifications_Call) Return() *NotificationService_LocalizeNotifications_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *NotificationService_LocalizeNotifications_Call) RunAndReturn(run func([]*model.Notification, string)) *NotificationService_LocalizeNotifications_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PurgeDeletedNotifications provides a mock function with given fields: ctx, olderThan, batchSize
+func (_m *NotificationService) PurgeDeletedNotifications(ctx context.Context, olderThan time.Duration, batchSize int) (int64, error) {
+	ret := _m.Called(ctx, olderThan, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedNotifications")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, int) (int64, error)); ok {
+		return rf(ctx, olderThan, batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, int) int64); ok {
+		r0 = rf(ctx, olderThan, batchSize)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, int) error); ok {
+		r1 = rf(ctx, olderThan, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_PurgeDeletedNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedNotifications'
+type NotificationService_PurgeDeletedNotifications_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThan time.Duration
+//   - batchSize int
+func (_e *NotificationService_Expecter) PurgeDeletedNotifications(ctx interface{}, olderThan interface{}, batchSize interface{}) *NotificationService_PurgeDeletedNotifications_Call {
+	return &NotificationService_PurgeDeletedNotifications_Call{Call: _e.mock.On("PurgeDeletedNotifications", ctx, olderThan, batchSize)}
+}
+
+func (_c *NotificationService_PurgeDeletedNotifications_Call) Run(run func(ctx context.Context, olderThan time.Duration, batchSize int)) *NotificationService_PurgeDeletedNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationService_PurgeDeletedNotifications_Call) Return(_a0 int64, _a1 error) *NotificationService_PurgeDeletedNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_PurgeDeletedNotifications_Call) RunAndReturn(run func(context.Context, time.Duration, int) (int64, error)) *NotificationService_PurgeDeletedNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpiredNotifications provides a mock function with given fields: ctx, batchSize
+func (_m *NotificationService) PurgeExpiredNotifications(ctx context.Context, batchSize int) (int64, error) {
+	ret := _m.Called(ctx, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpiredNotifications")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return rf(ctx, batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, batchSize)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_PurgeExpiredNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpiredNotifications'
+type NotificationService_PurgeExpiredNotifications_Call struct {
+	*mock.Call
+}
+
+// PurgeExpiredNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchSize int
+func (_e *NotificationService_Expecter) PurgeExpiredNotifications(ctx interface{}, batchSize interface{}) *NotificationService_PurgeExpiredNotifications_Call {
+	return &NotificationService_PurgeExpiredNotifications_Call{Call: _e.mock.On("PurgeExpiredNotifications", ctx, batchSize)}
+}
+
+func (_c *NotificationService_PurgeExpiredNotifications_Call) Run(run func(ctx context.Context, batchSize int)) *NotificationService_PurgeExpiredNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationService_PurgeExpiredNotifications_Call) Return(_a0 int64, _a1 error) *NotificationService_PurgeExpiredNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_PurgeExpiredNotifications_Call) RunAndReturn(run func(context.Context, int) (int64, error)) *NotificationService_PurgeExpiredNotifications_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -300,6 +555,64 @@ func (_c *NotificationService_ReadNotification_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ReadUserNotificationsUpTo provides a mock function with given fields: ctx, userID, watermark
+func (_m *NotificationService) ReadUserNotificationsUpTo(ctx context.Context, userID int64, watermark *model.ReadWatermark) (int64, error) {
+	ret := _m.Called(ctx, userID, watermark)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserNotificationsUpTo")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.ReadWatermark) (int64, error)); ok {
+		return rf(ctx, userID, watermark)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *model.ReadWatermark) int64); ok {
+		r0 = rf(ctx, userID, watermark)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *model.ReadWatermark) error); ok {
+		r1 = rf(ctx, userID, watermark)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_ReadUserNotificationsUpTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserNotificationsUpTo'
+type NotificationService_ReadUserNotificationsUpTo_Call struct {
+	*mock.Call
+}
+
+// ReadUserNotificationsUpTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - watermark *model.ReadWatermark
+func (_e *NotificationService_Expecter) ReadUserNotificationsUpTo(ctx interface{}, userID interface{}, watermark interface{}) *NotificationService_ReadUserNotificationsUpTo_Call {
+	return &NotificationService_ReadUserNotificationsUpTo_Call{Call: _e.mock.On("ReadUserNotificationsUpTo", ctx, userID, watermark)}
+}
+
+func (_c *NotificationService_ReadUserNotificationsUpTo_Call) Run(run func(ctx context.Context, userID int64, watermark *model.ReadWatermark)) *NotificationService_ReadUserNotificationsUpTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*model.ReadWatermark))
+	})
+	return _c
+}
+
+func (_c *NotificationService_ReadUserNotificationsUpTo_Call) Return(_a0 int64, _a1 error) *NotificationService_ReadUserNotificationsUpTo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_ReadUserNotificationsUpTo_Call) RunAndReturn(run func(context.Context, int64, *model.ReadWatermark) (int64, error)) *NotificationService_ReadUserNotificationsUpTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveNotification provides a mock function with given fields: ctx, id
 func (_m *NotificationService) RemoveNotification(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -347,6 +660,53 @@ func (_c *NotificationService_RemoveNotification_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RestoreNotification provides a mock function with given fields: ctx, id
+func (_m *NotificationService) RestoreNotification(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_RestoreNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreNotification'
+type NotificationService_RestoreNotification_Call struct {
+	*mock.Call
+}
+
+// RestoreNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *NotificationService_Expecter) RestoreNotification(ctx interface{}, id interface{}) *NotificationService_RestoreNotification_Call {
+	return &NotificationService_RestoreNotification_Call{Call: _e.mock.On("RestoreNotification", ctx, id)}
+}
+
+func (_c *NotificationService_RestoreNotification_Call) Run(run func(ctx context.Context, id int64)) *NotificationService_RestoreNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationService_RestoreNotification_Call) Return(_a0 error) *NotificationService_RestoreNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_RestoreNotification_Call) RunAndReturn(run func(context.Context, int64) error) *NotificationService_RestoreNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveNotification provides a mock function with given fields: ctx, notification
 func (_m *NotificationService) SaveNotification(ctx context.Context, notification *model.Notification) (int64, error) {
 	ret := _m.Called(ctx, notification)
@@ -404,6 +764,161 @@ func (_c *NotificationService_SaveNotification_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// SaveNotifications provides a mock function with given fields: ctx, notifications
+func (_m *NotificationService) SaveNotifications(ctx context.Context, notifications []*model.Notification) ([]error, error) {
+	ret := _m.Called(ctx, notifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveNotifications")
+	}
+
+	var r0 []error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Notification) ([]error, error)); ok {
+		return rf(ctx, notifications)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Notification) []error); ok {
+		r0 = rf(ctx, notifications)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.Notification) error); ok {
+		r1 = rf(ctx, notifications)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_SaveNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveNotifications'
+type NotificationService_SaveNotifications_Call struct {
+	*mock.Call
+}
+
+// SaveNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notifications []*model.Notification
+func (_e *NotificationService_Expecter) SaveNotifications(ctx interface{}, notifications interface{}) *NotificationService_SaveNotifications_Call {
+	return &NotificationService_SaveNotifications_Call{Call: _e.mock.On("SaveNotifications", ctx, notifications)}
+}
+
+func (_c *NotificationService_SaveNotifications_Call) Run(run func(ctx context.Context, notifications []*model.Notification)) *NotificationService_SaveNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*model.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationService_SaveNotifications_Call) Return(_a0 []error, _a1 error) *NotificationService_SaveNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_SaveNotifications_Call) RunAndReturn(run func(context.Context, []*model.Notification) ([]error, error)) *NotificationService_SaveNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetNotificationPinned provides a mock function with given fields: ctx, id, pinned
+func (_m *NotificationService) SetNotificationPinned(ctx context.Context, id int64, pinned bool) error {
+	ret := _m.Called(ctx, id, pinned)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNotificationPinned")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = rf(ctx, id, pinned)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_SetNotificationPinned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetNotificationPinned'
+type NotificationService_SetNotificationPinned_Call struct {
+	*mock.Call
+}
+
+// SetNotificationPinned is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - pinned bool
+func (_e *NotificationService_Expecter) SetNotificationPinned(ctx interface{}, id interface{}, pinned interface{}) *NotificationService_SetNotificationPinned_Call {
+	return &NotificationService_SetNotificationPinned_Call{Call: _e.mock.On("SetNotificationPinned", ctx, id, pinned)}
+}
+
+func (_c *NotificationService_SetNotificationPinned_Call) Run(run func(ctx context.Context, id int64, pinned bool)) *NotificationService_SetNotificationPinned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *NotificationService_SetNotificationPinned_Call) Return(_a0 error) *NotificationService_SetNotificationPinned_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_SetNotificationPinned_Call) RunAndReturn(run func(context.Context, int64, bool) error) *NotificationService_SetNotificationPinned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationState provides a mock function with given fields: ctx, id, state
+func (_m *NotificationService) UpdateNotificationState(ctx context.Context, id int64, state model.NotificationState) error {
+	ret := _m.Called(ctx, id, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.NotificationState) error); ok {
+		r0 = rf(ctx, id, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_UpdateNotificationState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationState'
+type NotificationService_UpdateNotificationState_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - state model.NotificationState
+func (_e *NotificationService_Expecter) UpdateNotificationState(ctx interface{}, id interface{}, state interface{}) *NotificationService_UpdateNotificationState_Call {
+	return &NotificationService_UpdateNotificationState_Call{Call: _e.mock.On("UpdateNotificationState", ctx, id, state)}
+}
+
+func (_c *NotificationService_UpdateNotificationState_Call) Run(run func(ctx context.Context, id int64, state model.NotificationState)) *NotificationService_UpdateNotificationState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.NotificationState))
+	})
+	return _c
+}
+
+func (_c *NotificationService_UpdateNotificationState_Call) Return(_a0 error) *NotificationService_UpdateNotificationState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_UpdateNotificationState_Call) RunAndReturn(run func(context.Context, int64, model.NotificationState) error) *NotificationService_UpdateNotificationState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNotificationService creates a new instance of NotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNotificationService(t interface {